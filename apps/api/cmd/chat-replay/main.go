@@ -0,0 +1,196 @@
+// Command chat-replay posts recorded LINE or WhatsApp webhook payloads to a
+// running server, signed with the configured platform secret, so the chat
+// intake can be exercised without a bot account.
+//
+// Usage:
+//
+//	chat-replay -platform line [-url http://localhost:8080] [-text hi -text 2 ...] payload.json...
+//
+// Each payload is sent once per -text, with that text as the message, or
+// once as recorded when no -text is given. Event and message IDs are made
+// unique on every send so redelivery detection does not drop them; use
+// -keep-ids to replay a redelivery. A whole conversation can be walked
+// through with one payload and a -text per answer.
+//
+// It reads LINE_CHANNEL_SECRET and WHATSAPP_APP_SECRET from the same
+// environment as the server. Recorded payloads are in testdata/chat.
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/config"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/line"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/whatsapp"
+)
+
+// texts collects repeated -text flags
+type texts []string
+
+func (t *texts) String() string     { return strings.Join(*t, ", ") }
+func (t *texts) Set(v string) error { *t = append(*t, v); return nil }
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	platform := flag.String("platform", "", "line or whatsapp")
+	baseURL := flag.String("url", "http://localhost:8080", "server base URL")
+	keepIDs := flag.Bool("keep-ids", false, "send event and message IDs as recorded")
+	var messages texts
+	flag.Var(&messages, "text", "message text to send; repeat for a conversation")
+	flag.Parse()
+
+	var secret, path string
+	var sign func(secret string, body []byte) (string, string)
+	switch *platform {
+	case "line":
+		secret, path, sign = cfg.LINEChannelSecret, "/v1/webhooks/line", signLINE
+	case "whatsapp":
+		secret, path, sign = cfg.WhatsAppAppSecret, "/v1/webhooks/whatsapp", signWhatsApp
+	default:
+		log.Fatalf("invalid -platform: %q", *platform)
+	}
+	if secret == "" {
+		log.Fatalf("no secret configured for %s", *platform)
+	}
+	if flag.NArg() == 0 {
+		log.Fatal("no payload files given")
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	for _, file := range flag.Args() {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("failed to read payload: %v", err)
+		}
+
+		sends := []*string{nil}
+		if len(messages) > 0 {
+			sends = sends[:0]
+			for i := range messages {
+				sends = append(sends, &messages[i])
+			}
+		}
+		for _, text := range sends {
+			var payload map[string]interface{}
+			if err := json.Unmarshal(raw, &payload); err != nil {
+				log.Fatalf("invalid payload %s: %v", file, err)
+			}
+			rewrite(payload, text, !*keepIDs)
+			body, err := json.Marshal(payload)
+			if err != nil {
+				log.Fatalf("failed to encode payload: %v", err)
+			}
+
+			req, err := http.NewRequest(http.MethodPost, strings.TrimRight(*baseURL, "/")+path, bytes.NewReader(body))
+			if err != nil {
+				log.Fatalf("failed to build request: %v", err)
+			}
+			header, value := sign(secret, body)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(header, value)
+
+			resp, err := client.Do(req)
+			if err != nil {
+				log.Fatalf("request failed: %v", err)
+			}
+			detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			resp.Body.Close()
+
+			label := file
+			if text != nil {
+				label = fmt.Sprintf("%s %q", file, *text)
+			}
+			fmt.Printf("%s: %s %s\n", label, resp.Status, bytes.TrimSpace(detail))
+		}
+	}
+}
+
+// signLINE signs a LINE webhook body
+func signLINE(secret string, body []byte) (string, string) {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return line.SignatureHeader, base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signWhatsApp signs a WhatsApp webhook body
+func signWhatsApp(secret string, body []byte) (string, string) {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return whatsapp.SignatureHeader, "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// rewrite sets the text of every text message in a LINE or WhatsApp payload
+// and, when fresh is set, gives events and messages new IDs
+func rewrite(payload map[string]interface{}, text *string, fresh bool) {
+	// LINE: events[].message
+	for _, e := range list(payload["events"]) {
+		event, _ := e.(map[string]interface{})
+		if event == nil {
+			continue
+		}
+		if fresh {
+			event["webhookEventId"] = strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", ""))[:26]
+			event["replyToken"] = strings.ReplaceAll(uuid.NewString(), "-", "")
+		}
+		if message, _ := event["message"].(map[string]interface{}); message != nil {
+			if fresh {
+				message["id"] = fmt.Sprint(time.Now().UnixNano())
+			}
+			if text != nil && message["type"] == "text" {
+				message["text"] = *text
+			}
+		}
+	}
+
+	// WhatsApp: entry[].changes[].value.messages[]
+	for _, e := range list(payload["entry"]) {
+		entry, _ := e.(map[string]interface{})
+		if entry == nil {
+			continue
+		}
+		for _, c := range list(entry["changes"]) {
+			change, _ := c.(map[string]interface{})
+			if change == nil {
+				continue
+			}
+			value, _ := change["value"].(map[string]interface{})
+			if value == nil {
+				continue
+			}
+			for _, m := range list(value["messages"]) {
+				message, _ := m.(map[string]interface{})
+				if message == nil {
+					continue
+				}
+				if fresh {
+					message["id"] = "wamid." + strings.ReplaceAll(uuid.NewString(), "-", "")
+				}
+				if text != nil && message["type"] == "text" {
+					message["text"] = map[string]interface{}{"body": *text}
+				}
+			}
+		}
+	}
+}
+
+func list(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	return l
+}
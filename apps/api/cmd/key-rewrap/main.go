@@ -0,0 +1,57 @@
+// Command key-rewrap moves every encrypted column under the primary master
+// key after a key rotation, and seals values stored before encryption was
+// enabled.
+//
+// Usage:
+//
+//	key-rewrap [-batch 500] [-dry-run]
+//
+// Only the per-value data keys are re-sealed, and each row is updated only if
+// it has not changed since it was read, so the server keeps running while it
+// works. It reads the same environment as the server, whose keyring must hold
+// the new primary key and every key still in use. Once a run finishes with no
+// conflicts, keys other than the primary can be removed from the keyring.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/config"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	batch := flag.Int("batch", 500, "rows read per query")
+	dryRun := flag.Bool("dry-run", false, "count what would change; write nothing")
+	flag.Parse()
+
+	if *batch < 1 {
+		log.Fatalf("invalid -batch: %d", *batch)
+	}
+
+	db, err := repository.NewDB(cfg)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	encryptionSvc := service.NewEncryptionService(repository.NewEncryptionRepository(db), db.Keyring)
+	results, err := encryptionSvc.Rewrap(context.Background(), *batch, *dryRun)
+	for _, r := range results {
+		fmt.Printf("%s.%s: %d current, %d rewrapped, %d encrypted, %d conflicts\n",
+			r.Table, r.Column, r.Current, r.Rewrapped, r.Encrypted, r.Conflicts)
+	}
+	if err != nil {
+		log.Fatalf("rewrap failed: %v", err)
+	}
+	if *dryRun {
+		fmt.Println("dry run: nothing was written")
+	}
+}
@@ -0,0 +1,89 @@
+// Command stix-export writes verified scam indicators as a STIX 2.1 bundle,
+// for partners that take file drops instead of pulling /v1/indicators/stix.
+//
+// Usage:
+//
+//	stix-export [-added-after 2026-01-08T00:00:00Z] [-limit 500] [-tlp green] [-o bundle.json]
+//
+// It reads the same environment as the server. When more indicators are
+// left, the added-after of the next run is printed to stderr.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/config"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/stix"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+)
+
+func main() {
+	// Load configuration
+	cfg := config.Load()
+
+	addedAfter := flag.String("added-after", "", "only indicators changed after this RFC 3339 time")
+	limit := flag.Int("limit", 500, "maximum indicators in the bundle")
+	tlp := flag.String("tlp", cfg.STIXTLP, "TLP level marking the objects: white, green, amber or red")
+	out := flag.String("o", "", "output file (default stdout)")
+	flag.Parse()
+
+	query := dto.ExportIndicatorsQuery{Limit: *limit}
+	if *addedAfter != "" {
+		t, err := time.Parse(time.RFC3339, *addedAfter)
+		if err != nil {
+			log.Fatalf("invalid -added-after: %v", err)
+		}
+		query.AddedAfter = t
+	}
+	if query.Limit < 1 {
+		log.Fatalf("invalid -limit: %d", query.Limit)
+	}
+	if !stix.ValidTLP(*tlp) {
+		log.Fatalf("invalid -tlp: %q", *tlp)
+	}
+
+	db, err := repository.NewDB(cfg)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	indicatorSvc := service.NewIndicatorService(
+		repository.NewIndicatorRepository(db),
+		repository.NewReportRepository(db),
+		repository.NewAuditRepository(db),
+		cfg.STIXIdentity, *tlp,
+	)
+
+	export, err := indicatorSvc.ExportSTIX(context.Background(), query)
+	if err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("failed to create output: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(export.Bundle); err != nil {
+		log.Fatalf("failed to write bundle: %v", err)
+	}
+
+	if export.More {
+		log.Printf("more indicators left; continue with -added-after %s", export.Last.UTC().Format(time.RFC3339Nano))
+	}
+}
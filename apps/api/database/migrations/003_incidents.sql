@@ -0,0 +1,44 @@
+-- +goose Up
+-- Incidents group related reports and carry their own lifecycle and severity.
+
+CREATE TABLE incidents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR(255) NOT NULL,
+    category VARCHAR(50) NOT NULL CHECK (category IN (
+        'suspicious_item', 'suspicious_person', 'harassment_stalking',
+        'scam_phishing', 'misinformation_panic', 'crowd_disorder',
+        'infrastructure_hazard', 'other'
+    )),
+    severity VARCHAR(10) CHECK (severity IN ('S0', 'S1', 'S2', 'S3', 'S4')),
+    status VARCHAR(50) NOT NULL DEFAULT 'open' CHECK (status IN (
+        'open', 'monitoring', 'resolved', 'closed', 'merged'
+    )),
+    summary TEXT,
+    area_hint VARCHAR(500),
+    merged_into UUID REFERENCES incidents(id) ON DELETE SET NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+ALTER TABLE reports ADD COLUMN incident_id UUID REFERENCES incidents(id) ON DELETE SET NULL;
+ALTER TABLE alerts ADD COLUMN incident_id UUID REFERENCES incidents(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_incidents_status ON incidents(status);
+CREATE INDEX idx_incidents_updated_at ON incidents(updated_at DESC);
+CREATE INDEX idx_incidents_merged_into ON incidents(merged_into);
+CREATE INDEX idx_reports_incident_id ON reports(incident_id);
+CREATE INDEX idx_alerts_incident_id ON alerts(incident_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_alerts_incident_id;
+DROP INDEX IF EXISTS idx_reports_incident_id;
+DROP INDEX IF EXISTS idx_incidents_merged_into;
+DROP INDEX IF EXISTS idx_incidents_updated_at;
+DROP INDEX IF EXISTS idx_incidents_status;
+
+ALTER TABLE alerts DROP COLUMN IF EXISTS incident_id;
+ALTER TABLE reports DROP COLUMN IF EXISTS incident_id;
+
+DROP TABLE IF EXISTS incidents;
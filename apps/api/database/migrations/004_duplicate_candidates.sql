@@ -0,0 +1,32 @@
+-- +goose Up
+-- Near-duplicate detection: SimHash fingerprints on reports and suggested pairs.
+
+ALTER TABLE reports ADD COLUMN simhash BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE duplicate_candidates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    candidate_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    score NUMERIC(5,4) NOT NULL,
+    text_score NUMERIC(5,4) NOT NULL,
+    area_score NUMERIC(5,4) NOT NULL,
+    time_score NUMERIC(5,4) NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'suggested' CHECK (status IN (
+        'suggested', 'linked', 'dismissed'
+    )),
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT idx_duplicate_pair UNIQUE (report_id, candidate_id)
+);
+
+CREATE INDEX idx_duplicate_candidates_candidate_id ON duplicate_candidates(candidate_id);
+CREATE INDEX idx_duplicate_candidates_status ON duplicate_candidates(status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_duplicate_candidates_status;
+DROP INDEX IF EXISTS idx_duplicate_candidates_candidate_id;
+
+DROP TABLE IF EXISTS duplicate_candidates;
+
+ALTER TABLE reports DROP COLUMN IF EXISTS simhash;
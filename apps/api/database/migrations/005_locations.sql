@@ -0,0 +1,24 @@
+-- +goose Up
+-- Structured, privacy-preserving locations: a coarsened geohash cell and a
+-- pilot-zone reference from the embedded gazetteer. Raw coordinates are never stored.
+
+ALTER TABLE reports ADD COLUMN geohash VARCHAR(12) NOT NULL DEFAULT '';
+ALTER TABLE reports ADD COLUMN zone_id VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE alerts ADD COLUMN geohash VARCHAR(12) NOT NULL DEFAULT '';
+ALTER TABLE alerts ADD COLUMN zone_id VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_reports_geohash ON reports(geohash);
+CREATE INDEX idx_reports_zone_id ON reports(zone_id);
+CREATE INDEX idx_alerts_geohash ON alerts(geohash);
+CREATE INDEX idx_alerts_zone_id ON alerts(zone_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_alerts_zone_id;
+DROP INDEX IF EXISTS idx_alerts_geohash;
+DROP INDEX IF EXISTS idx_reports_zone_id;
+DROP INDEX IF EXISTS idx_reports_geohash;
+
+ALTER TABLE alerts DROP COLUMN IF EXISTS zone_id;
+ALTER TABLE alerts DROP COLUMN IF EXISTS geohash;
+ALTER TABLE reports DROP COLUMN IF EXISTS zone_id;
+ALTER TABLE reports DROP COLUMN IF EXISTS geohash;
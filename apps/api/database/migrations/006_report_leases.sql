@@ -0,0 +1,20 @@
+-- +goose Up
+-- Triage claims. Leases are held in Redis when available; this table is the
+-- fallback store when the API runs without Redis.
+
+CREATE TABLE report_leases (
+    report_id UUID PRIMARY KEY REFERENCES reports(id) ON DELETE CASCADE,
+    holder_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    acquired_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_report_leases_holder_id ON report_leases(holder_id);
+CREATE INDEX idx_report_leases_expires_at ON report_leases(expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_report_leases_expires_at;
+DROP INDEX IF EXISTS idx_report_leases_holder_id;
+
+DROP TABLE IF EXISTS report_leases;
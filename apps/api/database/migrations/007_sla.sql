@@ -0,0 +1,62 @@
+-- +goose Up
+-- Triage SLAs: a deadline per severity, recorded breaches and the in-app
+-- notifications sent when a report nears or passes its deadline.
+
+CREATE TABLE sla_policies (
+    severity VARCHAR(10) PRIMARY KEY,
+    triage_minutes INTEGER NOT NULL,
+    warn_minutes INTEGER NOT NULL DEFAULT 0,
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO sla_policies (severity, triage_minutes, warn_minutes) VALUES
+    ('S4', 15, 5),
+    ('S3', 30, 10),
+    ('S2', 60, 15),
+    ('S1', 240, 60),
+    ('S0', 1440, 240);
+
+CREATE TABLE sla_breaches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    level VARCHAR(20) NOT NULL,
+    severity VARCHAR(10) NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    notified JSONB DEFAULT '[]',
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_sla_breach_level ON sla_breaches(report_id, level);
+CREATE INDEX idx_sla_breaches_severity ON sla_breaches(severity);
+CREATE INDEX idx_sla_breaches_detected_at ON sla_breaches(detected_at DESC);
+
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT,
+    object_type VARCHAR(50),
+    object_id UUID,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, created_at DESC);
+
+ALTER TABLE users ADD COLUMN on_call BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS on_call;
+
+DROP INDEX IF EXISTS idx_notifications_user_id;
+DROP TABLE IF EXISTS notifications;
+
+DROP INDEX IF EXISTS idx_sla_breaches_detected_at;
+DROP INDEX IF EXISTS idx_sla_breaches_severity;
+DROP INDEX IF EXISTS idx_sla_breach_level;
+DROP TABLE IF EXISTS sla_breaches;
+
+DROP TABLE IF EXISTS sla_policies;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Report search: a weighted tsvector over area and description for word
+-- matching, and a trigram index so Chinese bigram substring matches stay fast.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE reports ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(area_hint, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_reports_search_vector ON reports USING GIN (search_vector);
+CREATE INDEX idx_reports_search_trgm ON reports USING GIN ((area_hint || ' ' || description) gin_trgm_ops);
+CREATE INDEX idx_triage_decisions_report_decided ON triage_decisions(report_id, decided_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_triage_decisions_report_decided;
+DROP INDEX IF EXISTS idx_reports_search_trgm;
+DROP INDEX IF EXISTS idx_reports_search_vector;
+
+ALTER TABLE reports DROP COLUMN IF EXISTS search_vector;
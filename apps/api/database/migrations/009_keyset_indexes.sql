@@ -0,0 +1,16 @@
+-- +goose Up
+-- Composite indexes backing cursor (keyset) pagination. Each list orders by
+-- its time column and then id, so the cursor comparison is an index range scan.
+
+CREATE INDEX idx_reports_created_at_id ON reports(created_at DESC, id DESC);
+CREATE INDEX idx_triage_decisions_decided_at_id ON triage_decisions(decided_at DESC, id DESC);
+CREATE INDEX idx_alerts_created_at_id ON alerts(created_at DESC, id DESC);
+CREATE INDEX idx_training_events_event_date_id ON training_events(event_date DESC, id DESC);
+CREATE INDEX idx_audit_logs_ts_id ON audit_logs(ts DESC, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_logs_ts_id;
+DROP INDEX IF EXISTS idx_training_events_event_date_id;
+DROP INDEX IF EXISTS idx_alerts_created_at_id;
+DROP INDEX IF EXISTS idx_triage_decisions_decided_at_id;
+DROP INDEX IF EXISTS idx_reports_created_at_id;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- Intake gate: risk scoring for public submissions. High-risk reports are
+-- quarantined instead of entering the triage queue.
+
+ALTER TABLE reports ADD COLUMN risk_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE reports ADD COLUMN risk_signals JSONB DEFAULT '[]';
+ALTER TABLE reports ADD COLUMN device_hash VARCHAR(64);
+
+CREATE INDEX idx_reports_device_hash ON reports(device_hash, created_at);
+
+ALTER TABLE reports DROP CONSTRAINT reports_status_check;
+ALTER TABLE reports ADD CONSTRAINT reports_status_check CHECK (status IN (
+    'submitted', 'under_review', 'triaged', 'escalated', 'closed', 'spam', 'quarantined'
+));
+
+-- Spent proof-of-work challenges (used when Redis is unavailable)
+CREATE TABLE intake_nonces (
+    nonce VARCHAR(32) PRIMARY KEY,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_intake_nonces_expires_at ON intake_nonces(expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_intake_nonces_expires_at;
+DROP TABLE IF EXISTS intake_nonces;
+
+UPDATE reports SET status = 'submitted' WHERE status = 'quarantined';
+ALTER TABLE reports DROP CONSTRAINT reports_status_check;
+ALTER TABLE reports ADD CONSTRAINT reports_status_check CHECK (status IN (
+    'submitted', 'under_review', 'triaged', 'escalated', 'closed', 'spam'
+));
+
+DROP INDEX IF EXISTS idx_reports_device_hash;
+ALTER TABLE reports DROP COLUMN IF EXISTS device_hash;
+ALTER TABLE reports DROP COLUMN IF EXISTS risk_signals;
+ALTER TABLE reports DROP COLUMN IF EXISTS risk_score;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- Reporter reputation keyed on salted, rotating pseudonymous keys. Counts
+-- come from triage outcomes; rows expire under the retention policy.
+
+CREATE TABLE reporter_reputations (
+    key VARCHAR(64) PRIMARY KEY,
+    accepted INTEGER NOT NULL DEFAULT 0,
+    rejected INTEGER NOT NULL DEFAULT 0,
+    spam INTEGER NOT NULL DEFAULT 0,
+    first_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_reporter_reputations_expires_at ON reporter_reputations(expires_at);
+
+-- The outcome each report contributed, so re-triage replaces it
+CREATE TABLE reputation_outcomes (
+    report_id UUID PRIMARY KEY REFERENCES reports(id) ON DELETE CASCADE,
+    reporter_key VARCHAR(64) NOT NULL,
+    outcome VARCHAR(20) NOT NULL CHECK (outcome IN ('accepted', 'rejected', 'spam')),
+    recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_reputation_outcomes_reporter_key ON reputation_outcomes(reporter_key);
+CREATE INDEX idx_reputation_outcomes_expires_at ON reputation_outcomes(expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reputation_outcomes_expires_at;
+DROP INDEX IF EXISTS idx_reputation_outcomes_reporter_key;
+DROP TABLE IF EXISTS reputation_outcomes;
+DROP INDEX IF EXISTS idx_reporter_reputations_expires_at;
+DROP TABLE IF EXISTS reporter_reputations;
@@ -0,0 +1,39 @@
+-- +goose Up
+-- Coordinated reporting (brigading) detection: clusters of correlated reports
+-- against one area, category or phrasing, reviewed by triagers.
+
+CREATE TABLE brigade_clusters (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('area', 'category', 'phrasing')),
+    target_key VARCHAR(100) NOT NULL,
+    area_key VARCHAR(100),
+    category VARCHAR(50),
+    signals JSONB DEFAULT '[]',
+    report_count INTEGER NOT NULL DEFAULT 0,
+    source_count INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'confirmed', 'dismissed')),
+    tightened_until TIMESTAMP WITH TIME ZONE,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_by UUID REFERENCES users(id),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_brigade_target ON brigade_clusters(target_type, target_key);
+CREATE INDEX idx_brigade_clusters_area_key ON brigade_clusters(area_key);
+CREATE INDEX idx_brigade_clusters_status ON brigade_clusters(status, updated_at DESC);
+
+ALTER TABLE reports ADD COLUMN network_hash VARCHAR(64);
+ALTER TABLE reports ADD COLUMN brigade_cluster_id UUID REFERENCES brigade_clusters(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_reports_brigade_cluster_id ON reports(brigade_cluster_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reports_brigade_cluster_id;
+ALTER TABLE reports DROP COLUMN IF EXISTS brigade_cluster_id;
+ALTER TABLE reports DROP COLUMN IF EXISTS network_hash;
+
+DROP INDEX IF EXISTS idx_brigade_clusters_status;
+DROP INDEX IF EXISTS idx_brigade_clusters_area_key;
+DROP INDEX IF EXISTS idx_brigade_target;
+DROP TABLE IF EXISTS brigade_clusters;
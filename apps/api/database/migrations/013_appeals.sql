@@ -0,0 +1,37 @@
+-- +goose Up
+-- Reporter appeals against spam decisions and intake blocks. Reporters prove
+-- standing with the follow-up token returned at submission (stored hashed) or
+-- with the signed reference returned when intake turned them away.
+
+ALTER TABLE reports ADD COLUMN follow_up_hash VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_reports_follow_up_hash ON reports(follow_up_hash) WHERE follow_up_hash IS NOT NULL;
+
+CREATE TABLE appeals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('report', 'blocked')),
+    report_id UUID REFERENCES reports(id) ON DELETE CASCADE,
+    blocked_ref VARCHAR(64) UNIQUE,
+    blocked_at TIMESTAMP WITH TIME ZONE,
+    message TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'upheld', 'overturned')),
+    decision_reason TEXT,
+    decided_by UUID REFERENCES users(id),
+    decided_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK ((kind = 'report') = (report_id IS NOT NULL)),
+    CHECK ((kind = 'blocked') = (blocked_ref IS NOT NULL))
+);
+
+CREATE INDEX idx_appeals_status ON appeals(status, created_at);
+CREATE INDEX idx_appeals_report_id ON appeals(report_id);
+CREATE UNIQUE INDEX idx_appeals_report_pending ON appeals(report_id) WHERE status = 'pending';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_appeals_report_pending;
+DROP INDEX IF EXISTS idx_appeals_report_id;
+DROP INDEX IF EXISTS idx_appeals_status;
+DROP TABLE IF EXISTS appeals;
+
+DROP INDEX IF EXISTS idx_reports_follow_up_hash;
+ALTER TABLE reports DROP COLUMN IF EXISTS follow_up_hash;
@@ -0,0 +1,42 @@
+-- +goose Up
+-- Scam indicator registry: normalised URLs, domains, phone numbers, bank
+-- accounts, LINE IDs and crypto wallets extracted from scam_phishing reports.
+
+CREATE TABLE indicators (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('url', 'domain', 'phone', 'bank_account', 'line_id', 'crypto_wallet')),
+    value VARCHAR(500) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'unverified' CHECK (status IN ('unverified', 'confirmed', 'false_positive')),
+    report_count INTEGER NOT NULL DEFAULT 0,
+    first_seen_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    verified_by UUID REFERENCES users(id),
+    verified_at TIMESTAMP WITH TIME ZONE,
+    verification_note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_indicator_value ON indicators(type, value);
+CREATE INDEX idx_indicators_status ON indicators(status);
+CREATE INDEX idx_indicators_last_seen_at ON indicators(last_seen_at DESC);
+CREATE INDEX idx_indicators_value_trgm ON indicators USING GIN (value gin_trgm_ops);
+
+CREATE TABLE indicator_reports (
+    indicator_id UUID NOT NULL REFERENCES indicators(id) ON DELETE CASCADE,
+    report_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    seen_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (indicator_id, report_id)
+);
+
+CREATE INDEX idx_indicator_reports_report_id ON indicator_reports(report_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_indicator_reports_report_id;
+DROP TABLE IF EXISTS indicator_reports;
+
+DROP INDEX IF EXISTS idx_indicators_value_trgm;
+DROP INDEX IF EXISTS idx_indicators_last_seen_at;
+DROP INDEX IF EXISTS idx_indicators_status;
+DROP INDEX IF EXISTS idx_indicator_value;
+DROP TABLE IF EXISTS indicators;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Hash-prefix (k-anonymity) lookup of verified scam indicators: each
+-- indicator carries the SHA-256 hex of "type:value", matched by prefix.
+
+ALTER TABLE indicators ADD COLUMN hash VARCHAR(64);
+
+UPDATE indicators SET hash = encode(sha256(convert_to(type || ':' || value, 'UTF8')), 'hex');
+
+ALTER TABLE indicators ALTER COLUMN hash SET NOT NULL;
+
+CREATE INDEX idx_indicators_hash ON indicators(hash text_pattern_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_indicators_hash;
+ALTER TABLE indicators DROP COLUMN IF EXISTS hash;
@@ -0,0 +1,53 @@
+-- +goose Up
+-- Misinformation claim registry: claims repeated in reports and incidents,
+-- our verdict and its public explanation, published as schema.org ClaimReview.
+
+CREATE TABLE claims (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    text TEXT NOT NULL,
+    language VARCHAR(20),
+    appearances JSONB NOT NULL DEFAULT '[]',
+    verdict VARCHAR(20) NOT NULL DEFAULT 'unverified' CHECK (verdict IN ('unverified', 'false', 'misleading', 'true')),
+    explanation TEXT,
+    evidence JSONB NOT NULL DEFAULT '[]',
+    reviewed_by UUID REFERENCES users(id),
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    published_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_claims_verdict ON claims(verdict);
+CREATE INDEX idx_claims_published_at ON claims(published_at DESC) WHERE published_at IS NOT NULL;
+CREATE INDEX idx_claims_text_trgm ON claims USING GIN (text gin_trgm_ops);
+
+CREATE TABLE claim_reports (
+    claim_id UUID NOT NULL REFERENCES claims(id) ON DELETE CASCADE,
+    report_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (claim_id, report_id)
+);
+
+CREATE INDEX idx_claim_reports_report_id ON claim_reports(report_id);
+
+CREATE TABLE claim_incidents (
+    claim_id UUID NOT NULL REFERENCES claims(id) ON DELETE CASCADE,
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (claim_id, incident_id)
+);
+
+CREATE INDEX idx_claim_incidents_incident_id ON claim_incidents(incident_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_claim_incidents_incident_id;
+DROP TABLE IF EXISTS claim_incidents;
+
+DROP INDEX IF EXISTS idx_claim_reports_report_id;
+DROP TABLE IF EXISTS claim_reports;
+
+DROP INDEX IF EXISTS idx_claims_text_trgm;
+DROP INDEX IF EXISTS idx_claims_published_at;
+DROP INDEX IF EXISTS idx_claims_verdict;
+DROP TABLE IF EXISTS claims;
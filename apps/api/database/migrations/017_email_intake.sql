@@ -0,0 +1,33 @@
+-- +goose Up
+-- Email intake: reports record the channel they arrived through, forwarded
+-- messages are kept as evidence files, and email addresses become indicators.
+
+ALTER TABLE reports ADD COLUMN channel VARCHAR(20) NOT NULL DEFAULT 'web';
+
+CREATE TABLE evidence_files (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    filename VARCHAR(255),
+    size BIGINT NOT NULL,
+    sha256 VARCHAR(64) NOT NULL,
+    data BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_evidence_files_report_id ON evidence_files(report_id);
+
+ALTER TABLE indicators DROP CONSTRAINT indicators_type_check;
+ALTER TABLE indicators ADD CONSTRAINT indicators_type_check
+    CHECK (type IN ('url', 'domain', 'email', 'phone', 'bank_account', 'line_id', 'crypto_wallet'));
+
+-- +goose Down
+DELETE FROM indicators WHERE type = 'email';
+ALTER TABLE indicators DROP CONSTRAINT indicators_type_check;
+ALTER TABLE indicators ADD CONSTRAINT indicators_type_check
+    CHECK (type IN ('url', 'domain', 'phone', 'bank_account', 'line_id', 'crypto_wallet'));
+
+DROP INDEX IF EXISTS idx_evidence_files_report_id;
+DROP TABLE IF EXISTS evidence_files;
+ALTER TABLE reports DROP COLUMN IF EXISTS channel;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Chat intake: reporters who file through the LINE or WhatsApp bot can be
+-- sent status updates until their report is closed.
+
+CREATE TABLE report_subscribers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    channel VARCHAR(20) NOT NULL,
+    recipient VARCHAR(100) NOT NULL,
+    lang VARCHAR(10) NOT NULL,
+    last_status VARCHAR(50) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_report_subscribers_report_id ON report_subscribers(report_id);
+CREATE INDEX idx_report_subscribers_expires_at ON report_subscribers(expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_report_subscribers_expires_at;
+DROP INDEX IF EXISTS idx_report_subscribers_report_id;
+DROP TABLE IF EXISTS report_subscribers;
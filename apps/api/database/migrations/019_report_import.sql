@@ -0,0 +1,28 @@
+-- +goose Up
+-- Bulk report import: drill scenarios and historical backfills are loaded as
+-- batches, and every imported report points back at its batch.
+
+CREATE TABLE import_batches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(200) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    atomic BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(20) NOT NULL,
+    total INTEGER NOT NULL DEFAULT 0,
+    imported INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_import_batches_status ON import_batches(status);
+
+ALTER TABLE reports ADD COLUMN import_batch_id UUID REFERENCES import_batches(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_reports_import_batch_id ON reports(import_batch_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reports_import_batch_id;
+ALTER TABLE reports DROP COLUMN IF EXISTS import_batch_id;
+DROP INDEX IF EXISTS idx_import_batches_status;
+DROP TABLE IF EXISTS import_batches;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Data retention: closed and spam reports are anonymized once past their
+-- window and keep the time it happened. Purge summaries go to audit_logs.
+
+ALTER TABLE reports ADD COLUMN purged_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_evidence_files_created_at ON evidence_files(created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_evidence_files_created_at;
+ALTER TABLE reports DROP COLUMN IF EXISTS purged_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Field encryption: contact refs and chat recipients hold envelope-encrypted
+-- values, which are longer than the plaintext columns allowed. Evidence file
+-- data stays bytea and is sealed in place. Existing plaintext stays readable
+-- and is sealed by cmd/key-rewrap.
+
+ALTER TABLE reports ALTER COLUMN reporter_contact_ref TYPE TEXT;
+ALTER TABLE report_subscribers ALTER COLUMN recipient TYPE TEXT;
+
+-- +goose Down
+-- Values must be decrypted before going down; sealed values do not fit.
+ALTER TABLE report_subscribers ALTER COLUMN recipient TYPE VARCHAR(100);
+ALTER TABLE reports ALTER COLUMN reporter_contact_ref TYPE VARCHAR(255);
@@ -0,0 +1,44 @@
+-- +goose Up
+-- Data subject access and erasure requests (UK GDPR Articles 15 and 17).
+-- The subject's identifiers are kept only while the request is open; closed
+-- requests keep their outcome.
+
+CREATE TABLE subject_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('access', 'erasure')),
+    status VARCHAR(20) NOT NULL DEFAULT 'received' CHECK (status IN ('received', 'verified', 'fulfilled', 'rejected')),
+    follow_up_hash VARCHAR(64),
+    contact_ref TEXT,
+    participant_hash VARCHAR(64),
+    note TEXT,
+    verification_note TEXT,
+    verified_by UUID REFERENCES users(id),
+    verified_at TIMESTAMP WITH TIME ZONE,
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    extended_at TIMESTAMP WITH TIME ZONE,
+    extension_reason TEXT,
+    rejection_reason TEXT,
+    result JSONB,
+    closed_by UUID REFERENCES users(id),
+    closed_at TIMESTAMP WITH TIME ZONE,
+    reminded_at TIMESTAMP WITH TIME ZONE,
+    overdue_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_subject_requests_status ON subject_requests(status);
+CREATE INDEX idx_subject_requests_due_at ON subject_requests(due_at);
+
+-- Subject lookups by participant hash
+CREATE INDEX idx_quiz_results_participant_hash ON quiz_results(participant_hash);
+CREATE INDEX idx_training_participants_participant_hash ON training_participants(participant_hash);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_training_participants_participant_hash;
+DROP INDEX IF EXISTS idx_quiz_results_participant_hash;
+DROP INDEX IF EXISTS idx_subject_requests_due_at;
+DROP INDEX IF EXISTS idx_subject_requests_status;
+DROP TABLE IF EXISTS subject_requests;
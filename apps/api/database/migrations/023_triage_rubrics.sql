@@ -0,0 +1,35 @@
+-- +goose Up
+-- Triage rubrics: versioned rules per report category for the evidence each
+-- severity needs and the rationale fields and confirmations a decision must
+-- carry. One version is active; decisions record the version they met.
+
+CREATE TABLE triage_rubrics (
+    version INTEGER PRIMARY KEY,
+    note TEXT,
+    rules JSONB NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_triage_rubrics_active ON triage_rubrics(active) WHERE active;
+
+-- Version 1 only stops accepting or escalating high severities without evidence
+INSERT INTO triage_rubrics (version, note, rules, active) VALUES (
+    1,
+    'Initial rubric',
+    '{"default": {"decisions": ["accept", "escalate"], "minEvidence": {"S4": "E2", "S3": "E1"}}}',
+    TRUE
+);
+
+ALTER TABLE triage_decisions ADD COLUMN rubric_version INTEGER REFERENCES triage_rubrics(version);
+ALTER TABLE triage_decisions ADD COLUMN rationale_fields JSONB;
+ALTER TABLE triage_decisions ADD COLUMN confirmations JSONB DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE triage_decisions DROP COLUMN IF EXISTS confirmations;
+ALTER TABLE triage_decisions DROP COLUMN IF EXISTS rationale_fields;
+ALTER TABLE triage_decisions DROP COLUMN IF EXISTS rubric_version;
+
+DROP INDEX IF EXISTS idx_triage_rubrics_active;
+DROP TABLE IF EXISTS triage_rubrics;
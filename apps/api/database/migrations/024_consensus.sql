@@ -0,0 +1,48 @@
+-- +goose Up
+-- Consensus rounds: high-severity triage decisions need a second, blind
+-- review. The decision is final when both reviewers agree on decision and
+-- severity; otherwise the round is disputed until a lead breaks the tie.
+
+CREATE TABLE consensus_rounds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_id UUID NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'agreed', 'disputed', 'resolved')),
+    decision_id UUID REFERENCES triage_decisions(id) ON DELETE SET NULL,
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_consensus_rounds_report ON consensus_rounds(report_id);
+CREATE INDEX idx_consensus_rounds_status ON consensus_rounds(status);
+-- A report has at most one round in progress
+CREATE UNIQUE INDEX idx_consensus_rounds_pending ON consensus_rounds(report_id) WHERE status IN ('open', 'disputed');
+
+CREATE TABLE triage_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    round_id UUID NOT NULL REFERENCES consensus_rounds(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    decision VARCHAR(50) NOT NULL CHECK (decision IN ('accept', 'reject', 'needs_more_info', 'escalate')),
+    severity_final VARCHAR(10) NOT NULL CHECK (severity_final IN ('S0', 'S1', 'S2', 'S3', 'S4')),
+    evidence_level VARCHAR(10) CHECK (evidence_level IN ('E0', 'E1', 'E2', 'E3')),
+    rationale TEXT,
+    rationale_fields JSONB,
+    confirmations JSONB DEFAULT '[]',
+    rubric_version INTEGER REFERENCES triage_rubrics(version),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_triage_review_reviewer ON triage_reviews(round_id, reviewer_id);
+
+ALTER TABLE triage_decisions ADD COLUMN round_id UUID REFERENCES consensus_rounds(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE triage_decisions DROP COLUMN IF EXISTS round_id;
+
+DROP INDEX IF EXISTS idx_triage_review_reviewer;
+DROP TABLE IF EXISTS triage_reviews;
+
+DROP INDEX IF EXISTS idx_consensus_rounds_pending;
+DROP INDEX IF EXISTS idx_consensus_rounds_status;
+DROP INDEX IF EXISTS idx_consensus_rounds_report;
+DROP TABLE IF EXISTS consensus_rounds;
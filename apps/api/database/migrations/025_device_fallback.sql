@@ -0,0 +1,9 @@
+-- +goose Up
+-- Reports whose device hash was derived from the user agent and network
+-- prefix, because the client sent no device ID. Unrelated reporters can share
+-- such a hash, so it is not used for reputation.
+
+ALTER TABLE reports ADD COLUMN device_fallback BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE reports DROP COLUMN IF EXISTS device_fallback;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- When a breach's notification was sent. Breaches without one are notified
+-- again on the next check, so a failed notification is retried.
+
+ALTER TABLE sla_breaches ADD COLUMN notified_at TIMESTAMP WITH TIME ZONE;
+
+-- Breaches recorded before this column are treated as notified
+UPDATE sla_breaches SET notified_at = detected_at;
+
+-- +goose Down
+ALTER TABLE sla_breaches DROP COLUMN IF EXISTS notified_at;
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -28,8 +29,113 @@ type Config struct {
 	RateLimitRequests int
 	RateLimitWindow   time.Duration
 
+	// Public indicator lookup rate limiting, on top of the global limit
+	LookupRateLimitRequests int
+	LookupRateLimitWindow   time.Duration
+
 	// CAP settings
 	CAPSender string
+
+	// Duplicate detection
+	DuplicateThreshold float64
+	DuplicateLookback  time.Duration
+
+	// Location settings
+	LocationPrecision int // geohash length stored for report coordinates
+
+	// Triage queue settings
+	TriageLeaseTTL          time.Duration
+	TriageConsensusSeverity string // lowest severity needing a second review; "none" disables
+
+	// SLA settings
+	SLACheckInterval time.Duration // 0 disables the SLA worker
+
+	// Intake gate settings
+	IntakeGates               []string // enabled gates: pow, captcha, device, reputation, brigade
+	IntakeSecret              string   // signs challenges and device hashes; required outside dev
+	IntakeQuarantineThreshold float64
+	PowDifficulty             int // leading zero bits
+	PowTTL                    time.Duration
+	CaptchaVerifyURL          string // siteverify endpoint; empty uses the local verifier
+	CaptchaSecret             string
+	CaptchaTestToken          string // token accepted by the local verifier
+	DeviceLimit               int    // reports per device per window before it is flagged
+	DeviceWindow              time.Duration
+
+	// Reporter reputation settings
+	ReputationSalt          string        // salts pseudonymous reporter keys
+	ReputationRotation      time.Duration // how often reporter keys change
+	ReputationRetention     time.Duration // how long an unused key is kept
+	ReputationPurgeInterval time.Duration // 0 disables the purge worker
+
+	// Coordinated reporting (brigade) detection settings
+	BrigadeWindow      time.Duration // how far back a burst is looked for
+	BrigadeMinReports  int           // reports on one target before a burst is considered
+	BrigadeSourceMin   int           // reports sharing a network or phrasing that count as correlated
+	BrigadeTightenFor  time.Duration // how long intake stays tightened after a detection
+	BrigadeTightenRisk float64       // risk the brigade gate adds while tightened
+
+	// Appeals
+	AppealWindow time.Duration // how long a blocked-intake reference can be appealed
+
+	// STIX export settings
+	STIXIdentity string // name of the identity that creates exported objects
+	STIXTLP      string // TLP level marking exported objects: white, green, amber or red
+
+	// Public fact-check settings
+	PublisherName string // organization named as the author of claim reviews
+	PublicSiteURL string // public web app; claim reviews live at <url>/claims/<id>
+
+	// Email intake gateway
+	EmailSMTPAddr         string        // embedded SMTP listener, e.g. ":2525"; empty disables it
+	EmailHostname         string        // name announced by the SMTP listener
+	EmailRecipients       []string      // accepted addresses, or "@domain" for a whole domain; empty accepts all
+	EmailMaildropDir      string        // directory polled for .eml files; empty disables it
+	EmailMaildropInterval time.Duration // how often the maildrop is polled
+	EmailMaxSize          int64         // largest message accepted, in bytes
+	EmailZone             string        // pilot zone given to emailed reports; empty leaves them unzoned
+	EmailReplyFrom        string        // sender of follow-up token replies
+	EmailAuthservID       string        // authserv-id of the upstream MTA's Authentication-Results; empty sends no replies
+	SMTPRelayAddr         string        // relay for outgoing mail; empty only logs replies
+	SMTPRelayUsername     string
+	SMTPRelayPassword     string
+
+	// Chat-platform intake (LINE / WhatsApp bots)
+	LINEChannelSecret      string // verifies LINE webhooks; empty disables the LINE webhook
+	LINEChannelAccessToken string // sends LINE replies; empty only logs them
+	LINEAPIBaseURL         string
+	WhatsAppAppSecret      string // verifies WhatsApp webhooks; empty disables the WhatsApp webhook
+	WhatsAppVerifyToken    string // answers the WhatsApp subscription handshake
+	WhatsAppAccessToken    string // sends WhatsApp messages; empty only logs them
+	WhatsAppPhoneNumberID  string // business number whose messages are taken
+	WhatsAppAPIBaseURL     string
+	ChatZone               string        // pilot zone given to chat reports; empty leaves them unzoned
+	ChatSessionTTL         time.Duration // how long an unanswered conversation is kept
+	ChatSubscriptionTTL    time.Duration // how long status updates are pushed for a report
+	ChatStatusInterval     time.Duration // how often report statuses are checked for updates
+
+	// Bulk report import
+	ImportMaxRows int   // most rows accepted in one import
+	ImportMaxSize int64 // largest import file accepted, in bytes
+
+	// Data retention windows; 0 keeps a class indefinitely
+	RetentionReports    time.Duration // content of decided reports
+	RetentionContacts   time.Duration // reporter contact refs and pseudonymous hashes
+	RetentionEvidence   time.Duration // stored evidence files
+	RetentionAuditIPs   time.Duration // actor IPs in the audit log
+	RetentionAuditLogs  time.Duration // audit log entries
+	RetentionInterval   time.Duration // 0 disables the purge worker
+	RetentionSigningKey string        // signs purge summaries; required outside dev
+
+	// Data subject requests
+	SubjectReminder      time.Duration // how long before a deadline admins are reminded
+	SubjectCheckInterval time.Duration // how often deadlines are checked; 0 disables reminders
+
+	// Field encryption of contact refs, chat recipients and evidence files
+	EncryptionKeyringFile string   // JSON keyring with several master keys; replaces the settings below
+	EncryptionKey         string   // base64 256-bit master key new values are sealed with
+	EncryptionKeyID       string   // ID recorded with values sealed under EncryptionKey
+	EncryptionRetiredKeys []string // "id:base64" master keys still accepted for reading
 }
 
 // Load loads configuration from environment variables
@@ -46,6 +152,94 @@ func Load() *Config {
 		RateLimitRequests: getEnvInt("RATE_LIMIT_REQUESTS", 100),
 		RateLimitWindow:   getEnvDuration("RATE_LIMIT_WINDOW", time.Minute),
 		CAPSender:         getEnv("CAP_SENDER", "the-hive@example.invalid"),
+
+		LookupRateLimitRequests: getEnvInt("LOOKUP_RATE_LIMIT_REQUESTS", 30),
+		LookupRateLimitWindow:   getEnvDuration("LOOKUP_RATE_LIMIT_WINDOW", time.Minute),
+
+		DuplicateThreshold: getEnvFloat("DUPLICATE_THRESHOLD", 0.6),
+		DuplicateLookback:  getEnvDuration("DUPLICATE_LOOKBACK", 72*time.Hour),
+
+		LocationPrecision: getEnvInt("LOCATION_PRECISION", 6),
+
+		TriageLeaseTTL:          getEnvDuration("TRIAGE_LEASE_TTL", 15*time.Minute),
+		TriageConsensusSeverity: getEnv("TRIAGE_CONSENSUS_SEVERITY", "S3"),
+
+		SLACheckInterval: getEnvDuration("SLA_CHECK_INTERVAL", time.Minute),
+
+		IntakeGates:               getEnvList("INTAKE_GATES", []string{"pow", "device", "reputation", "brigade"}),
+		IntakeSecret:              getEnv("INTAKE_SECRET", ""),
+		IntakeQuarantineThreshold: getEnvFloat("INTAKE_QUARANTINE_THRESHOLD", 0.7),
+		PowDifficulty:             getEnvInt("POW_DIFFICULTY", 16),
+		PowTTL:                    getEnvDuration("POW_TTL", 10*time.Minute),
+		CaptchaVerifyURL:          getEnv("CAPTCHA_VERIFY_URL", ""),
+		CaptchaSecret:             getEnv("CAPTCHA_SECRET", ""),
+		CaptchaTestToken:          getEnv("CAPTCHA_TEST_TOKEN", ""),
+		DeviceLimit:               getEnvInt("DEVICE_LIMIT", 5),
+		DeviceWindow:              getEnvDuration("DEVICE_WINDOW", time.Hour),
+
+		ReputationSalt:          getEnv("REPUTATION_SALT", ""),
+		ReputationRotation:      getEnvDuration("REPUTATION_ROTATION", 90*24*time.Hour),
+		ReputationRetention:     getEnvDuration("REPUTATION_RETENTION", 180*24*time.Hour),
+		ReputationPurgeInterval: getEnvDuration("REPUTATION_PURGE_INTERVAL", time.Hour),
+
+		BrigadeWindow:      getEnvDuration("BRIGADE_WINDOW", 30*time.Minute),
+		BrigadeMinReports:  getEnvInt("BRIGADE_MIN_REPORTS", 5),
+		BrigadeSourceMin:   getEnvInt("BRIGADE_SOURCE_MIN", 3),
+		BrigadeTightenFor:  getEnvDuration("BRIGADE_TIGHTEN_FOR", 2*time.Hour),
+		BrigadeTightenRisk: getEnvFloat("BRIGADE_TIGHTEN_RISK", 0.5),
+
+		AppealWindow: getEnvDuration("APPEAL_WINDOW", 30*24*time.Hour),
+
+		STIXIdentity: getEnv("STIX_IDENTITY", "The Hive"),
+		STIXTLP:      getEnv("STIX_TLP", "green"),
+
+		PublisherName: getEnv("PUBLISHER_NAME", "The Hive"),
+		PublicSiteURL: getEnv("PUBLIC_SITE_URL", "http://localhost:3000"),
+
+		EmailSMTPAddr:         getEnv("EMAIL_SMTP_ADDR", ""),
+		EmailHostname:         getEnv("EMAIL_HOSTNAME", "localhost"),
+		EmailRecipients:       getEnvList("EMAIL_RECIPIENTS", nil),
+		EmailMaildropDir:      getEnv("EMAIL_MAILDROP_DIR", ""),
+		EmailMaildropInterval: getEnvDuration("EMAIL_MAILDROP_INTERVAL", 30*time.Second),
+		EmailMaxSize:          int64(getEnvInt("EMAIL_MAX_SIZE", 10<<20)),
+		EmailZone:             getEnv("EMAIL_ZONE", ""),
+		EmailReplyFrom:        getEnv("EMAIL_REPLY_FROM", "report@the-hive.example.invalid"),
+		EmailAuthservID:       getEnv("EMAIL_AUTHSERV_ID", ""),
+		SMTPRelayAddr:         getEnv("SMTP_RELAY_ADDR", ""),
+		SMTPRelayUsername:     getEnv("SMTP_RELAY_USERNAME", ""),
+		SMTPRelayPassword:     getEnv("SMTP_RELAY_PASSWORD", ""),
+
+		LINEChannelSecret:      getEnv("LINE_CHANNEL_SECRET", ""),
+		LINEChannelAccessToken: getEnv("LINE_CHANNEL_ACCESS_TOKEN", ""),
+		LINEAPIBaseURL:         getEnv("LINE_API_BASE_URL", "https://api.line.me"),
+		WhatsAppAppSecret:      getEnv("WHATSAPP_APP_SECRET", ""),
+		WhatsAppVerifyToken:    getEnv("WHATSAPP_VERIFY_TOKEN", ""),
+		WhatsAppAccessToken:    getEnv("WHATSAPP_ACCESS_TOKEN", ""),
+		WhatsAppPhoneNumberID:  getEnv("WHATSAPP_PHONE_NUMBER_ID", ""),
+		WhatsAppAPIBaseURL:     getEnv("WHATSAPP_API_BASE_URL", "https://graph.facebook.com/v19.0"),
+		ChatZone:               getEnv("CHAT_ZONE", ""),
+		ChatSessionTTL:         getEnvDuration("CHAT_SESSION_TTL", 30*time.Minute),
+		ChatSubscriptionTTL:    getEnvDuration("CHAT_SUBSCRIPTION_TTL", 30*24*time.Hour),
+		ChatStatusInterval:     getEnvDuration("CHAT_STATUS_INTERVAL", time.Minute),
+
+		ImportMaxRows: getEnvInt("IMPORT_MAX_ROWS", 5000),
+		ImportMaxSize: int64(getEnvInt("IMPORT_MAX_SIZE", 10<<20)),
+
+		RetentionReports:    getEnvDuration("RETENTION_REPORTS", 180*24*time.Hour),
+		RetentionContacts:   getEnvDuration("RETENTION_CONTACTS", 90*24*time.Hour),
+		RetentionEvidence:   getEnvDuration("RETENTION_EVIDENCE", 90*24*time.Hour),
+		RetentionAuditIPs:   getEnvDuration("RETENTION_AUDIT_IPS", 90*24*time.Hour),
+		RetentionAuditLogs:  getEnvDuration("RETENTION_AUDIT_LOGS", 0),
+		RetentionInterval:   getEnvDuration("RETENTION_INTERVAL", 24*time.Hour),
+		RetentionSigningKey: getEnv("RETENTION_SIGNING_KEY", ""),
+
+		SubjectReminder:      getEnvDuration("SUBJECT_REMINDER", 7*24*time.Hour),
+		SubjectCheckInterval: getEnvDuration("SUBJECT_CHECK_INTERVAL", time.Hour),
+
+		EncryptionKeyringFile: getEnv("ENCRYPTION_KEYRING_FILE", ""),
+		EncryptionKey:         getEnv("ENCRYPTION_KEY", ""),
+		EncryptionKeyID:       getEnv("ENCRYPTION_KEY_ID", "env-1"),
+		EncryptionRetiredKeys: getEnvList("ENCRYPTION_RETIRED_KEYS", nil),
 	}
 }
 
@@ -75,6 +269,15 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
@@ -83,3 +286,17 @@ func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
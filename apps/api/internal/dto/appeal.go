@@ -0,0 +1,23 @@
+package dto
+
+// CreateAppealRequest represents the request body for a reporter's appeal.
+// Exactly one of the follow-up token or the blocked reference is required.
+type CreateAppealRequest struct {
+	FollowUpToken string `json:"followUpToken,omitempty" binding:"required_without=BlockedRef,excluded_with=BlockedRef,max=100"`
+	BlockedRef    string `json:"blockedRef,omitempty" binding:"required_without=FollowUpToken,excluded_with=FollowUpToken,max=100"`
+	Message       string `json:"message" binding:"required,max=2000"`
+}
+
+// ListAppealsQuery represents query parameters for listing appeals
+type ListAppealsQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Status   string `form:"status,omitempty" binding:"omitempty,oneof=pending upheld overturned"`
+	Kind     string `form:"kind,omitempty" binding:"omitempty,oneof=report blocked"`
+}
+
+// DecideAppealRequest represents the request body for deciding an appeal
+type DecideAppealRequest struct {
+	Decision string `json:"decision" binding:"required,oneof=upheld overturned"`
+	Reason   string `json:"reason" binding:"required,max=1000"`
+}
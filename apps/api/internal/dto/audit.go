@@ -0,0 +1,13 @@
+package dto
+
+// ListAuditLogsQuery represents query parameters for listing audit logs
+type ListAuditLogsQuery struct {
+	Page       int    `form:"page,default=1" binding:"min=1"`
+	PageSize   int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	ObjectType string `form:"objectType,omitempty" binding:"max=50"`
+	ObjectID   string `form:"objectId,omitempty" binding:"omitempty,uuid"`
+	ActorID    string `form:"actorId,omitempty" binding:"omitempty,uuid"`
+	Action     string `form:"action,omitempty" binding:"max=100"`
+	BatchID    string `form:"batchId,omitempty" binding:"omitempty,uuid"`
+	Cursor     string `form:"cursor,omitempty" binding:"max=200"`
+}
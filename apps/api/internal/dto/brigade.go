@@ -0,0 +1,14 @@
+package dto
+
+// ListBrigadesQuery represents query parameters for listing brigade clusters
+type ListBrigadesQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Status   string `form:"status,omitempty" binding:"omitempty,oneof=open confirmed dismissed"`
+}
+
+// ResolveBrigadeRequest represents the request body for reviewing a brigade cluster
+type ResolveBrigadeRequest struct {
+	Outcome string `json:"outcome" binding:"required,oneof=confirmed dismissed"`
+	Reason  string `json:"reason" binding:"required,max=1000"`
+}
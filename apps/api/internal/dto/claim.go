@@ -0,0 +1,53 @@
+package dto
+
+// CreateClaimRequest represents the request body for recording a claim
+type CreateClaimRequest struct {
+	Text        string   `json:"text" binding:"required,max=2000"`
+	Language    string   `json:"language,omitempty" binding:"omitempty,bcp47_language_tag"`
+	Appearances []string `json:"appearances,omitempty" binding:"max=20,dive,url,max=2000"`
+	ReportIDs   []string `json:"reportIds,omitempty" binding:"max=100,dive,uuid"`
+	IncidentIDs []string `json:"incidentIds,omitempty" binding:"max=100,dive,uuid"`
+}
+
+// UpdateClaimRequest represents the request body for editing a claim.
+// Omitted fields are left unchanged; explanation and evidence edits of a
+// published claim update its review.
+type UpdateClaimRequest struct {
+	Text        string   `json:"text,omitempty" binding:"omitempty,max=2000"`
+	Language    string   `json:"language,omitempty" binding:"omitempty,bcp47_language_tag"`
+	Appearances []string `json:"appearances,omitempty" binding:"omitempty,max=20,dive,url,max=2000"`
+	Explanation string   `json:"explanation,omitempty" binding:"omitempty,max=5000"`
+	Evidence    []string `json:"evidence,omitempty" binding:"omitempty,max=20,dive,url,max=2000"`
+}
+
+// ClaimVerdictRequest represents the request body for rating a claim. Rated
+// verdicts are published and need a public explanation.
+type ClaimVerdictRequest struct {
+	Verdict     string   `json:"verdict" binding:"required,oneof=unverified false misleading true"`
+	Explanation string   `json:"explanation,omitempty" binding:"required_unless=Verdict unverified,max=5000"`
+	Evidence    []string `json:"evidence,omitempty" binding:"omitempty,max=20,dive,url,max=2000"`
+}
+
+// LinkClaimRequest represents the request body for linking reports and
+// incidents to a claim
+type LinkClaimRequest struct {
+	ReportIDs   []string `json:"reportIds,omitempty" binding:"required_without=IncidentIDs,max=100,dive,uuid"`
+	IncidentIDs []string `json:"incidentIds,omitempty" binding:"required_without=ReportIDs,max=100,dive,uuid"`
+}
+
+// ListClaimsQuery represents query parameters for listing claims
+type ListClaimsQuery struct {
+	Page       int    `form:"page,default=1" binding:"min=1"`
+	PageSize   int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Verdict    string `form:"verdict,omitempty" binding:"omitempty,oneof=unverified false misleading true"`
+	Q          string `form:"q,omitempty" binding:"max=200"`
+	ReportID   string `form:"reportId,omitempty" binding:"omitempty,uuid"`
+	IncidentID string `form:"incidentId,omitempty" binding:"omitempty,uuid"`
+}
+
+// ListClaimReviewsQuery represents query parameters for the public
+// ClaimReview feed
+type ListClaimReviewsQuery struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"pageSize,default=50" binding:"min=1,max=200"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+// ListConsensusRoundsQuery represents query parameters for listing consensus rounds
+type ListConsensusRoundsQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Status   string `form:"status,omitempty" binding:"omitempty,oneof=open agreed disputed resolved"`
+	ReportID string `form:"reportId,omitempty" binding:"omitempty,uuid"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+// ImportReportsQuery represents query parameters for a bulk report import.
+// The body is the file itself: CSV with a header row, or JSON Lines with one
+// CreateReportRequest object per line.
+type ImportReportsQuery struct {
+	Format string `form:"format" binding:"required,oneof=csv jsonl"`
+	Name   string `form:"name" binding:"required,max=200"`
+	DryRun bool   `form:"dryRun"`
+	Atomic bool   `form:"atomic"`
+}
+
+// ListImportsQuery represents query parameters for listing import batches
+type ListImportsQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Status   string `form:"status,omitempty" binding:"omitempty,oneof=completed partial rolled_back"`
+}
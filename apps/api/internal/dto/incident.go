@@ -0,0 +1,48 @@
+package dto
+
+// CreateIncidentRequest represents the request body for creating an incident
+type CreateIncidentRequest struct {
+	Title     string   `json:"title" binding:"required,max=255"`
+	Category  string   `json:"category" binding:"required,oneof=suspicious_item suspicious_person harassment_stalking scam_phishing misinformation_panic crowd_disorder infrastructure_hazard other"`
+	Severity  string   `json:"severity,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+	Summary   string   `json:"summary,omitempty"`
+	AreaHint  string   `json:"areaHint,omitempty" binding:"max=500"`
+	ReportIDs []string `json:"reportIds,omitempty" binding:"omitempty,dive,uuid"`
+}
+
+// UpdateIncidentRequest represents the request body for updating an incident
+type UpdateIncidentRequest struct {
+	Title    string `json:"title,omitempty" binding:"omitempty,max=255"`
+	Status   string `json:"status,omitempty" binding:"omitempty,oneof=open monitoring resolved closed"`
+	Severity string `json:"severity,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+	Summary  string `json:"summary,omitempty"`
+	AreaHint string `json:"areaHint,omitempty" binding:"omitempty,max=500"`
+}
+
+// LinkReportsRequest represents the request body for attaching reports to an incident
+type LinkReportsRequest struct {
+	ReportIDs []string `json:"reportIds" binding:"required,min=1,dive,uuid"`
+}
+
+// MergeIncidentsRequest represents the request body for merging incidents into a target
+type MergeIncidentsRequest struct {
+	SourceIDs []string `json:"sourceIds" binding:"required,min=1,dive,uuid"`
+	Reason    string   `json:"reason,omitempty"`
+}
+
+// SplitIncidentRequest represents the request body for splitting reports off an incident
+type SplitIncidentRequest struct {
+	Title     string   `json:"title" binding:"required,max=255"`
+	Severity  string   `json:"severity,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+	ReportIDs []string `json:"reportIds" binding:"required,min=1,dive,uuid"`
+	Reason    string   `json:"reason,omitempty"`
+}
+
+// ListIncidentsQuery represents query parameters for listing incidents
+type ListIncidentsQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Status   string `form:"status,omitempty" binding:"omitempty,oneof=open monitoring resolved closed merged"`
+	Category string `form:"category,omitempty" binding:"omitempty,oneof=suspicious_item suspicious_person harassment_stalking scam_phishing misinformation_panic crowd_disorder infrastructure_hazard other"`
+	Severity string `form:"severity,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+}
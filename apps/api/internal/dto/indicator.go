@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+// ListIndicatorsQuery represents query parameters for listing scam indicators
+type ListIndicatorsQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Type     string `form:"type,omitempty" binding:"omitempty,oneof=url domain email phone bank_account line_id crypto_wallet"`
+	Status   string `form:"status,omitempty" binding:"omitempty,oneof=unverified confirmed false_positive"`
+	Q        string `form:"q,omitempty" binding:"max=200"`
+	ReportID string `form:"reportId,omitempty" binding:"omitempty,uuid"`
+}
+
+// ExportIndicatorsQuery represents query parameters for the STIX export
+type ExportIndicatorsQuery struct {
+	AddedAfter time.Time `form:"added_after,omitempty" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit      int       `form:"limit,default=500" binding:"min=1,max=5000"`
+}
+
+// VerifyIndicatorRequest represents the request body for setting the
+// verification status of an indicator
+type VerifyIndicatorRequest struct {
+	Status string `json:"status" binding:"required,oneof=unverified confirmed false_positive"`
+	Note   string `json:"note,omitempty" binding:"max=1000"`
+}
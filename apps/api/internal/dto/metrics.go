@@ -0,0 +1,6 @@
+package dto
+
+// TriageAgreementQuery represents query parameters for triage agreement metrics
+type TriageAgreementQuery struct {
+	Days int `form:"days,default=90" binding:"min=1,max=730"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// ListNotificationsQuery represents query parameters for listing notifications
+type ListNotificationsQuery struct {
+	Page       int  `form:"page,default=1" binding:"min=1"`
+	PageSize   int  `form:"pageSize,default=20" binding:"min=1,max=100"`
+	UnreadOnly bool `form:"unread,omitempty"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+// ListQueueQuery represents query parameters for the triage queue
+type ListQueueQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Category string `form:"category,omitempty" binding:"omitempty,oneof=suspicious_item suspicious_person harassment_stalking scam_phishing misinformation_panic crowd_disorder infrastructure_hazard other"`
+	ZoneID   string `form:"zoneId,omitempty" binding:"max=64"`
+}
+
+// AssignReportRequest represents the request body for assigning a report
+type AssignReportRequest struct {
+	UserID string `json:"userId" binding:"required,uuid"`
+	Reason string `json:"reason,omitempty" binding:"max=1000"`
+}
@@ -5,18 +5,47 @@ type CreateReportRequest struct {
 	Category          string   `json:"category" binding:"required,oneof=suspicious_item suspicious_person harassment_stalking scam_phishing misinformation_panic crowd_disorder infrastructure_hazard other"`
 	SeveritySuggested string   `json:"severitySuggested,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
 	AreaHint          string   `json:"areaHint" binding:"required,max=500"`
+	ZoneID            string   `json:"zoneId,omitempty" binding:"max=64"`
+	Latitude          *float64 `json:"latitude,omitempty" binding:"omitempty,required_with=Longitude,min=-90,max=90"`
+	Longitude         *float64 `json:"longitude,omitempty" binding:"omitempty,required_with=Latitude,min=-180,max=180"`
 	TimeWindow        string   `json:"timeWindow,omitempty" binding:"max=100"`
 	Description       string   `json:"description" binding:"required"`
 	Evidence          []string `json:"evidence,omitempty"`
 	ReporterContact   string   `json:"reporterContact,omitempty" binding:"max=255"`
 }
 
+// ReportStatusRequest represents the request body for a report status change
+type ReportStatusRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"`
+}
+
 // ListReportsQuery represents query parameters for listing reports
 type ListReportsQuery struct {
-	Page     int    `form:"page,default=1" binding:"min=1"`
-	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
-	Status   string `form:"status,omitempty" binding:"omitempty,oneof=submitted under_review triaged escalated closed spam"`
-	Category string `form:"category,omitempty" binding:"omitempty,oneof=suspicious_item suspicious_person harassment_stalking scam_phishing misinformation_panic crowd_disorder infrastructure_hazard other"`
-	SortBy   string `form:"sortBy,default=createdAt" binding:"oneof=createdAt category status"`
-	SortDir  string `form:"sortDir,default=desc" binding:"oneof=asc desc"`
+	Page       int    `form:"page,default=1" binding:"min=1"`
+	PageSize   int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Status     string `form:"status,omitempty" binding:"omitempty,oneof=submitted under_review triaged escalated closed spam quarantined"`
+	Category   string `form:"category,omitempty" binding:"omitempty,oneof=suspicious_item suspicious_person harassment_stalking scam_phishing misinformation_panic crowd_disorder infrastructure_hazard other"`
+	IncidentID string `form:"incidentId,omitempty" binding:"omitempty,uuid"`
+	ZoneID     string `form:"zoneId,omitempty" binding:"max=64"`
+	SortBy     string `form:"sortBy,default=createdAt" binding:"oneof=createdAt category status"`
+	SortDir    string `form:"sortDir,default=desc" binding:"oneof=asc desc"`
+	Cursor     string `form:"cursor,omitempty" binding:"max=200"`
+
+	ImportBatchID string `form:"importBatchId,omitempty" binding:"omitempty,uuid"`
+}
+
+// SearchReportsQuery represents query parameters for searching reports
+type SearchReportsQuery struct {
+	Page              int    `form:"page,default=1" binding:"min=1"`
+	PageSize          int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Q                 string `form:"q,omitempty" binding:"max=200"`
+	From              string `form:"from,omitempty" binding:"omitempty,datetime=2006-01-02"`
+	To                string `form:"to,omitempty" binding:"omitempty,datetime=2006-01-02"`
+	Status            string `form:"status,omitempty" binding:"omitempty,oneof=submitted under_review triaged escalated closed spam quarantined"`
+	Category          string `form:"category,omitempty" binding:"omitempty,oneof=suspicious_item suspicious_person harassment_stalking scam_phishing misinformation_panic crowd_disorder infrastructure_hazard other"`
+	SeveritySuggested string `form:"severitySuggested,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+	SeverityFinal     string `form:"severityFinal,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+	EvidenceLevel     string `form:"evidenceLevel,omitempty" binding:"omitempty,oneof=E0 E1 E2 E3"`
+	ZoneID            string `form:"zoneId,omitempty" binding:"max=64"`
+	IncidentID        string `form:"incidentId,omitempty" binding:"omitempty,uuid"`
 }
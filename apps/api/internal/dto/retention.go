@@ -0,0 +1,7 @@
+package dto
+
+// ListRetentionRunsQuery represents query parameters for listing recorded purges
+type ListRetentionRunsQuery struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"pageSize,default=20" binding:"min=1,max=100"`
+}
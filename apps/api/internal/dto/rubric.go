@@ -0,0 +1,17 @@
+package dto
+
+// CreateRubricRequest represents the request body for saving a new triage
+// rubric version. The new version becomes the active one.
+type CreateRubricRequest struct {
+	Note       string                       `json:"note" binding:"required,max=1000"`
+	Default    RubricRuleRequest            `json:"default"`
+	Categories map[string]RubricRuleRequest `json:"categories,omitempty" binding:"max=20,dive"`
+}
+
+// RubricRuleRequest is what a decision on a report of one category must include
+type RubricRuleRequest struct {
+	Decisions     []string          `json:"decisions,omitempty" binding:"max=4,unique,dive,oneof=accept reject needs_more_info escalate"`
+	MinEvidence   map[string]string `json:"minEvidence,omitempty" binding:"max=5,dive,keys,oneof=S0 S1 S2 S3 S4,endkeys,oneof=E0 E1 E2 E3"`
+	Fields        []string          `json:"fields,omitempty" binding:"max=20,unique,dive,min=1,max=50"`
+	Confirmations []string          `json:"confirmations,omitempty" binding:"max=20,unique,dive,min=1,max=50"`
+}
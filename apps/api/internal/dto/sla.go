@@ -0,0 +1,26 @@
+package dto
+
+// UpdateSLAPolicyRequest represents the request body for updating an SLA policy
+type UpdateSLAPolicyRequest struct {
+	TriageMinutes int `json:"triageMinutes" binding:"required,min=1,max=10080"`
+	WarnMinutes   int `json:"warnMinutes" binding:"min=0,ltfield=TriageMinutes"`
+}
+
+// ListSLABreachesQuery represents query parameters for listing SLA breaches
+type ListSLABreachesQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Level    string `form:"level,omitempty" binding:"omitempty,oneof=warning breach"`
+	Severity string `form:"severity,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+	Open     bool   `form:"open,omitempty"`
+}
+
+// SLAStatsQuery represents query parameters for SLA statistics
+type SLAStatsQuery struct {
+	Days int `form:"days,default=30" binding:"min=1,max=365"`
+}
+
+// SetOnCallRequest represents the request body for toggling on-call status
+type SetOnCallRequest struct {
+	OnCall *bool `json:"onCall" binding:"required"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// CreateSubjectRequest represents the request body for logging a data
+// subject request. At least one identifier of the subject is required.
+type CreateSubjectRequest struct {
+	Kind            string     `json:"kind" binding:"required,oneof=access erasure"`
+	FollowUpToken   string     `json:"followUpToken,omitempty" binding:"required_without_all=ContactRef ParticipantHash,max=100"`
+	ContactRef      string     `json:"contactRef,omitempty" binding:"max=255"`
+	ParticipantHash string     `json:"participantHash,omitempty" binding:"max=64"`
+	Note            string     `json:"note,omitempty" binding:"max=2000"`
+	ReceivedAt      *time.Time `json:"receivedAt,omitempty"` // defaults to now
+}
+
+// ListSubjectRequestsQuery represents query parameters for listing subject requests
+type ListSubjectRequestsQuery struct {
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	Status   string `form:"status,omitempty" binding:"omitempty,oneof=received verified fulfilled rejected"`
+	Kind     string `form:"kind,omitempty" binding:"omitempty,oneof=access erasure"`
+	Overdue  bool   `form:"overdue,omitempty"`
+}
+
+// VerifySubjectRequest represents the request body for recording that a
+// subject's identity was checked
+type VerifySubjectRequest struct {
+	Note string `json:"note" binding:"required,max=1000"`
+}
+
+// ExtendSubjectRequest represents the request body for extending a deadline
+type ExtendSubjectRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"`
+}
+
+// RejectSubjectRequest represents the request body for rejecting a request
+type RejectSubjectRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"`
+}
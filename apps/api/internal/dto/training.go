@@ -39,4 +39,5 @@ type ListTrainingEventsQuery struct {
 	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
 	From     string `form:"from,omitempty"` // Format: YYYY-MM-DD
 	To       string `form:"to,omitempty"`   // Format: YYYY-MM-DD
+	Cursor   string `form:"cursor,omitempty" binding:"max=200"`
 }
@@ -6,6 +6,27 @@ type TriageRequest struct {
 	SeverityFinal string `json:"severityFinal" binding:"required,oneof=S0 S1 S2 S3 S4"`
 	EvidenceLevel string `json:"evidenceLevel,omitempty" binding:"omitempty,oneof=E0 E1 E2 E3"`
 	Rationale     string `json:"rationale,omitempty"`
+	// Structured rationale and confirmed checks, as the active rubric
+	// requires for the report's category
+	RationaleFields map[string]string `json:"rationaleFields,omitempty" binding:"max=20,dive,keys,max=50,endkeys,max=2000"`
+	Confirmations   []string          `json:"confirmations,omitempty" binding:"max=20,dive,max=50"`
+}
+
+// BulkTriageRequest represents the request body for applying one decision to
+// many reports. Besides the triage decisions it accepts spam, and duplicate,
+// which closes the reports under incidentId.
+type BulkTriageRequest struct {
+	ReportIDs     []string `json:"reportIds" binding:"required,min=1,max=100,unique,dive,uuid"`
+	Decision      string   `json:"decision" binding:"required,oneof=accept reject needs_more_info escalate spam duplicate"`
+	SeverityFinal string   `json:"severityFinal,omitempty" binding:"omitempty,oneof=S0 S1 S2 S3 S4"`
+	EvidenceLevel string   `json:"evidenceLevel,omitempty" binding:"omitempty,oneof=E0 E1 E2 E3"`
+	IncidentID    string   `json:"incidentId,omitempty" binding:"required_if=Decision duplicate,omitempty,uuid"`
+	Reason        string   `json:"reason" binding:"required,max=1000"`
+	Atomic        bool     `json:"atomic,omitempty"`
+	// Checked against the rubric for each report's category; reports
+	// whose rule is not met are skipped
+	RationaleFields map[string]string `json:"rationaleFields,omitempty" binding:"max=20,dive,keys,max=50,endkeys,max=2000"`
+	Confirmations   []string          `json:"confirmations,omitempty" binding:"max=20,dive,max=50"`
 }
 
 // ListTriageDecisionsQuery represents query parameters for listing triage decisions
@@ -14,4 +35,5 @@ type ListTriageDecisionsQuery struct {
 	PageSize int    `form:"pageSize,default=20" binding:"min=1,max=100"`
 	ReportID string `form:"reportId,omitempty" binding:"omitempty,uuid"`
 	Decision string `form:"decision,omitempty" binding:"omitempty,oneof=accept reject needs_more_info escalate"`
+	Cursor   string `form:"cursor,omitempty" binding:"max=200"`
 }
@@ -31,6 +31,7 @@ func NewAlertHandler(alertSvc *service.AlertService) *AlertHandler {
 // @Param request body dto.CreateAlertRequest true "Alert data"
 // @Success 201 {object} vo.AlertVO
 // @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
 // @Failure 500 {object} vo.ErrorVO
 // @Security BearerAuth
 // @Router /v1/alerts [post]
@@ -54,6 +55,20 @@ func (h *AlertHandler) Create(c *gin.Context) {
 	actorIP := c.ClientIP()
 	alert, err := h.alertSvc.Create(c.Request.Context(), req, userID, actorIP)
 	if err != nil {
+		if errors.Is(err, service.ErrUnknownZone) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "UNKNOWN_ZONE",
+				Message: "Zone not found in gazetteer",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrIncidentNotFound) {
+			c.JSON(http.StatusNotFound, vo.ErrorVO{
+				Code:    "NOT_FOUND",
+				Message: "Incident not found",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
 			Code:    "INTERNAL_ERROR",
 			Message: "Failed to create alert",
@@ -73,6 +88,8 @@ func (h *AlertHandler) Create(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param pageSize query int false "Page size" default(20)
 // @Param status query string false "Filter by status"
+// @Param zoneId query string false "Filter by pilot zone"
+// @Param cursor query string false "Cursor from a previous page (keyset pagination)"
 // @Success 200 {object} vo.AlertListVO
 // @Failure 400 {object} vo.ErrorVO
 // @Failure 500 {object} vo.ErrorVO
@@ -97,6 +114,13 @@ func (h *AlertHandler) List(c *gin.Context) {
 
 	alerts, err := h.alertSvc.List(c.Request.Context(), query)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_CURSOR",
+				Message: "Cursor is invalid or expired",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
 			Code:    "INTERNAL_ERROR",
 			Message: "Failed to list alerts",
@@ -191,6 +215,13 @@ func (h *AlertHandler) Update(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, service.ErrUnknownZone) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "UNKNOWN_ZONE",
+				Message: "Zone not found in gazetteer",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
 			Code:    "INTERNAL_ERROR",
 			Message: "Failed to update alert",
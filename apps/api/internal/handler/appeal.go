@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// AppealHandler handles appeal HTTP requests
+type AppealHandler struct {
+	appealSvc *service.AppealService
+}
+
+// NewAppealHandler creates a new appeal handler
+func NewAppealHandler(appealSvc *service.AppealService) *AppealHandler {
+	return &AppealHandler{appealSvc: appealSvc}
+}
+
+// Create handles POST /v1/appeals
+// @Summary File an appeal
+// @Description Appeal a spam decision (with the report's follow-up token) or an intake block (with the reference from the blocked response)
+// @Tags appeals
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateAppealRequest true "Appeal"
+// @Success 201 {object} vo.AppealStatusVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Router /v1/appeals [post]
+func (h *AppealHandler) Create(c *gin.Context) {
+	var req dto.CreateAppealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	appeal, err := h.appealSvc.Create(c.Request.Context(), req, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to file appeal")
+		return
+	}
+
+	c.JSON(http.StatusCreated, appeal)
+}
+
+// Status handles GET /v1/appeals/:id/status
+// @Summary Get appeal status
+// @Description Get the status and decision of an appeal, as shown to the reporter
+// @Tags appeals
+// @Accept json
+// @Produce json
+// @Param id path string true "Appeal ID"
+// @Success 200 {object} vo.AppealStatusVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Router /v1/appeals/{id}/status [get]
+func (h *AppealHandler) Status(c *gin.Context) {
+	appeal, err := h.appealSvc.Status(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get appeal")
+		return
+	}
+
+	c.JSON(http.StatusOK, appeal)
+}
+
+// List handles GET /v1/appeals
+// @Summary List appeals
+// @Description Get the appeal review queue (pending appeals are listed oldest first)
+// @Tags appeals
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param status query string false "Filter by status (pending, upheld, overturned)"
+// @Param kind query string false "Filter by kind (report, blocked)"
+// @Success 200 {object} vo.AppealListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/appeals [get]
+func (h *AppealHandler) List(c *gin.Context) {
+	var query dto.ListAppealsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	appeals, err := h.appealSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list appeals",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, appeals)
+}
+
+// GetByID handles GET /v1/appeals/:id
+// @Summary Get appeal by ID
+// @Description Get an appeal with the appealed report
+// @Tags appeals
+// @Accept json
+// @Produce json
+// @Param id path string true "Appeal ID"
+// @Success 200 {object} vo.AppealVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/appeals/{id} [get]
+func (h *AppealHandler) GetByID(c *gin.Context) {
+	appeal, err := h.appealSvc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get appeal")
+		return
+	}
+
+	c.JSON(http.StatusOK, appeal)
+}
+
+// Decide handles POST /v1/appeals/:id/decide
+// @Summary Decide an appeal
+// @Description Uphold or overturn an appeal. Overturning a spam decision returns the report to review.
+// @Tags appeals
+// @Accept json
+// @Produce json
+// @Param id path string true "Appeal ID"
+// @Param request body dto.DecideAppealRequest true "Decision"
+// @Success 200 {object} vo.AppealVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/appeals/{id}/decide [post]
+func (h *AppealHandler) Decide(c *gin.Context) {
+	var req dto.DecideAppealRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	appeal, err := h.appealSvc.Decide(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to decide appeal")
+		return
+	}
+
+	c.JSON(http.StatusOK, appeal)
+}
+
+// handleError maps appeal service errors to HTTP responses
+func (h *AppealHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrAppealNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Appeal not found",
+		})
+	case errors.Is(err, service.ErrInvalidAppealRef):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "INVALID_APPEAL_REFERENCE",
+			Message: "Follow-up token or blocked reference is invalid or expired",
+		})
+	case errors.Is(err, service.ErrNotAppealable):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "NOT_APPEALABLE",
+			Message: "Only reports marked as spam can be appealed",
+		})
+	case errors.Is(err, service.ErrAppealExists):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "APPEAL_EXISTS",
+			Message: "An appeal has already been filed",
+		})
+	case errors.Is(err, service.ErrAppealDecided):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "ALREADY_DECIDED",
+			Message: "Appeal has already been decided",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// AuditHandler handles audit log HTTP requests
+type AuditHandler struct {
+	auditSvc *service.AuditService
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(auditSvc *service.AuditService) *AuditHandler {
+	return &AuditHandler{auditSvc: auditSvc}
+}
+
+// List handles GET /v1/audit-logs
+// @Summary List audit logs
+// @Description Get audit log entries, newest first (admin and auditor only)
+// @Tags audit
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param objectType query string false "Filter by object type"
+// @Param objectId query string false "Filter by object ID"
+// @Param actorId query string false "Filter by actor ID"
+// @Param action query string false "Filter by action"
+// @Param batchId query string false "Filter by bulk triage batch ID"
+// @Param cursor query string false "Cursor from a previous page (keyset pagination)"
+// @Success 200 {object} vo.AuditLogListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/audit-logs [get]
+func (h *AuditHandler) List(c *gin.Context) {
+	var query dto.ListAuditLogsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 20
+	}
+
+	logs, err := h.auditSvc.List(c.Request.Context(), query)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_CURSOR",
+				Message: "Cursor is invalid or expired",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list audit logs",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, logs)
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// BrigadeHandler handles coordinated-reporting cluster HTTP requests
+type BrigadeHandler struct {
+	brigadeSvc *service.BrigadeService
+}
+
+// NewBrigadeHandler creates a new brigade handler
+func NewBrigadeHandler(brigadeSvc *service.BrigadeService) *BrigadeHandler {
+	return &BrigadeHandler{brigadeSvc: brigadeSvc}
+}
+
+// List handles GET /v1/brigades
+// @Summary List brigade clusters
+// @Description Get a paginated list of suspected coordinated-reporting clusters
+// @Tags brigades
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param status query string false "Filter by status (open, confirmed, dismissed)"
+// @Success 200 {object} vo.BrigadeClusterListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/brigades [get]
+func (h *BrigadeHandler) List(c *gin.Context) {
+	var query dto.ListBrigadesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	clusters, err := h.brigadeSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list brigade clusters",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, clusters)
+}
+
+// GetByID handles GET /v1/brigades/:id
+// @Summary Get brigade cluster by ID
+// @Description Get a suspected coordinated-reporting cluster with its reports
+// @Tags brigades
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Success 200 {object} vo.BrigadeClusterDetailVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/brigades/{id} [get]
+func (h *BrigadeHandler) GetByID(c *gin.Context) {
+	cluster, err := h.brigadeSvc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get brigade cluster")
+		return
+	}
+
+	c.JSON(http.StatusOK, cluster)
+}
+
+// Resolve handles POST /v1/brigades/:id/resolve
+// @Summary Resolve a brigade cluster
+// @Description Confirm a cluster (its untriaged reports become spam) or dismiss it (intake tightening is lifted)
+// @Tags brigades
+// @Accept json
+// @Produce json
+// @Param id path string true "Cluster ID"
+// @Param request body dto.ResolveBrigadeRequest true "Review outcome"
+// @Success 200 {object} vo.BrigadeClusterDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/brigades/{id}/resolve [post]
+func (h *BrigadeHandler) Resolve(c *gin.Context) {
+	var req dto.ResolveBrigadeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	cluster, err := h.brigadeSvc.Resolve(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to resolve brigade cluster")
+		return
+	}
+
+	c.JSON(http.StatusOK, cluster)
+}
+
+// handleError maps brigade service errors to HTTP responses
+func (h *BrigadeHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrBrigadeNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Brigade cluster not found",
+		})
+	case errors.Is(err, service.ErrBrigadeResolved):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "ALREADY_RESOLVED",
+			Message: "Brigade cluster has already been resolved",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/line"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/whatsapp"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// maxWebhookBody is the largest chat webhook body read
+const maxWebhookBody = 1 << 20
+
+// ChatHandler handles chat platform webhooks
+type ChatHandler struct {
+	chatSvc *service.ChatIntakeService
+}
+
+// NewChatHandler creates a new chat handler
+func NewChatHandler(chatSvc *service.ChatIntakeService) *ChatHandler {
+	return &ChatHandler{chatSvc: chatSvc}
+}
+
+// LINEWebhook handles POST /v1/webhooks/line
+// @Summary LINE webhook
+// @Description Receives LINE Messaging API events. The body must be signed with the channel secret in X-Line-Signature. Messages advance the sender's guided reporting conversation.
+// @Tags webhooks
+// @Accept json
+// @Param X-Line-Signature header string true "Base64 HMAC-SHA256 of the body"
+// @Success 200
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 401 {object} vo.ErrorVO
+// @Router /v1/webhooks/line [post]
+func (h *ChatHandler) LINEWebhook(c *gin.Context) {
+	body, ok := readWebhookBody(c)
+	if !ok {
+		return
+	}
+	err := h.chatSvc.HandleLINE(c.Request.Context(), body, c.GetHeader(line.SignatureHeader))
+	respondWebhook(c, err)
+}
+
+// WhatsAppVerify handles GET /v1/webhooks/whatsapp
+// @Summary WhatsApp webhook verification
+// @Description Answers the WhatsApp Cloud API subscription handshake by echoing hub.challenge when hub.verify_token matches.
+// @Tags webhooks
+// @Produce plain
+// @Param hub.mode query string true "Always subscribe"
+// @Param hub.verify_token query string true "Configured verify token"
+// @Param hub.challenge query string true "Value to echo back"
+// @Success 200 {string} string
+// @Failure 403 {object} vo.ErrorVO
+// @Router /v1/webhooks/whatsapp [get]
+func (h *ChatHandler) WhatsAppVerify(c *gin.Context) {
+	if !h.chatSvc.VerifyWhatsAppSubscription(c.Query("hub.mode"), c.Query("hub.verify_token")) {
+		c.JSON(http.StatusForbidden, vo.ErrorVO{
+			Code:    "FORBIDDEN",
+			Message: "Verify token does not match",
+		})
+		return
+	}
+	c.String(http.StatusOK, c.Query("hub.challenge"))
+}
+
+// WhatsAppWebhook handles POST /v1/webhooks/whatsapp
+// @Summary WhatsApp webhook
+// @Description Receives WhatsApp Cloud API notifications. The body must be signed with the app secret in X-Hub-Signature-256. Messages advance the sender's guided reporting conversation.
+// @Tags webhooks
+// @Accept json
+// @Param X-Hub-Signature-256 header string true "sha256= followed by the hex HMAC-SHA256 of the body"
+// @Success 200
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 401 {object} vo.ErrorVO
+// @Router /v1/webhooks/whatsapp [post]
+func (h *ChatHandler) WhatsAppWebhook(c *gin.Context) {
+	body, ok := readWebhookBody(c)
+	if !ok {
+		return
+	}
+	err := h.chatSvc.HandleWhatsApp(c.Request.Context(), body, c.GetHeader(whatsapp.SignatureHeader))
+	respondWebhook(c, err)
+}
+
+// readWebhookBody reads the raw body, which the signature covers
+func readWebhookBody(c *gin.Context) ([]byte, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBody))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: "Webhook body could not be read",
+		})
+		return nil, false
+	}
+	return body, true
+}
+
+// respondWebhook answers a webhook delivery
+func respondWebhook(c *gin.Context, err error) {
+	switch {
+	case err == nil:
+		c.Status(http.StatusOK)
+	case errors.Is(err, service.ErrChatSignature):
+		c.JSON(http.StatusUnauthorized, vo.ErrorVO{
+			Code:    "UNAUTHORIZED",
+			Message: "Invalid webhook signature",
+		})
+	case errors.Is(err, service.ErrChatPayload):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: "Webhook payload could not be read",
+		})
+	case errors.Is(err, service.ErrChatDisabled):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Chat platform is not configured",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to process webhook",
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// jsonLDContentType is the media type of schema.org JSON-LD responses
+const jsonLDContentType = "application/ld+json; charset=utf-8"
+
+// ClaimHandler handles misinformation claim HTTP requests
+type ClaimHandler struct {
+	claimSvc *service.ClaimService
+}
+
+// NewClaimHandler creates a new claim handler
+func NewClaimHandler(claimSvc *service.ClaimService) *ClaimHandler {
+	return &ClaimHandler{claimSvc: claimSvc}
+}
+
+// Create handles POST /v1/claims
+// @Summary Record a claim
+// @Description Record a circulating claim, unverified, linked to reports and incidents
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateClaimRequest true "Claim"
+// @Success 201 {object} vo.ClaimDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/claims [post]
+func (h *ClaimHandler) Create(c *gin.Context) {
+	var req dto.CreateClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	claim, err := h.claimSvc.Create(c.Request.Context(), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to create claim")
+		return
+	}
+
+	c.JSON(http.StatusCreated, claim)
+}
+
+// List handles GET /v1/claims
+// @Summary List claims
+// @Description Get a paginated list of claims, most recently updated first
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param verdict query string false "Filter by verdict (unverified, false, misleading, true)"
+// @Param q query string false "Substring of the claim text"
+// @Param reportId query string false "Only claims linked to this report"
+// @Param incidentId query string false "Only claims linked to this incident"
+// @Success 200 {object} vo.ClaimListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/claims [get]
+func (h *ClaimHandler) List(c *gin.Context) {
+	var query dto.ListClaimsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	claims, err := h.claimSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list claims",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, claims)
+}
+
+// GetByID handles GET /v1/claims/:id
+// @Summary Get claim by ID
+// @Description Get a claim with its linked reports and incidents
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Param id path string true "Claim ID"
+// @Success 200 {object} vo.ClaimDetailVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/claims/{id} [get]
+func (h *ClaimHandler) GetByID(c *gin.Context) {
+	claim, err := h.claimSvc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get claim")
+		return
+	}
+
+	c.JSON(http.StatusOK, claim)
+}
+
+// Update handles PATCH /v1/claims/:id
+// @Summary Update a claim
+// @Description Edit a claim's text, language, appearances, explanation or evidence
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Param id path string true "Claim ID"
+// @Param request body dto.UpdateClaimRequest true "Fields to update"
+// @Success 200 {object} vo.ClaimDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/claims/{id} [patch]
+func (h *ClaimHandler) Update(c *gin.Context) {
+	var req dto.UpdateClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	claim, err := h.claimSvc.Update(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to update claim")
+		return
+	}
+
+	c.JSON(http.StatusOK, claim)
+}
+
+// SetVerdict handles POST /v1/claims/:id/verdict
+// @Summary Rate a claim
+// @Description Set the verdict of a claim. false, misleading and true publish it as a ClaimReview and need a public explanation; unverified withdraws the review.
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Param id path string true "Claim ID"
+// @Param request body dto.ClaimVerdictRequest true "Verdict"
+// @Success 200 {object} vo.ClaimDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/claims/{id}/verdict [post]
+func (h *ClaimHandler) SetVerdict(c *gin.Context) {
+	var req dto.ClaimVerdictRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	claim, err := h.claimSvc.SetVerdict(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to set claim verdict")
+		return
+	}
+
+	c.JSON(http.StatusOK, claim)
+}
+
+// Link handles POST /v1/claims/:id/links
+// @Summary Link reports and incidents to a claim
+// @Description Link further reports and incidents that repeat a claim
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Param id path string true "Claim ID"
+// @Param request body dto.LinkClaimRequest true "Reports and incidents to link"
+// @Success 200 {object} vo.ClaimDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/claims/{id}/links [post]
+func (h *ClaimHandler) Link(c *gin.Context) {
+	var req dto.LinkClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	claim, err := h.claimSvc.Link(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to link claim")
+		return
+	}
+
+	c.JSON(http.StatusOK, claim)
+}
+
+// ReviewFeed handles GET /v1/claims/reviews
+// @Summary Published fact checks
+// @Description schema.org DataFeed of ClaimReview JSON-LD for every rated claim, most recently published first
+// @Tags claims
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(50)
+// @Success 200 {object} claimreview.DataFeed
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Router /v1/claims/reviews [get]
+func (h *ClaimHandler) ReviewFeed(c *gin.Context) {
+	var query dto.ListClaimReviewsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	feed, total, err := h.claimSvc.ReviewFeed(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list claim reviews",
+		})
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Header("Content-Type", jsonLDContentType)
+	c.JSON(http.StatusOK, feed)
+}
+
+// Review handles GET /v1/claims/reviews/:id
+// @Summary Published fact check of a claim
+// @Description ClaimReview JSON-LD of a rated claim, for embedding in its public page
+// @Tags claims
+// @Produce json
+// @Param id path string true "Claim ID"
+// @Success 200 {object} claimreview.ClaimReview
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Router /v1/claims/reviews/{id} [get]
+func (h *ClaimHandler) Review(c *gin.Context) {
+	review, err := h.claimSvc.Review(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get claim review")
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Header("Content-Type", jsonLDContentType)
+	c.JSON(http.StatusOK, review)
+}
+
+// handleError maps claim service errors to HTTP responses
+func (h *ClaimHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrClaimNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Claim not found",
+		})
+	case errors.Is(err, service.ErrReportNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Report not found",
+		})
+	case errors.Is(err, service.ErrIncidentNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Incident not found",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
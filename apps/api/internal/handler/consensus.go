@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// ListRounds handles GET /v1/consensus-rounds
+// @Summary List consensus rounds
+// @Description Get a paginated list of consensus rounds, newest first. Reviews are withheld while a round is open.
+// @Tags triage
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param status query string false "Filter by status" Enums(open, agreed, disputed, resolved)
+// @Param reportId query string false "Filter by report ID"
+// @Success 200 {object} vo.ConsensusRoundListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/consensus-rounds [get]
+func (h *TriageHandler) ListRounds(c *gin.Context) {
+	var query dto.ListConsensusRoundsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Set defaults
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 20
+	}
+
+	rounds, err := h.triageSvc.ListRounds(c.Request.Context(), query)
+	if err != nil {
+		h.handleRoundError(c, err, "Failed to list consensus rounds")
+		return
+	}
+
+	c.JSON(http.StatusOK, rounds)
+}
+
+// GetRound handles GET /v1/consensus-rounds/:id
+// @Summary Get a consensus round
+// @Description Get a consensus round. Reviews are withheld while the round is open.
+// @Tags triage
+// @Produce json
+// @Param id path string true "Consensus round ID"
+// @Success 200 {object} vo.ConsensusRoundVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/consensus-rounds/{id} [get]
+func (h *TriageHandler) GetRound(c *gin.Context) {
+	round, err := h.triageSvc.GetRound(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleRoundError(c, err, "Failed to get consensus round")
+		return
+	}
+
+	c.JSON(http.StatusOK, round)
+}
+
+// ResolveRound handles POST /v1/consensus-rounds/:id/resolve
+// @Summary Break the tie in a disputed consensus round
+// @Description Record a lead's decision for a report whose reviewers disagreed; it becomes the final triage decision (admin only). The lead cannot be one of the reviewers.
+// @Tags triage
+// @Accept json
+// @Produce json
+// @Param id path string true "Consensus round ID"
+// @Param request body dto.TriageRequest true "Triage decision"
+// @Success 200 {object} vo.TriageDecisionVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 403 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/consensus-rounds/{id}/resolve [post]
+func (h *TriageHandler) ResolveRound(c *gin.Context) {
+	var req dto.TriageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	decision, err := h.triageSvc.ResolveRound(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleRoundError(c, err, "Failed to resolve consensus round")
+		return
+	}
+
+	c.JSON(http.StatusOK, decision)
+}
+
+// handleRoundError maps consensus round service errors to HTTP responses
+func (h *TriageHandler) handleRoundError(c *gin.Context, err error, message string) {
+	var rubricErr *service.RubricError
+	switch {
+	case errors.Is(err, service.ErrRoundNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Consensus round not found",
+		})
+	case errors.Is(err, service.ErrReportNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Report not found",
+		})
+	case errors.Is(err, service.ErrRoundNotDisputed):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "NOT_DISPUTED",
+			Message: "Only disputed rounds need a tie-break",
+		})
+	case errors.Is(err, service.ErrRoundChanged):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "CONSENSUS_CHANGED",
+			Message: "The round was resolved by another lead",
+		})
+	case errors.Is(err, service.ErrLeadReviewed):
+		c.JSON(http.StatusForbidden, vo.ErrorVO{
+			Code:    "FORBIDDEN",
+			Message: "You reviewed this report; another lead must break the tie",
+		})
+	case errors.Is(err, service.ErrReviewerRequired):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "REVIEWER_REQUIRED",
+			Message: "A tie-break needs a signed-in lead",
+		})
+	case errors.Is(err, service.ErrInvalidTransition):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "INVALID_TRANSITION",
+			Message: "Report status does not allow this decision",
+		})
+	case errors.As(err, &rubricErr):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "RUBRIC_VIOLATION",
+			Message: fmt.Sprintf("Decision does not meet triage rubric version %d", rubricErr.Version),
+			Details: rubricErr.Violations,
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
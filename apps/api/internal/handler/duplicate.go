@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// DuplicateHandler handles duplicate suggestion HTTP requests
+type DuplicateHandler struct {
+	duplicateSvc *service.DuplicateService
+}
+
+// NewDuplicateHandler creates a new duplicate handler
+func NewDuplicateHandler(duplicateSvc *service.DuplicateService) *DuplicateHandler {
+	return &DuplicateHandler{duplicateSvc: duplicateSvc}
+}
+
+// List handles GET /v1/reports/:id/duplicates
+// @Summary List suggested duplicates
+// @Description Get duplicate suggestions raised for a report, best match first
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Success 200 {array} vo.DuplicateCandidateVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/duplicates [get]
+func (h *DuplicateHandler) List(c *gin.Context) {
+	id := c.Param("id")
+
+	duplicates, err := h.duplicateSvc.ListCandidates(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err, "Failed to list duplicates")
+		return
+	}
+
+	c.JSON(http.StatusOK, duplicates)
+}
+
+// Link handles POST /v1/reports/:id/duplicates/:candidateId/link
+// @Summary Confirm a duplicate
+// @Description Group the report and the suggested duplicate under one incident
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param candidateId path string true "Duplicate candidate ID"
+// @Success 200 {object} vo.DuplicateCandidateVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/duplicates/{candidateId}/link [post]
+func (h *DuplicateHandler) Link(c *gin.Context) {
+	id := c.Param("id")
+	candidateID := c.Param("candidateId")
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	duplicate, err := h.duplicateSvc.Link(c.Request.Context(), id, candidateID, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to link duplicate")
+		return
+	}
+
+	c.JSON(http.StatusOK, duplicate)
+}
+
+// Dismiss handles POST /v1/reports/:id/duplicates/:candidateId/dismiss
+// @Summary Dismiss a duplicate suggestion
+// @Description Mark a suggested duplicate as not the same event
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param candidateId path string true "Duplicate candidate ID"
+// @Success 200 {object} vo.DuplicateCandidateVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/duplicates/{candidateId}/dismiss [post]
+func (h *DuplicateHandler) Dismiss(c *gin.Context) {
+	id := c.Param("id")
+	candidateID := c.Param("candidateId")
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	duplicate, err := h.duplicateSvc.Dismiss(c.Request.Context(), id, candidateID, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to dismiss duplicate")
+		return
+	}
+
+	c.JSON(http.StatusOK, duplicate)
+}
+
+// handleError maps duplicate service errors to HTTP responses
+func (h *DuplicateHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrReportNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Report not found",
+		})
+	case errors.Is(err, service.ErrDuplicateNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Duplicate candidate not found",
+		})
+	case errors.Is(err, service.ErrDuplicateReviewed):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "ALREADY_REVIEWED",
+			Message: "Duplicate candidate has already been reviewed",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// EvidenceHandler handles evidence file HTTP requests
+type EvidenceHandler struct {
+	evidenceSvc *service.EvidenceService
+}
+
+// NewEvidenceHandler creates a new evidence handler
+func NewEvidenceHandler(evidenceSvc *service.EvidenceService) *EvidenceHandler {
+	return &EvidenceHandler{evidenceSvc: evidenceSvc}
+}
+
+// Download handles GET /v1/reports/:id/evidence/:evidenceId
+// @Summary Download report evidence
+// @Description Download a file stored with a report, such as the original of a forwarded scam email (message/rfc822). Downloads are audited.
+// @Tags reports
+// @Produce octet-stream
+// @Param id path string true "Report ID"
+// @Param evidenceId path string true "Evidence ID, from an evidence:<id> ref of the report"
+// @Success 200 {file} file
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/evidence/{evidenceId} [get]
+func (h *EvidenceHandler) Download(c *gin.Context) {
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	evidence, err := h.evidenceSvc.Download(c.Request.Context(), c.Param("id"), c.Param("evidenceId"), userID, c.ClientIP())
+	if err != nil {
+		if errors.Is(err, service.ErrEvidenceNotFound) {
+			c.JSON(http.StatusNotFound, vo.ErrorVO{
+				Code:    "NOT_FOUND",
+				Message: "Evidence not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to get evidence",
+		})
+		return
+	}
+
+	// Evidence is served as a download, never rendered, and not cached
+	filename := evidence.Filename
+	if filename == "" {
+		filename = evidence.ID.String()
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Cache-Control", "no-store")
+	c.Header("ETag", `"`+evidence.SHA256+`"`)
+	c.Data(http.StatusOK, evidence.ContentType, evidence.Data)
+}
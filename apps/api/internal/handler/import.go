@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// ImportHandler handles bulk report import HTTP requests
+type ImportHandler struct {
+	importSvc *service.ImportService
+}
+
+// NewImportHandler creates a new import handler
+func NewImportHandler(importSvc *service.ImportService) *ImportHandler {
+	return &ImportHandler{importSvc: importSvc}
+}
+
+// Import handles POST /v1/reports/import
+// @Summary Import reports in bulk
+// @Description Import reports from a CSV or JSON Lines file sent as the request body, for drills and historical backfill. Each row is validated like POST /v1/reports and reported on separately. With dryRun nothing is stored; with atomic either every row is imported or none is.
+// @Tags reports
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Produce json
+// @Param format query string true "csv or jsonl"
+// @Param name query string true "Batch name, e.g. the drill scenario"
+// @Param dryRun query bool false "Validate only"
+// @Param atomic query bool false "Roll back the whole import if any row fails"
+// @Success 200 {object} vo.ImportResultVO "Dry run"
+// @Success 201 {object} vo.ImportResultVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 413 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/import [post]
+func (h *ImportHandler) Import(c *gin.Context) {
+	var query dto.ImportReportsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	result, err := h.importSvc.Import(c.Request.Context(), c.Request.Body, query, userID, actorIP)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrImportTooLarge):
+			c.JSON(http.StatusRequestEntityTooLarge, vo.ErrorVO{
+				Code:    "IMPORT_TOO_LARGE",
+				Message: "Import exceeds the row or size limit",
+			})
+		case errors.Is(err, service.ErrImportEmpty):
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "VALIDATION_ERROR",
+				Message: "Import has no rows",
+			})
+		case errors.Is(err, service.ErrImportInvalid):
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "VALIDATION_ERROR",
+				Message: err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+				Code:    "INTERNAL_ERROR",
+				Message: "Failed to import reports",
+			})
+		}
+		return
+	}
+
+	if result.DryRun {
+		c.JSON(http.StatusOK, result)
+		return
+	}
+	c.JSON(http.StatusCreated, result)
+}
+
+// List handles GET /v1/import-batches
+// @Summary List import batches
+// @Description List past bulk imports, newest first
+// @Tags reports
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param status query string false "Filter by status"
+// @Success 200 {object} vo.ImportBatchListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/import-batches [get]
+func (h *ImportHandler) List(c *gin.Context) {
+	var query dto.ListImportsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	batches, err := h.importSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list import batches",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, batches)
+}
+
+// GetByID handles GET /v1/import-batches/:id
+// @Summary Get import batch by ID
+// @Description Get a bulk import's counts and status. Its reports are listed with GET /v1/reports?importBatchId=.
+// @Tags reports
+// @Produce json
+// @Param id path string true "Import batch ID"
+// @Success 200 {object} vo.ImportBatchVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/import-batches/{id} [get]
+func (h *ImportHandler) GetByID(c *gin.Context) {
+	id := c.Param("id")
+
+	batch, err := h.importSvc.GetByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrImportNotFound) {
+			c.JSON(http.StatusNotFound, vo.ErrorVO{
+				Code:    "NOT_FOUND",
+				Message: "Import batch not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to get import batch",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, batch)
+}
@@ -0,0 +1,349 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// IncidentHandler handles incident HTTP requests
+type IncidentHandler struct {
+	incidentSvc *service.IncidentService
+}
+
+// NewIncidentHandler creates a new incident handler
+func NewIncidentHandler(incidentSvc *service.IncidentService) *IncidentHandler {
+	return &IncidentHandler{incidentSvc: incidentSvc}
+}
+
+// Create handles POST /v1/incidents
+// @Summary Create an incident
+// @Description Create an incident, optionally grouping existing reports under it
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateIncidentRequest true "Incident data"
+// @Success 201 {object} vo.IncidentDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/incidents [post]
+func (h *IncidentHandler) Create(c *gin.Context) {
+	var req dto.CreateIncidentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	incident, err := h.incidentSvc.Create(c.Request.Context(), req, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to create incident")
+		return
+	}
+
+	c.JSON(http.StatusCreated, incident)
+}
+
+// List handles GET /v1/incidents
+// @Summary List incidents
+// @Description Get a paginated list of incidents (merged incidents are hidden unless requested)
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param status query string false "Filter by status"
+// @Param category query string false "Filter by category"
+// @Param severity query string false "Filter by severity"
+// @Success 200 {object} vo.IncidentListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/incidents [get]
+func (h *IncidentHandler) List(c *gin.Context) {
+	var query dto.ListIncidentsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 20
+	}
+
+	incidents, err := h.incidentSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list incidents",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, incidents)
+}
+
+// GetByID handles GET /v1/incidents/:id
+// @Summary Get incident by ID
+// @Description Get an incident with its linked reports and alerts
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param id path string true "Incident ID"
+// @Success 200 {object} vo.IncidentDetailVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/incidents/{id} [get]
+func (h *IncidentHandler) GetByID(c *gin.Context) {
+	id := c.Param("id")
+
+	incident, err := h.incidentSvc.GetByID(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err, "Failed to get incident")
+		return
+	}
+
+	c.JSON(http.StatusOK, incident)
+}
+
+// Update handles PATCH /v1/incidents/:id
+// @Summary Update an incident
+// @Description Update incident metadata, severity or lifecycle status
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param id path string true "Incident ID"
+// @Param request body dto.UpdateIncidentRequest true "Update data"
+// @Success 200 {object} vo.IncidentDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/incidents/{id} [patch]
+func (h *IncidentHandler) Update(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.UpdateIncidentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	incident, err := h.incidentSvc.Update(c.Request.Context(), id, req, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to update incident")
+		return
+	}
+
+	c.JSON(http.StatusOK, incident)
+}
+
+// LinkReports handles POST /v1/incidents/:id/reports
+// @Summary Link reports to an incident
+// @Description Group existing reports under an incident
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param id path string true "Incident ID"
+// @Param request body dto.LinkReportsRequest true "Reports to link"
+// @Success 200 {object} vo.IncidentDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/incidents/{id}/reports [post]
+func (h *IncidentHandler) LinkReports(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.LinkReportsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	incident, err := h.incidentSvc.LinkReports(c.Request.Context(), id, req, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to link reports")
+		return
+	}
+
+	c.JSON(http.StatusOK, incident)
+}
+
+// Merge handles POST /v1/incidents/:id/merge
+// @Summary Merge incidents
+// @Description Merge one or more source incidents into this incident
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param id path string true "Target incident ID"
+// @Param request body dto.MergeIncidentsRequest true "Incidents to merge"
+// @Success 200 {object} vo.IncidentDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/incidents/{id}/merge [post]
+func (h *IncidentHandler) Merge(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.MergeIncidentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	incident, err := h.incidentSvc.Merge(c.Request.Context(), id, req, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to merge incidents")
+		return
+	}
+
+	c.JSON(http.StatusOK, incident)
+}
+
+// Split handles POST /v1/incidents/:id/split
+// @Summary Split an incident
+// @Description Move a subset of this incident's reports into a new incident
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param id path string true "Source incident ID"
+// @Param request body dto.SplitIncidentRequest true "Reports to split off"
+// @Success 201 {object} vo.IncidentDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/incidents/{id}/split [post]
+func (h *IncidentHandler) Split(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.SplitIncidentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	incident, err := h.incidentSvc.Split(c.Request.Context(), id, req, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to split incident")
+		return
+	}
+
+	c.JSON(http.StatusCreated, incident)
+}
+
+// handleError maps incident service errors to HTTP responses
+func (h *IncidentHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrIncidentNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Incident not found",
+		})
+	case errors.Is(err, service.ErrReportNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Report not found",
+		})
+	case errors.Is(err, service.ErrIncidentMerged):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "INCIDENT_MERGED",
+			Message: "Incident has been merged into another incident",
+		})
+	case errors.Is(err, service.ErrInvalidIncidentMerge):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "INVALID_MERGE",
+			Message: "An incident cannot be merged into itself",
+		})
+	case errors.Is(err, service.ErrReportNotInIncident):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "REPORT_NOT_IN_INCIDENT",
+			Message: "Only reports belonging to this incident can be split off",
+		})
+	case errors.Is(err, service.ErrInvalidTransition):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "INVALID_TRANSITION",
+			Message: "Invalid status transition",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// IndicatorHandler handles scam indicator registry HTTP requests
+type IndicatorHandler struct {
+	indicatorSvc *service.IndicatorService
+}
+
+// NewIndicatorHandler creates a new indicator handler
+func NewIndicatorHandler(indicatorSvc *service.IndicatorService) *IndicatorHandler {
+	return &IndicatorHandler{indicatorSvc: indicatorSvc}
+}
+
+// List handles GET /v1/indicators
+// @Summary List scam indicators
+// @Description Get a paginated list of indicators extracted from scam_phishing reports, most recently seen first
+// @Tags indicators
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param type query string false "Filter by type (url, domain, email, phone, bank_account, line_id, crypto_wallet)"
+// @Param status query string false "Filter by status (unverified, confirmed, false_positive)"
+// @Param q query string false "Substring of the normalised value"
+// @Param reportId query string false "Only indicators found in this report"
+// @Success 200 {object} vo.IndicatorListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/indicators [get]
+func (h *IndicatorHandler) List(c *gin.Context) {
+	var query dto.ListIndicatorsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	indicators, err := h.indicatorSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list indicators",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, indicators)
+}
+
+// GetByID handles GET /v1/indicators/:id
+// @Summary Get scam indicator by ID
+// @Description Get an indicator with the newest reports it was found in
+// @Tags indicators
+// @Accept json
+// @Produce json
+// @Param id path string true "Indicator ID"
+// @Success 200 {object} vo.IndicatorDetailVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/indicators/{id} [get]
+func (h *IndicatorHandler) GetByID(c *gin.Context) {
+	indicator, err := h.indicatorSvc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get indicator")
+		return
+	}
+
+	c.JSON(http.StatusOK, indicator)
+}
+
+// Verify handles POST /v1/indicators/:id/verify
+// @Summary Verify a scam indicator
+// @Description Set the verification status of an indicator (confirmed, false_positive, or back to unverified)
+// @Tags indicators
+// @Accept json
+// @Produce json
+// @Param id path string true "Indicator ID"
+// @Param request body dto.VerifyIndicatorRequest true "Verification status"
+// @Success 200 {object} vo.IndicatorDetailVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/indicators/{id}/verify [post]
+func (h *IndicatorHandler) Verify(c *gin.Context) {
+	var req dto.VerifyIndicatorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	indicator, err := h.indicatorSvc.Verify(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to verify indicator")
+		return
+	}
+
+	c.JSON(http.StatusOK, indicator)
+}
+
+// Rescan handles POST /v1/indicators/rescan
+// @Summary Rescan reports for indicators
+// @Description Re-extract indicators from every scam_phishing report (admin only)
+// @Tags indicators
+// @Accept json
+// @Produce json
+// @Success 200 {object} vo.IndicatorRescanVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/indicators/rescan [post]
+func (h *IndicatorHandler) Rescan(c *gin.Context) {
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	result, err := h.indicatorSvc.Rescan(c.Request.Context(), userID, c.ClientIP())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to rescan reports",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Lookup handles GET /v1/indicators/lookup/:prefix
+// @Summary Check a value against verified scam indicators
+// @Description Hash-prefix (k-anonymity) lookup. Normalise the value, compute the SHA-256 hex of "type:value", send its first 5 characters and compare the returned hashes locally; the value itself is never sent. Only verified indicators (confirmed or false_positive) are returned.
+// @Tags indicators
+// @Accept json
+// @Produce json
+// @Param prefix path string true "First 5 hex characters of the SHA-256 of type:value"
+// @Success 200 {object} vo.IndicatorLookupVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 429 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Router /v1/indicators/lookup/{prefix} [get]
+func (h *IndicatorHandler) Lookup(c *gin.Context) {
+	result, err := h.indicatorSvc.Lookup(c.Request.Context(), c.Param("prefix"))
+	if err != nil {
+		h.handleError(c, err, "Failed to look up indicators")
+		return
+	}
+
+	// Responses depend only on the prefix, so shared caches may keep them
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, result)
+}
+
+// ExportSTIX handles GET /v1/indicators/stix
+// @Summary Export verified scam indicators as STIX 2.1
+// @Description STIX 2.1 bundle of confirmed indicators with observed-data report counts and TLP markings; object IDs are stable across exports. Pages are ordered by change time: pass the X-TAXII-Date-Added-Last of one page as added_after to get the next, which also carries indicators since marked false_positive as revoked. API keys need the intel scope.
+// @Tags indicators
+// @Produce json
+// @Param added_after query string false "Only indicators changed after this RFC 3339 time"
+// @Param limit query int false "Maximum indicators per page" default(500)
+// @Success 200 {object} stix.Bundle
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 403 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/indicators/stix [get]
+func (h *IndicatorHandler) ExportSTIX(c *gin.Context) {
+	var query dto.ExportIndicatorsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	export, err := h.indicatorSvc.ExportSTIX(c.Request.Context(), query)
+	if err != nil {
+		h.handleError(c, err, "Failed to export indicators")
+		return
+	}
+
+	// Paging headers as in TAXII 2.1 get-objects responses
+	if export.First != nil {
+		c.Header("X-TAXII-Date-Added-First", export.First.UTC().Format(time.RFC3339Nano))
+		c.Header("X-TAXII-Date-Added-Last", export.Last.UTC().Format(time.RFC3339Nano))
+	}
+	if export.More {
+		c.Header("X-TAXII-More", "true")
+	}
+	c.Header("Content-Type", "application/stix+json;version=2.1")
+	c.JSON(http.StatusOK, export.Bundle)
+}
+
+// handleError maps indicator service errors to HTTP responses
+func (h *IndicatorHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrIndicatorNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Indicator not found",
+		})
+	case errors.Is(err, service.ErrInvalidLookupHash):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: "prefix must be 5 hex characters",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
 )
@@ -42,6 +43,43 @@ func (h *MetricsHandler) GetKPIMetrics(c *gin.Context) {
 	c.JSON(http.StatusOK, metrics)
 }
 
+// GetTriageAgreement handles GET /v1/metrics/triage-agreement
+// @Summary Get triage agreement
+// @Description Cohen's kappa between the independent reviewers of high-severity reports, overall, per pair of triagers and per month
+// @Tags metrics
+// @Produce json
+// @Param days query int false "Look-back window in days" default(90)
+// @Success 200 {object} vo.TriageAgreementVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/metrics/triage-agreement [get]
+func (h *MetricsHandler) GetTriageAgreement(c *gin.Context) {
+	var query dto.TriageAgreementQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if query.Days == 0 {
+		query.Days = 90
+	}
+
+	agreement, err := h.metricsSvc.GetTriageAgreement(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to get triage agreement",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, agreement)
+}
+
 // GetDashboardStats handles GET /v1/metrics/dashboard
 // @Summary Get dashboard statistics
 // @Description Get public dashboard statistics
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// NotificationHandler handles in-app notification HTTP requests
+type NotificationHandler struct {
+	notificationSvc *service.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(notificationSvc *service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notificationSvc: notificationSvc}
+}
+
+// List handles GET /v1/notifications
+// @Summary List notifications
+// @Description Get the current user's notifications, newest first
+// @Tags notifications
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param unread query bool false "Only unread notifications"
+// @Success 200 {object} vo.NotificationListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 401 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/notifications [get]
+func (h *NotificationHandler) List(c *gin.Context) {
+	var query dto.ListNotificationsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, vo.ErrorVO{
+			Code:    "UNAUTHORIZED",
+			Message: "Not authenticated",
+		})
+		return
+	}
+
+	notifications, err := h.notificationSvc.List(c.Request.Context(), userID.(uuid.UUID), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list notifications",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, notifications)
+}
+
+// MarkRead handles POST /v1/notifications/:id/read
+// @Summary Mark a notification as read
+// @Tags notifications
+// @Param id path string true "Notification ID"
+// @Success 204
+// @Failure 401 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/notifications/{id}/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, vo.ErrorVO{
+			Code:    "UNAUTHORIZED",
+			Message: "Not authenticated",
+		})
+		return
+	}
+
+	if err := h.notificationSvc.MarkRead(c.Request.Context(), c.Param("id"), userID.(uuid.UUID)); err != nil {
+		if errors.Is(err, service.ErrNotificationNotFound) {
+			c.JSON(http.StatusNotFound, vo.ErrorVO{
+				Code:    "NOT_FOUND",
+				Message: "Notification not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to mark notification as read",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// QueueHandler handles triage queue HTTP requests
+type QueueHandler struct {
+	queueSvc *service.QueueService
+}
+
+// NewQueueHandler creates a new triage queue handler
+func NewQueueHandler(queueSvc *service.QueueService) *QueueHandler {
+	return &QueueHandler{queueSvc: queueSvc}
+}
+
+// List handles GET /v1/triage-queue
+// @Summary List the triage queue
+// @Description Get unclaimed reports awaiting triage, highest suggested severity and oldest first
+// @Tags triage
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param category query string false "Filter by category"
+// @Param zoneId query string false "Filter by pilot zone"
+// @Success 200 {object} vo.ReportListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-queue [get]
+func (h *QueueHandler) List(c *gin.Context) {
+	var query dto.ListQueueQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 20
+	}
+
+	reports, err := h.queueSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list triage queue",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+// ListClaims handles GET /v1/triage-queue/claims
+// @Summary List active claims
+// @Description Get all unexpired report claims
+// @Tags triage
+// @Produce json
+// @Success 200 {array} vo.LeaseVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-queue/claims [get]
+func (h *QueueHandler) ListClaims(c *gin.Context) {
+	claims, err := h.queueSvc.ListClaims(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list claims",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, claims)
+}
+
+// Claim handles POST /v1/triage-queue/:id/claim
+// @Summary Claim a report
+// @Description Take a time-limited lease on a report and move it to under_review. Claiming again renews the lease.
+// @Tags triage
+// @Produce json
+// @Param id path string true "Report ID"
+// @Success 200 {object} vo.LeaseVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-queue/{id}/claim [post]
+func (h *QueueHandler) Claim(c *gin.Context) {
+	id := c.Param("id")
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	lease, err := h.queueSvc.Claim(c.Request.Context(), id, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to claim report")
+		return
+	}
+
+	c.JSON(http.StatusOK, lease)
+}
+
+// Release handles POST /v1/triage-queue/:id/release
+// @Summary Release a claim
+// @Description Give up your claim on a report so it returns to the queue
+// @Tags triage
+// @Param id path string true "Report ID"
+// @Success 204
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-queue/{id}/release [post]
+func (h *QueueHandler) Release(c *gin.Context) {
+	id := c.Param("id")
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	if err := h.queueSvc.Release(c.Request.Context(), id, userID, actorIP); err != nil {
+		h.handleError(c, err, "Failed to release claim")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Assign handles POST /v1/triage-queue/:id/assign
+// @Summary Assign a report
+// @Description Give a report to a triager, replacing any existing claim (admin only)
+// @Tags triage
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param request body dto.AssignReportRequest true "Assignee"
+// @Success 200 {object} vo.LeaseVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-queue/{id}/assign [post]
+func (h *QueueHandler) Assign(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.AssignReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	lease, err := h.queueSvc.Assign(c.Request.Context(), id, req, userID, actorIP)
+	if err != nil {
+		h.handleError(c, err, "Failed to assign report")
+		return
+	}
+
+	c.JSON(http.StatusOK, lease)
+}
+
+// handleError maps triage queue service errors to HTTP responses
+func (h *QueueHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrReportNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Report not found",
+		})
+	case errors.Is(err, service.ErrReportClaimed):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "REPORT_CLAIMED",
+			Message: "Report is claimed by another triager",
+		})
+	case errors.Is(err, service.ErrNotClaimHolder):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "NOT_CLAIM_HOLDER",
+			Message: "You do not hold a claim on this report",
+		})
+	case errors.Is(err, service.ErrInvalidAssignee):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "INVALID_ASSIGNEE",
+			Message: "Assignee must be an active admin or triager",
+		})
+	case errors.Is(err, service.ErrInvalidTransition):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "INVALID_TRANSITION",
+			Message: "Report cannot be claimed in its current status",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
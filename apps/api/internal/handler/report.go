@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
@@ -28,6 +30,10 @@ func NewReportHandler(reportSvc *service.ReportService) *ReportHandler {
 // @Accept json
 // @Produce json
 // @Param request body dto.CreateReportRequest true "Report data"
+// @Param X-PoW-Challenge header string false "Challenge token from GET /v1/reports/challenge"
+// @Param X-PoW-Solution header string false "Proof-of-work solution"
+// @Param X-Captcha-Token header string false "CAPTCHA response token"
+// @Param X-Device-ID header string false "Random per-device identifier"
 // @Success 201 {object} vo.ReportVO
 // @Failure 400 {object} vo.ErrorVO
 // @Failure 500 {object} vo.ErrorVO
@@ -43,8 +49,24 @@ func (h *ReportHandler) Create(c *gin.Context) {
 	}
 
 	actorIP := c.ClientIP()
-	report, err := h.reportSvc.Create(c.Request.Context(), req, actorIP)
+	signals := &service.IntakeSignals{
+		IP:           actorIP,
+		UserAgent:    c.Request.UserAgent(),
+		DeviceID:     c.GetHeader("X-Device-ID"),
+		PowToken:     c.GetHeader("X-PoW-Challenge"),
+		PowSolution:  c.GetHeader("X-PoW-Solution"),
+		CaptchaToken: c.GetHeader("X-Captcha-Token"),
+	}
+
+	report, err := h.reportSvc.Create(c.Request.Context(), req, signals, actorIP)
 	if err != nil {
+		if errors.Is(err, service.ErrUnknownZone) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "UNKNOWN_ZONE",
+				Message: "Zone not found in gazetteer",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
 			Code:    "INTERNAL_ERROR",
 			Message: "Failed to create report",
@@ -55,6 +77,34 @@ func (h *ReportHandler) Create(c *gin.Context) {
 	c.JSON(http.StatusCreated, report)
 }
 
+// Challenge handles GET /v1/reports/challenge
+// @Summary Get an intake challenge
+// @Description Issue a proof-of-work challenge to solve before submitting a report
+// @Tags reports
+// @Produce json
+// @Success 200 {object} vo.IntakeChallengeVO
+// @Failure 404 {object} vo.ErrorVO
+// @Router /v1/reports/challenge [get]
+func (h *ReportHandler) Challenge(c *gin.Context) {
+	challenge, err := h.reportSvc.Challenge()
+	if err != nil {
+		if errors.Is(err, service.ErrChallengeDisabled) {
+			c.JSON(http.StatusNotFound, vo.ErrorVO{
+				Code:    "NOT_FOUND",
+				Message: "Proof-of-work is not enabled",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to issue challenge",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, challenge)
+}
+
 // List handles GET /v1/reports
 // @Summary List reports
 // @Description Get a paginated list of reports
@@ -65,8 +115,10 @@ func (h *ReportHandler) Create(c *gin.Context) {
 // @Param pageSize query int false "Page size" default(20)
 // @Param status query string false "Filter by status"
 // @Param category query string false "Filter by category"
+// @Param zoneId query string false "Filter by pilot zone"
 // @Param sortBy query string false "Sort by field" default(createdAt)
 // @Param sortDir query string false "Sort direction" default(desc)
+// @Param cursor query string false "Cursor from a previous page (keyset pagination)"
 // @Success 200 {object} vo.ReportListVO
 // @Failure 400 {object} vo.ErrorVO
 // @Failure 500 {object} vo.ErrorVO
@@ -98,6 +150,20 @@ func (h *ReportHandler) List(c *gin.Context) {
 
 	reports, err := h.reportSvc.List(c.Request.Context(), query)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_CURSOR",
+				Message: "Cursor is invalid or expired",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrCursorSort) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "VALIDATION_ERROR",
+				Message: "Cursor pagination requires sortBy=createdAt",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
 			Code:    "INTERNAL_ERROR",
 			Message: "Failed to list reports",
@@ -108,6 +174,64 @@ func (h *ReportHandler) List(c *gin.Context) {
 	c.JSON(http.StatusOK, reports)
 }
 
+// Search handles GET /v1/reports/search
+// @Summary Search reports
+// @Description Full-text search over description and area with filters and facet counts
+// @Tags reports
+// @Produce json
+// @Param q query string false "Search text (English words or Chinese)"
+// @Param from query string false "Created on or after (YYYY-MM-DD)"
+// @Param to query string false "Created on or before (YYYY-MM-DD)"
+// @Param status query string false "Filter by status"
+// @Param category query string false "Filter by category"
+// @Param severitySuggested query string false "Filter by suggested severity"
+// @Param severityFinal query string false "Filter by latest triage severity"
+// @Param evidenceLevel query string false "Filter by latest evidence level"
+// @Param zoneId query string false "Filter by pilot zone"
+// @Param incidentId query string false "Filter by incident"
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Success 200 {object} vo.ReportSearchVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/search [get]
+func (h *ReportHandler) Search(c *gin.Context) {
+	var query dto.SearchReportsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 20
+	}
+
+	results, err := h.reportSvc.Search(c.Request.Context(), query)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidDateRange) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_DATE_RANGE",
+				Message: "The from date must not be after the to date",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to search reports",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
 // GetByID handles GET /v1/reports/:id
 // @Summary Get report by ID
 // @Description Get detailed information about a specific report
@@ -141,3 +265,169 @@ func (h *ReportHandler) GetByID(c *gin.Context) {
 
 	c.JSON(http.StatusOK, report)
 }
+
+// Review handles POST /v1/reports/:id/review
+// @Summary Claim a report for review
+// @Description Claim a report as from the triage queue and move it to under_review
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param request body dto.ReportStatusRequest true "Reason for the change"
+// @Success 200 {object} vo.ReportVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/review [post]
+func (h *ReportHandler) Review(c *gin.Context) {
+	h.changeStatus(c, h.reportSvc.Review, "Failed to claim report")
+}
+
+// MarkSpam handles POST /v1/reports/:id/spam
+// @Summary Mark a report as spam
+// @Description Mark a report as spam or abuse (counts toward the abuse-rate KPI)
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param request body dto.ReportStatusRequest true "Reason for the change"
+// @Success 200 {object} vo.ReportVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/spam [post]
+func (h *ReportHandler) MarkSpam(c *gin.Context) {
+	h.changeStatus(c, h.reportSvc.MarkSpam, "Failed to mark report as spam")
+}
+
+// Close handles POST /v1/reports/:id/close
+// @Summary Close a report
+// @Description Close a report without further action
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param request body dto.ReportStatusRequest true "Reason for the change"
+// @Success 200 {object} vo.ReportVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/close [post]
+func (h *ReportHandler) Close(c *gin.Context) {
+	h.changeStatus(c, h.reportSvc.Close, "Failed to close report")
+}
+
+// Reopen handles POST /v1/reports/:id/reopen
+// @Summary Reopen a report
+// @Description Return a closed or spam report to review
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param request body dto.ReportStatusRequest true "Reason for the change"
+// @Success 200 {object} vo.ReportVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/reopen [post]
+func (h *ReportHandler) Reopen(c *gin.Context) {
+	h.changeStatus(c, h.reportSvc.Reopen, "Failed to reopen report")
+}
+
+// Admit handles POST /v1/reports/:id/admit
+// @Summary Admit a quarantined report
+// @Description Move a report held by the intake gate into the triage queue
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID"
+// @Param request body dto.ReportStatusRequest true "Reason for the change"
+// @Success 200 {object} vo.ReportVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/reports/{id}/admit [post]
+func (h *ReportHandler) Admit(c *gin.Context) {
+	h.changeStatus(c, h.reportSvc.Admit, "Failed to admit report")
+}
+
+// reportStatusFunc is a report service status change
+type reportStatusFunc func(ctx context.Context, id string, req dto.ReportStatusRequest, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error)
+
+// changeStatus binds a status change request and maps service errors
+func (h *ReportHandler) changeStatus(c *gin.Context, change reportStatusFunc, message string) {
+	id := c.Param("id")
+
+	var req dto.ReportStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	report, err := change(c.Request.Context(), id, req, userID, actorIP)
+	if err != nil {
+		if errors.Is(err, service.ErrReportNotFound) {
+			c.JSON(http.StatusNotFound, vo.ErrorVO{
+				Code:    "NOT_FOUND",
+				Message: "Report not found",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_TRANSITION",
+				Message: "Invalid status transition",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrReportClaimed) {
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "REPORT_CLAIMED",
+				Message: "Report is claimed by another triager",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrInConsensus) {
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "IN_CONSENSUS",
+				Message: "Report is waiting for a second review or a lead's tie-break",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrNotClaimHolder) {
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "NOT_CLAIM_HOLDER",
+				Message: "Only a signed-in user can claim a report",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// RetentionHandler handles data retention HTTP requests
+type RetentionHandler struct {
+	retentionSvc *service.RetentionService
+}
+
+// NewRetentionHandler creates a new retention handler
+func NewRetentionHandler(retentionSvc *service.RetentionService) *RetentionHandler {
+	return &RetentionHandler{retentionSvc: retentionSvc}
+}
+
+// Preview handles GET /v1/retention/preview
+// @Summary Preview a retention purge
+// @Description Count what the retention purge would remove if it ran now, per retention class. Nothing is changed.
+// @Tags audit
+// @Produce json
+// @Success 200 {object} vo.RetentionSummaryVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/retention/preview [get]
+func (h *RetentionHandler) Preview(c *gin.Context) {
+	summary, err := h.retentionSvc.Preview(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to preview retention purge",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// ListRuns handles GET /v1/retention/runs
+// @Summary List retention purges
+// @Description List the signed purge summaries recorded in the audit log, newest first, with whether each signature is valid
+// @Tags audit
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Success 200 {object} vo.RetentionRunListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/retention/runs [get]
+func (h *RetentionHandler) ListRuns(c *gin.Context) {
+	var query dto.ListRetentionRunsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	runs, err := h.retentionSvc.ListRuns(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list retention purges",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, runs)
+}
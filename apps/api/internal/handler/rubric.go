@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// RubricHandler handles triage rubric HTTP requests
+type RubricHandler struct {
+	rubricSvc *service.RubricService
+}
+
+// NewRubricHandler creates a new rubric handler
+func NewRubricHandler(rubricSvc *service.RubricService) *RubricHandler {
+	return &RubricHandler{rubricSvc: rubricSvc}
+}
+
+// List handles GET /v1/triage-rubrics
+// @Summary List triage rubric versions
+// @Description Get every rubric version, newest first
+// @Tags triage
+// @Produce json
+// @Success 200 {array} vo.TriageRubricVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-rubrics [get]
+func (h *RubricHandler) List(c *gin.Context) {
+	rubrics, err := h.rubricSvc.List(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err, "Failed to list triage rubrics")
+		return
+	}
+
+	c.JSON(http.StatusOK, rubrics)
+}
+
+// GetActive handles GET /v1/triage-rubrics/active
+// @Summary Get the active triage rubric
+// @Description Get the rubric triage decisions are currently checked against
+// @Tags triage
+// @Produce json
+// @Success 200 {object} vo.TriageRubricVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-rubrics/active [get]
+func (h *RubricHandler) GetActive(c *gin.Context) {
+	rubric, err := h.rubricSvc.GetActive(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err, "Failed to get triage rubric")
+		return
+	}
+
+	c.JSON(http.StatusOK, rubric)
+}
+
+// GetByVersion handles GET /v1/triage-rubrics/:version
+// @Summary Get a triage rubric version
+// @Description Get a rubric version, e.g. the one an earlier decision was made under
+// @Tags triage
+// @Produce json
+// @Param version path int true "Rubric version"
+// @Success 200 {object} vo.TriageRubricVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-rubrics/{version} [get]
+func (h *RubricHandler) GetByVersion(c *gin.Context) {
+	rubric, err := h.rubricSvc.GetByVersion(c.Request.Context(), c.Param("version"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get triage rubric")
+		return
+	}
+
+	c.JSON(http.StatusOK, rubric)
+}
+
+// Create handles POST /v1/triage-rubrics
+// @Summary Save a triage rubric version
+// @Description Save the rules as a new rubric version and make it the active one (admin only)
+// @Tags triage
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateRubricRequest true "Rubric"
+// @Success 201 {object} vo.TriageRubricVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-rubrics [post]
+func (h *RubricHandler) Create(c *gin.Context) {
+	var req dto.CreateRubricRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	rubric, err := h.rubricSvc.Create(c.Request.Context(), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to save triage rubric")
+		return
+	}
+
+	c.JSON(http.StatusCreated, rubric)
+}
+
+// Activate handles POST /v1/triage-rubrics/:version/activate
+// @Summary Activate a triage rubric version
+// @Description Check decisions against an earlier version again, e.g. to roll back (admin only)
+// @Tags triage
+// @Produce json
+// @Param version path int true "Rubric version"
+// @Success 200 {object} vo.TriageRubricVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-rubrics/{version}/activate [post]
+func (h *RubricHandler) Activate(c *gin.Context) {
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	rubric, err := h.rubricSvc.Activate(c.Request.Context(), c.Param("version"), userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to activate triage rubric")
+		return
+	}
+
+	c.JSON(http.StatusOK, rubric)
+}
+
+// handleError maps rubric service errors to HTTP responses
+func (h *RubricHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrRubricNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Rubric version not found",
+		})
+	case errors.Is(err, service.ErrNoActiveRubric):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "No rubric version is active",
+		})
+	case errors.Is(err, service.ErrRubricCategory):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: "categories must be report categories",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
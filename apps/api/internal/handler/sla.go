@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// SLAHandler handles triage SLA HTTP requests
+type SLAHandler struct {
+	slaSvc *service.SLAService
+}
+
+// NewSLAHandler creates a new SLA handler
+func NewSLAHandler(slaSvc *service.SLAService) *SLAHandler {
+	return &SLAHandler{slaSvc: slaSvc}
+}
+
+// ListPolicies handles GET /v1/sla/policies
+// @Summary List SLA policies
+// @Description Get the triage SLA for each severity
+// @Tags sla
+// @Produce json
+// @Success 200 {array} vo.SLAPolicyVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/sla/policies [get]
+func (h *SLAHandler) ListPolicies(c *gin.Context) {
+	policies, err := h.slaSvc.ListPolicies(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list SLA policies",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// UpdatePolicy handles PUT /v1/sla/policies/:severity
+// @Summary Update an SLA policy
+// @Description Set the triage deadline and warning lead time for a severity (admin only)
+// @Tags sla
+// @Accept json
+// @Produce json
+// @Param severity path string true "Severity (S0-S4)"
+// @Param request body dto.UpdateSLAPolicyRequest true "Policy"
+// @Success 200 {object} vo.SLAPolicyVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/sla/policies/{severity} [put]
+func (h *SLAHandler) UpdatePolicy(c *gin.Context) {
+	severity := c.Param("severity")
+
+	var req dto.UpdateSLAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	actorIP := c.ClientIP()
+	policy, err := h.slaSvc.UpdatePolicy(c.Request.Context(), severity, req, userID, actorIP)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidSeverity) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_SEVERITY",
+				Message: "Severity must be one of S0-S4",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to update SLA policy",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// ListBreaches handles GET /v1/sla/breaches
+// @Summary List SLA breaches
+// @Description Get recorded SLA warnings and breaches, newest first
+// @Tags sla
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param level query string false "Filter by level (warning, breach)"
+// @Param severity query string false "Filter by severity"
+// @Param open query bool false "Only reports still awaiting triage"
+// @Success 200 {object} vo.SLABreachListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/sla/breaches [get]
+func (h *SLAHandler) ListBreaches(c *gin.Context) {
+	var query dto.ListSLABreachesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 20
+	}
+
+	breaches, err := h.slaSvc.ListBreaches(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list SLA breaches",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, breaches)
+}
+
+// GetStats handles GET /v1/sla/stats
+// @Summary SLA performance
+// @Description Breach counts and average overrun by severity
+// @Tags sla
+// @Produce json
+// @Param days query int false "Look-back window in days" default(30)
+// @Success 200 {array} vo.SLAStatVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/sla/stats [get]
+func (h *SLAHandler) GetStats(c *gin.Context) {
+	var query dto.SLAStatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if query.Days == 0 {
+		query.Days = 30
+	}
+
+	stats, err := h.slaSvc.GetStats(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to get SLA stats",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// SetOnCall handles PUT /v1/sla/on-call
+// @Summary Set on-call status
+// @Description Mark yourself on call to receive SLA escalations for unclaimed reports
+// @Tags sla
+// @Accept json
+// @Param request body dto.SetOnCallRequest true "On-call status"
+// @Success 204
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/sla/on-call [put]
+func (h *SLAHandler) SetOnCall(c *gin.Context) {
+	var req dto.SetOnCallRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, vo.ErrorVO{
+			Code:    "UNAUTHORIZED",
+			Message: "Not authenticated",
+		})
+		return
+	}
+
+	actorIP := c.ClientIP()
+	if err := h.slaSvc.SetOnCall(c.Request.Context(), userID.(uuid.UUID), *req.OnCall, actorIP); err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to update on-call status",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,351 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// SubjectHandler handles data subject request HTTP requests
+type SubjectHandler struct {
+	subjectSvc *service.SubjectService
+}
+
+// NewSubjectHandler creates a new subject request handler
+func NewSubjectHandler(subjectSvc *service.SubjectService) *SubjectHandler {
+	return &SubjectHandler{subjectSvc: subjectSvc}
+}
+
+// Create handles POST /v1/subject-requests
+// @Summary Log a data subject request
+// @Description Log an access or erasure request received from a data subject, identified by follow-up token, contact or training participant hash. The deadline is one month from receipt.
+// @Tags subject-requests
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateSubjectRequest true "Subject request"
+// @Success 201 {object} vo.SubjectRequestVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests [post]
+func (h *SubjectHandler) Create(c *gin.Context) {
+	var req dto.CreateSubjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	subject, err := h.subjectSvc.Create(c.Request.Context(), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to create subject request")
+		return
+	}
+
+	c.JSON(http.StatusCreated, subject)
+}
+
+// List handles GET /v1/subject-requests
+// @Summary List data subject requests
+// @Description Get data subject requests, soonest deadline first
+// @Tags subject-requests
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Page size" default(20)
+// @Param status query string false "Filter by status (received, verified, fulfilled, rejected)"
+// @Param kind query string false "Filter by kind (access, erasure)"
+// @Param overdue query bool false "Only open requests past their deadline"
+// @Success 200 {object} vo.SubjectRequestListVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests [get]
+func (h *SubjectHandler) List(c *gin.Context) {
+	var query dto.ListSubjectRequestsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	subjects, err := h.subjectSvc.List(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: "Failed to list subject requests",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, subjects)
+}
+
+// GetByID handles GET /v1/subject-requests/:id
+// @Summary Get data subject request by ID
+// @Description Get a data subject request with its deadline and outcome
+// @Tags subject-requests
+// @Accept json
+// @Produce json
+// @Param id path string true "Subject request ID"
+// @Success 200 {object} vo.SubjectRequestVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests/{id} [get]
+func (h *SubjectHandler) GetByID(c *gin.Context) {
+	subject, err := h.subjectSvc.GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get subject request")
+		return
+	}
+
+	c.JSON(http.StatusOK, subject)
+}
+
+// Verify handles POST /v1/subject-requests/:id/verify
+// @Summary Verify a data subject request
+// @Description Record how the requester's identity was checked. Data is only exported or erased for verified requests.
+// @Tags subject-requests
+// @Accept json
+// @Produce json
+// @Param id path string true "Subject request ID"
+// @Param request body dto.VerifySubjectRequest true "Verification"
+// @Success 200 {object} vo.SubjectRequestVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests/{id}/verify [post]
+func (h *SubjectHandler) Verify(c *gin.Context) {
+	var req dto.VerifySubjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	subject, err := h.subjectSvc.Verify(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to verify subject request")
+		return
+	}
+
+	c.JSON(http.StatusOK, subject)
+}
+
+// Extend handles POST /v1/subject-requests/:id/extend
+// @Summary Extend a data subject request deadline
+// @Description Extend an open request's deadline by two months, once and before the original deadline
+// @Tags subject-requests
+// @Accept json
+// @Produce json
+// @Param id path string true "Subject request ID"
+// @Param request body dto.ExtendSubjectRequest true "Extension"
+// @Success 200 {object} vo.SubjectRequestVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests/{id}/extend [post]
+func (h *SubjectHandler) Extend(c *gin.Context) {
+	var req dto.ExtendSubjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	subject, err := h.subjectSvc.Extend(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to extend subject request")
+		return
+	}
+
+	c.JSON(http.StatusOK, subject)
+}
+
+// Reject handles POST /v1/subject-requests/:id/reject
+// @Summary Reject a data subject request
+// @Description Close an open request without acting on it, for instance when the requester's identity could not be verified
+// @Tags subject-requests
+// @Accept json
+// @Produce json
+// @Param id path string true "Subject request ID"
+// @Param request body dto.RejectSubjectRequest true "Rejection"
+// @Success 200 {object} vo.SubjectRequestVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests/{id}/reject [post]
+func (h *SubjectHandler) Reject(c *gin.Context) {
+	var req dto.RejectSubjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	subject, err := h.subjectSvc.Reject(c.Request.Context(), c.Param("id"), req, userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to reject subject request")
+		return
+	}
+
+	c.JSON(http.StatusOK, subject)
+}
+
+// Export handles GET /v1/subject-requests/:id/export
+// @Summary Export a data subject's data
+// @Description Download everything held about the subject of a verified request as a JSON bundle: reports, evidence files (base64), appeals, chat subscriptions, submission IPs and training records. Exports are audited.
+// @Tags subject-requests
+// @Produce json
+// @Param id path string true "Subject request ID"
+// @Success 200 {object} vo.SubjectExportVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests/{id}/export [get]
+func (h *SubjectHandler) Export(c *gin.Context) {
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	bundle, err := h.subjectSvc.Export(c.Request.Context(), c.Param("id"), userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to export subject data")
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": "subject-request-" + bundle.RequestID + ".json"}))
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, bundle)
+}
+
+// Fulfil handles POST /v1/subject-requests/:id/fulfil
+// @Summary Fulfil a data subject request
+// @Description Close a verified request. An access request records that the bundle was sent; an erasure request first anonymizes the subject's reports, deletes their evidence, subscriptions and training attendance, and strips quiz results of the participant hash. The subject's identifiers are then cleared from the request.
+// @Tags subject-requests
+// @Produce json
+// @Param id path string true "Subject request ID"
+// @Success 200 {object} vo.SubjectRequestVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/subject-requests/{id}/fulfil [post]
+func (h *SubjectHandler) Fulfil(c *gin.Context) {
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	subject, err := h.subjectSvc.Fulfil(c.Request.Context(), c.Param("id"), userID, c.ClientIP())
+	if err != nil {
+		h.handleError(c, err, "Failed to fulfil subject request")
+		return
+	}
+
+	c.JSON(http.StatusOK, subject)
+}
+
+// handleError maps subject request service errors to HTTP responses
+func (h *SubjectHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrSubjectRequestNotFound):
+		c.JSON(http.StatusNotFound, vo.ErrorVO{
+			Code:    "NOT_FOUND",
+			Message: "Subject request not found",
+		})
+	case errors.Is(err, service.ErrSubjectReceivedAt):
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: "receivedAt must not be in the future",
+		})
+	case errors.Is(err, service.ErrSubjectRequestClosed):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "REQUEST_CLOSED",
+			Message: "Subject request has already been fulfilled or rejected",
+		})
+	case errors.Is(err, service.ErrSubjectNotVerified):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "NOT_VERIFIED",
+			Message: "The requester's identity must be verified first",
+		})
+	case errors.Is(err, service.ErrSubjectVerified):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "ALREADY_VERIFIED",
+			Message: "Subject request has already been verified",
+		})
+	case errors.Is(err, service.ErrSubjectExtended):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "ALREADY_EXTENDED",
+			Message: "The deadline has already been extended",
+		})
+	case errors.Is(err, service.ErrSubjectPastDue):
+		c.JSON(http.StatusConflict, vo.ErrorVO{
+			Code:    "PAST_DUE",
+			Message: "The deadline has passed and can no longer be extended",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+			Code:    "INTERNAL_ERROR",
+			Message: message,
+		})
+	}
+}
@@ -74,6 +74,7 @@ func (h *TrainingHandler) Create(c *gin.Context) {
 // @Param pageSize query int false "Page size" default(20)
 // @Param from query string false "From date (YYYY-MM-DD)"
 // @Param to query string false "To date (YYYY-MM-DD)"
+// @Param cursor query string false "Cursor from a previous page (keyset pagination)"
 // @Success 200 {object} vo.TrainingEventListVO
 // @Failure 400 {object} vo.ErrorVO
 // @Failure 500 {object} vo.ErrorVO
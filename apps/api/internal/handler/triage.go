@@ -2,6 +2,7 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -24,15 +25,17 @@ func NewTriageHandler(triageSvc *service.TriageService) *TriageHandler {
 
 // TriageReport handles POST /v1/reports/:id/triage
 // @Summary Triage a report
-// @Description Create a triage decision for a report
+// @Description Create a triage decision for a report. The decision must meet the active triage rubric for the report's category; what it lacks is listed in details. High-severity decisions are recorded as blind reviews in a consensus round: the round is returned with 202 until a second reviewer agrees, or a lead breaks the tie.
 // @Tags triage
 // @Accept json
 // @Produce json
 // @Param id path string true "Report ID"
 // @Param request body dto.TriageRequest true "Triage decision"
 // @Success 200 {object} vo.TriageDecisionVO
+// @Success 202 {object} vo.ConsensusRoundVO
 // @Failure 400 {object} vo.ErrorVO
 // @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.ErrorVO
 // @Failure 500 {object} vo.ErrorVO
 // @Security BearerAuth
 // @Router /v1/reports/{id}/triage [post]
@@ -57,7 +60,7 @@ func (h *TriageHandler) TriageReport(c *gin.Context) {
 	}
 
 	actorIP := c.ClientIP()
-	decision, err := h.triageSvc.TriageReport(c.Request.Context(), reportID, req, userID, actorIP)
+	decision, round, err := h.triageSvc.TriageReport(c.Request.Context(), reportID, req, userID, actorIP)
 	if err != nil {
 		if errors.Is(err, service.ErrReportNotFound) {
 			c.JSON(http.StatusNotFound, vo.ErrorVO{
@@ -66,6 +69,57 @@ func (h *TriageHandler) TriageReport(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, service.ErrReportClaimed) {
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "REPORT_CLAIMED",
+				Message: "Report is claimed by another triager",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_TRANSITION",
+				Message: "Report status does not allow this decision",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrReviewerRequired) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "REVIEWER_REQUIRED",
+				Message: "High-severity reviews need a signed-in reviewer",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrAlreadyReviewed) {
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "ALREADY_REVIEWED",
+				Message: "You have already reviewed this report; a second triager must review it",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrRoundDisputed) {
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "CONSENSUS_DISPUTED",
+				Message: "Reviewers disagree on this report; a lead must break the tie",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrRoundChanged) {
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "CONSENSUS_CHANGED",
+				Message: "Another review was recorded at the same time; try again",
+			})
+			return
+		}
+		var rubricErr *service.RubricError
+		if errors.As(err, &rubricErr) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "RUBRIC_VIOLATION",
+				Message: fmt.Sprintf("Decision does not meet triage rubric version %d", rubricErr.Version),
+				Details: rubricErr.Violations,
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
 			Code:    "INTERNAL_ERROR",
 			Message: "Failed to triage report",
@@ -73,9 +127,89 @@ func (h *TriageHandler) TriageReport(c *gin.Context) {
 		return
 	}
 
+	if round != nil {
+		// The decision waits for another review
+		c.JSON(http.StatusAccepted, round)
+		return
+	}
 	c.JSON(http.StatusOK, decision)
 }
 
+// BulkTriage handles POST /v1/triage-decisions/bulk
+// @Summary Bulk triage reports
+// @Description Apply one decision (a triage decision, spam, or duplicate of an incident) to many reports in a single transaction. Reports that cannot be changed, or are in a consensus round, are skipped; with atomic set the whole batch is refused instead. High-severity triage decisions need independent reviews and cannot be applied in bulk.
+// @Tags triage
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkTriageRequest true "Bulk triage"
+// @Success 200 {object} vo.BulkTriageResultVO
+// @Failure 400 {object} vo.ErrorVO
+// @Failure 404 {object} vo.ErrorVO
+// @Failure 409 {object} vo.BulkTriageResultVO
+// @Failure 500 {object} vo.ErrorVO
+// @Security BearerAuth
+// @Router /v1/triage-decisions/bulk [post]
+func (h *TriageHandler) BulkTriage(c *gin.Context) {
+	var req dto.BulkTriageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, vo.ErrorVO{
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get user ID from context (set by auth middleware)
+	var userID *uuid.UUID
+	if uid, exists := c.Get("userID"); exists {
+		if id, ok := uid.(uuid.UUID); ok {
+			userID = &id
+		}
+	}
+
+	result, err := h.triageSvc.BulkTriage(c.Request.Context(), req, userID, c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBatchIncomplete):
+			// The per-report results say why the batch was refused
+			c.JSON(http.StatusConflict, result)
+		case errors.Is(err, service.ErrSeverityRequired):
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "VALIDATION_ERROR",
+				Message: "severityFinal is required for triage decisions",
+			})
+		case errors.Is(err, service.ErrConsensusRequired):
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "CONSENSUS_REQUIRED",
+				Message: "High-severity decisions need independent reviews; triage these reports one at a time",
+			})
+		case errors.Is(err, service.ErrIncidentNotFound):
+			c.JSON(http.StatusNotFound, vo.ErrorVO{
+				Code:    "NOT_FOUND",
+				Message: "Incident not found",
+			})
+		case errors.Is(err, service.ErrIncidentMerged):
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "INCIDENT_MERGED",
+				Message: "Incident has been merged",
+			})
+		case errors.Is(err, service.ErrBatchConflict):
+			c.JSON(http.StatusConflict, vo.ErrorVO{
+				Code:    "BATCH_CONFLICT",
+				Message: "A report changed while the batch was applied; nothing was changed",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, vo.ErrorVO{
+				Code:    "INTERNAL_ERROR",
+				Message: "Failed to triage reports",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // List handles GET /v1/triage-decisions
 // @Summary List triage decisions
 // @Description Get a paginated list of triage decisions
@@ -86,6 +220,7 @@ func (h *TriageHandler) TriageReport(c *gin.Context) {
 // @Param pageSize query int false "Page size" default(20)
 // @Param reportId query string false "Filter by report ID"
 // @Param decision query string false "Filter by decision type"
+// @Param cursor query string false "Cursor from a previous page (keyset pagination)"
 // @Success 200 {object} vo.TriageDecisionListVO
 // @Failure 400 {object} vo.ErrorVO
 // @Failure 500 {object} vo.ErrorVO
@@ -111,6 +246,13 @@ func (h *TriageHandler) List(c *gin.Context) {
 
 	decisions, err := h.triageSvc.List(c.Request.Context(), query)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, vo.ErrorVO{
+				Code:    "INVALID_CURSOR",
+				Message: "Cursor is invalid or expired",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, vo.ErrorVO{
 			Code:    "INTERNAL_ERROR",
 			Message: "Failed to list triage decisions",
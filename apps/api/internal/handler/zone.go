@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
+)
+
+// ZoneHandler handles pilot zone HTTP requests
+type ZoneHandler struct {
+	locationSvc *service.LocationService
+}
+
+// NewZoneHandler creates a new zone handler
+func NewZoneHandler(locationSvc *service.LocationService) *ZoneHandler {
+	return &ZoneHandler{locationSvc: locationSvc}
+}
+
+// List handles GET /v1/zones
+// @Summary List pilot zones
+// @Description Get the pilot-zone gazetteer (Taipei districts and Glasgow wards)
+// @Tags zones
+// @Produce json
+// @Success 200 {array} vo.ZoneVO
+// @Router /v1/zones [get]
+func (h *ZoneHandler) List(c *gin.Context) {
+	c.JSON(http.StatusOK, h.locationSvc.ListZones())
+}
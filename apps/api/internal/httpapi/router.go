@@ -2,7 +2,11 @@ package httpapi
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -11,6 +15,13 @@ import (
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/handler"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/middleware"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/captcha"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/geo"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/line"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/mailer"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/smtpd"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/stix"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/whatsapp"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
@@ -21,6 +32,8 @@ type Server struct {
 	Router *gin.Engine
 	DB     *repository.DB
 	Config *config.Config
+
+	stopWorkers context.CancelFunc
 }
 
 // NewServer creates a new HTTP server
@@ -48,14 +61,110 @@ func NewServer(cfg *config.Config) (*Server, error) {
 	alertRepo := repository.NewAlertRepository(db)
 	trainingRepo := repository.NewTrainingRepository(db)
 	auditRepo := repository.NewAuditRepository(db)
+	incidentRepo := repository.NewIncidentRepository(db)
+	duplicateRepo := repository.NewDuplicateRepository(db)
+	leaseStore := repository.NewLeaseStore(db)
+	slaRepo := repository.NewSLARepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	nonceStore := repository.NewNonceStore(db)
+	reputationRepo := repository.NewReputationRepository(db)
+	brigadeRepo := repository.NewBrigadeRepository(db)
+	appealRepo := repository.NewAppealRepository(db)
+	indicatorRepo := repository.NewIndicatorRepository(db)
+	claimRepo := repository.NewClaimRepository(db)
+	evidenceRepo := repository.NewEvidenceRepository(db)
+	subscriberRepo := repository.NewSubscriberRepository(db)
+	conversationStore := repository.NewConversationStore(db)
+	importRepo := repository.NewImportRepository(db)
+	retentionRepo := repository.NewRetentionRepository(db)
+	subjectRepo := repository.NewSubjectRepository(db)
+	rubricRepo := repository.NewRubricRepository(db)
+	consensusRepo := repository.NewConsensusRepository(db)
+
+	if cfg.RetentionContacts > 0 && cfg.RetentionContacts < cfg.AppealWindow {
+		return nil, fmt.Errorf("RETENTION_CONTACTS %s is shorter than APPEAL_WINDOW %s", cfg.RetentionContacts, cfg.AppealWindow)
+	}
+
+	// High-severity decisions need a second review from this severity up
+	consensusSeverity := cfg.TriageConsensusSeverity
+	if consensusSeverity == "none" {
+		consensusSeverity = ""
+	} else if model.SeverityRank(consensusSeverity) < 0 {
+		return nil, fmt.Errorf("invalid TRIAGE_CONSENSUS_SEVERITY %q", cfg.TriageConsensusSeverity)
+	}
+
+	if !stix.ValidTLP(cfg.STIXTLP) {
+		return nil, fmt.Errorf("invalid STIX_TLP %q", cfg.STIXTLP)
+	}
+
+	// Signing secrets have no safe default outside dev
+	if cfg.IntakeSecret, err = requireSecret(cfg, "INTAKE_SECRET", cfg.IntakeSecret, "dev-intake-secret-change-in-production"); err != nil {
+		return nil, err
+	}
+	if cfg.RetentionSigningKey, err = requireSecret(cfg, "RETENTION_SIGNING_KEY", cfg.RetentionSigningKey, "dev-retention-key-change-in-production"); err != nil {
+		return nil, err
+	}
+	if cfg.ReputationSalt, err = requireSecret(cfg, "REPUTATION_SALT", cfg.ReputationSalt, "dev-reputation-salt-change-in-production"); err != nil {
+		return nil, err
+	}
+
+	// Load pilot-zone gazetteer
+	gazetteer, err := geo.LoadGazetteer()
+	if err != nil {
+		return nil, err
+	}
+
+	// Reporter reputation and brigade detection feed both triage and the intake gates
+	notificationSvc := service.NewNotificationService(notificationRepo)
+	reputationSvc := service.NewReputationService(reputationRepo, cfg.ReputationSalt, cfg.ReputationRotation, cfg.ReputationRetention)
+	brigadeSvc := service.NewBrigadeService(brigadeRepo, reportRepo, userRepo, auditRepo, notificationSvc, reputationSvc,
+		cfg.BrigadeWindow, cfg.BrigadeMinReports, cfg.BrigadeSourceMin, cfg.BrigadeTightenFor)
+
+	// Build intake gates for public report submissions
+	intakeGates, err := newIntakeGates(cfg, reportRepo, nonceStore, reputationSvc, brigadeSvc)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create services
 	authSvc := service.NewAuthService(userRepo, auditRepo, cfg.JWTSecret, cfg.JWTExpiration)
-	reportSvc := service.NewReportService(reportRepo, auditRepo)
-	triageSvc := service.NewTriageService(triageRepo, reportRepo, auditRepo)
-	alertSvc := service.NewAlertService(alertRepo, auditRepo, cfg.CAPSender)
+	locationSvc := service.NewLocationService(gazetteer, cfg.LocationPrecision)
+	if _, err := locationSvc.Resolve(cfg.EmailZone, "", nil, nil); err != nil {
+		return nil, fmt.Errorf("invalid EMAIL_ZONE %q: %w", cfg.EmailZone, err)
+	}
+	if _, err := locationSvc.Resolve(cfg.ChatZone, "", nil, nil); err != nil {
+		return nil, fmt.Errorf("invalid CHAT_ZONE %q: %w", cfg.ChatZone, err)
+	}
+	duplicateSvc := service.NewDuplicateService(duplicateRepo, reportRepo, incidentRepo, auditRepo, cfg.DuplicateThreshold, cfg.DuplicateLookback)
+	intakeSvc := service.NewIntakeService(cfg.IntakeSecret, cfg.IntakeQuarantineThreshold, intakeGates...)
+	indicatorSvc := service.NewIndicatorService(indicatorRepo, reportRepo, auditRepo, cfg.STIXIdentity, cfg.STIXTLP)
+	claimSvc := service.NewClaimService(claimRepo, reportRepo, incidentRepo, auditRepo, cfg.PublisherName, cfg.PublicSiteURL)
+	queueSvc := service.NewQueueService(reportRepo, userRepo, auditRepo, leaseStore, cfg.TriageLeaseTTL, reputationSvc)
+	reportSvc := service.NewReportService(reportRepo, consensusRepo, auditRepo, duplicateSvc, locationSvc, intakeSvc, queueSvc, leaseStore, reputationSvc, brigadeSvc, indicatorSvc)
+	triageSvc := service.NewTriageService(triageRepo, reportRepo, incidentRepo, rubricRepo, consensusRepo, userRepo, auditRepo, leaseStore,
+		reputationSvc, notificationSvc, consensusSeverity)
+	alertSvc := service.NewAlertService(alertRepo, incidentRepo, auditRepo, locationSvc, cfg.CAPSender)
 	trainingSvc := service.NewTrainingService(trainingRepo, auditRepo)
-	metricsSvc := service.NewMetricsService(reportRepo, triageRepo, alertRepo, trainingRepo, userRepo)
+	incidentSvc := service.NewIncidentService(incidentRepo, reportRepo, auditRepo)
+	auditSvc := service.NewAuditService(auditRepo)
+	appealSvc := service.NewAppealService(appealRepo, reportRepo, auditRepo, reputationSvc, nonceStore, cfg.IntakeSecret, cfg.AppealWindow)
+	slaSvc := service.NewSLAService(slaRepo, reportRepo, userRepo, auditRepo, leaseStore, notificationSvc)
+	evidenceSvc := service.NewEvidenceService(evidenceRepo, auditRepo)
+	emailSvc := service.NewEmailIntakeService(reportSvc, indicatorSvc, evidenceRepo, newMailSender(cfg), cfg.IntakeSecret, cfg.EmailZone, cfg.EmailReplyFrom, cfg.EmailAuthservID, cfg.EmailMaxSize)
+	linePlatform, whatsappPlatform := newChatPlatforms(cfg)
+	chatSvc := service.NewChatIntakeService(reportSvc, subscriberRepo, conversationStore, nonceStore, linePlatform, whatsappPlatform,
+		cfg.IntakeSecret, cfg.ChatZone, cfg.ChatSessionTTL, cfg.ChatSubscriptionTTL)
+	importSvc := service.NewImportService(importRepo, reportRepo, auditRepo, locationSvc, duplicateSvc, indicatorSvc, cfg.ImportMaxRows, cfg.ImportMaxSize)
+	retentionSvc := service.NewRetentionService(retentionRepo, auditRepo, service.RetentionPolicy{
+		Reports:   cfg.RetentionReports,
+		Contacts:  cfg.RetentionContacts,
+		Evidence:  cfg.RetentionEvidence,
+		AuditIPs:  cfg.RetentionAuditIPs,
+		AuditLogs: cfg.RetentionAuditLogs,
+	}, cfg.RetentionSigningKey)
+	subjectSvc := service.NewSubjectService(subjectRepo, userRepo, auditRepo, notificationSvc, cfg.IntakeSecret, cfg.SubjectReminder)
+	rubricSvc := service.NewRubricService(rubricRepo, auditRepo)
+	metricsSvc := service.NewMetricsService(reportRepo, triageRepo, alertRepo, trainingRepo, userRepo, incidentRepo, appealRepo, consensusRepo)
 
 	// Create handlers
 	authHandler := handler.NewAuthHandler(authSvc)
@@ -63,16 +172,59 @@ func NewServer(cfg *config.Config) (*Server, error) {
 	triageHandler := handler.NewTriageHandler(triageSvc)
 	alertHandler := handler.NewAlertHandler(alertSvc)
 	trainingHandler := handler.NewTrainingHandler(trainingSvc)
+	incidentHandler := handler.NewIncidentHandler(incidentSvc)
+	duplicateHandler := handler.NewDuplicateHandler(duplicateSvc)
+	zoneHandler := handler.NewZoneHandler(locationSvc)
+	queueHandler := handler.NewQueueHandler(queueSvc)
+	slaHandler := handler.NewSLAHandler(slaSvc)
+	notificationHandler := handler.NewNotificationHandler(notificationSvc)
+	auditHandler := handler.NewAuditHandler(auditSvc)
+	brigadeHandler := handler.NewBrigadeHandler(brigadeSvc)
+	appealHandler := handler.NewAppealHandler(appealSvc)
+	indicatorHandler := handler.NewIndicatorHandler(indicatorSvc)
+	claimHandler := handler.NewClaimHandler(claimSvc)
+	evidenceHandler := handler.NewEvidenceHandler(evidenceSvc)
+	chatHandler := handler.NewChatHandler(chatSvc)
+	importHandler := handler.NewImportHandler(importSvc)
+	retentionHandler := handler.NewRetentionHandler(retentionSvc)
+	subjectHandler := handler.NewSubjectHandler(subjectSvc)
+	rubricHandler := handler.NewRubricHandler(rubricSvc)
 	metricsHandler := handler.NewMetricsHandler(metricsSvc)
 
 	// Rate limiter
 	var rateLimiter gin.HandlerFunc
+	if db.Redis != nil {
+		rateLimiter = middleware.NewRateLimiter(db.Redis, cfg.RateLimitRequests, cfg.RateLimitWindow).Middleware()
+	} else {
+		rateLimiter = middleware.NewInMemoryRateLimiter(cfg.RateLimitRequests).Middleware()
+	}
+
+	// Report submissions are limited on their own. Blocked reporters get a
+	// reference they can appeal with, and an overturned appeal gives them a
+	// pass past the limit.
+	var intakeLimiter gin.HandlerFunc
 	if db.Redis != nil {
 		rl := middleware.NewRateLimiter(db.Redis, cfg.RateLimitRequests, cfg.RateLimitWindow)
-		rateLimiter = rl.Middleware()
+		rl.Scope = "intake"
+		rl.Reference = appealSvc.IssueReference
+		rl.Pass = appealSvc.RedeemPass
+		intakeLimiter = rl.Middleware()
 	} else {
 		rl := middleware.NewInMemoryRateLimiter(cfg.RateLimitRequests)
-		rateLimiter = rl.Middleware()
+		rl.Reference = appealSvc.IssueReference
+		rl.Pass = appealSvc.RedeemPass
+		intakeLimiter = rl.Middleware()
+	}
+
+	// Stricter limit for the public indicator lookup, which could otherwise
+	// be used to walk the hash space
+	var lookupLimiter gin.HandlerFunc
+	if db.Redis != nil {
+		rl := middleware.NewRateLimiter(db.Redis, cfg.LookupRateLimitRequests, cfg.LookupRateLimitWindow)
+		rl.Scope = "lookup"
+		lookupLimiter = rl.Middleware()
+	} else {
+		lookupLimiter = middleware.NewInMemoryRateLimiter(cfg.LookupRateLimitRequests).Middleware()
 	}
 
 	// Health check
@@ -96,6 +248,21 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		})
 	})
 
+	// Chat platform webhooks, registered for configured platforms only. They
+	// are authenticated by signature and all arrive from the platforms' own
+	// addresses, so the per-IP rate limit does not apply.
+	webhooks := r.Group("/v1/webhooks")
+	if linePlatform != nil {
+		webhooks.POST("/line", chatHandler.LINEWebhook)
+	}
+	if whatsappPlatform != nil {
+		webhooks.GET("/whatsapp", chatHandler.WhatsAppVerify)
+		webhooks.POST("/whatsapp", chatHandler.WhatsAppWebhook)
+	}
+
+	// Public report submission, behind the intake limiter only
+	r.POST("/v1/reports", intakeLimiter, reportHandler.Create)
+
 	// API v1 routes
 	v1 := r.Group("/v1")
 	v1.Use(rateLimiter)
@@ -114,30 +281,227 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		authProtected.POST("/api-keys", authHandler.CreateAPIKey)
 	}
 
+	// Zones routes (public)
+	v1.GET("/zones", zoneHandler.List)
+
 	// Reports routes
 	reports := v1.Group("/reports")
 	{
-		// Public: create report
-		reports.POST("", reportHandler.Create)
+		// Public: intake challenge (submissions are registered above)
+		reports.GET("/challenge", reportHandler.Challenge)
 
 		// Protected: list and get reports
 		reportsProtected := reports.Group("")
 		reportsProtected.Use(middleware.AuthMiddleware(authSvc))
 		{
 			reportsProtected.GET("", reportHandler.List)
+			reportsProtected.GET("/search", reportHandler.Search)
+			reportsProtected.POST("/import",
+				middleware.RoleMiddleware(model.RoleAdmin),
+				importHandler.Import,
+			)
 			reportsProtected.GET("/:id", reportHandler.GetByID)
 			reportsProtected.POST("/:id/triage", 
 				middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager),
 				triageHandler.TriageReport,
 			)
+			reportsProtected.GET("/:id/duplicates", duplicateHandler.List)
+			reportsProtected.GET("/:id/evidence/:evidenceId",
+				middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager),
+				evidenceHandler.Download,
+			)
+			reportsProtected.POST("/:id/duplicates/:candidateId/link",
+				middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager),
+				duplicateHandler.Link,
+			)
+			reportsProtected.POST("/:id/duplicates/:candidateId/dismiss",
+				middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager),
+				duplicateHandler.Dismiss,
+			)
+
+			reportsStatus := reportsProtected.Group("/:id")
+			reportsStatus.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+			{
+				reportsStatus.POST("/review", reportHandler.Review)
+				reportsStatus.POST("/spam", reportHandler.MarkSpam)
+				reportsStatus.POST("/close", reportHandler.Close)
+				reportsStatus.POST("/reopen", reportHandler.Reopen)
+				reportsStatus.POST("/admit", reportHandler.Admit)
+			}
 		}
 	}
 
+	// Import batch routes (protected)
+	importBatches := v1.Group("/import-batches")
+	importBatches.Use(middleware.AuthMiddleware(authSvc))
+	importBatches.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		importBatches.GET("", importHandler.List)
+		importBatches.GET("/:id", importHandler.GetByID)
+	}
+
 	// Triage decisions routes (protected)
 	triage := v1.Group("/triage-decisions")
 	triage.Use(middleware.AuthMiddleware(authSvc))
 	{
 		triage.GET("", triageHandler.List)
+		triage.POST("/bulk",
+			middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager),
+			triageHandler.BulkTriage,
+		)
+	}
+
+	// Triage rubric routes (protected; triagers read the rubric they are held to)
+	rubrics := v1.Group("/triage-rubrics")
+	rubrics.Use(middleware.AuthMiddleware(authSvc))
+	rubrics.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		rubrics.GET("", rubricHandler.List)
+		rubrics.GET("/active", rubricHandler.GetActive)
+		rubrics.GET("/:version", rubricHandler.GetByVersion)
+		rubrics.POST("",
+			middleware.RoleMiddleware(model.RoleAdmin),
+			rubricHandler.Create,
+		)
+		rubrics.POST("/:version/activate",
+			middleware.RoleMiddleware(model.RoleAdmin),
+			rubricHandler.Activate,
+		)
+	}
+
+	// Consensus round routes (protected; leads break ties)
+	rounds := v1.Group("/consensus-rounds")
+	rounds.Use(middleware.AuthMiddleware(authSvc))
+	rounds.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		rounds.GET("", triageHandler.ListRounds)
+		rounds.GET("/:id", triageHandler.GetRound)
+		rounds.POST("/:id/resolve",
+			middleware.RoleMiddleware(model.RoleAdmin),
+			triageHandler.ResolveRound,
+		)
+	}
+
+	// Triage queue routes (protected)
+	queue := v1.Group("/triage-queue")
+	queue.Use(middleware.AuthMiddleware(authSvc))
+	queue.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		queue.GET("", queueHandler.List)
+		queue.GET("/claims", queueHandler.ListClaims)
+		queue.POST("/:id/claim", queueHandler.Claim)
+		queue.POST("/:id/release", queueHandler.Release)
+		queue.POST("/:id/assign",
+			middleware.RoleMiddleware(model.RoleAdmin),
+			queueHandler.Assign,
+		)
+	}
+
+	// SLA routes (protected)
+	sla := v1.Group("/sla")
+	sla.Use(middleware.AuthMiddleware(authSvc))
+	sla.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		sla.GET("/policies", slaHandler.ListPolicies)
+		sla.PUT("/policies/:severity",
+			middleware.RoleMiddleware(model.RoleAdmin),
+			slaHandler.UpdatePolicy,
+		)
+		sla.GET("/breaches", slaHandler.ListBreaches)
+		sla.GET("/stats", slaHandler.GetStats)
+		sla.PUT("/on-call", slaHandler.SetOnCall)
+	}
+
+	// Notifications routes (protected)
+	notifications := v1.Group("/notifications")
+	notifications.Use(middleware.AuthMiddleware(authSvc))
+	{
+		notifications.GET("", notificationHandler.List)
+		notifications.POST("/:id/read", notificationHandler.MarkRead)
+	}
+
+	// Incidents routes (protected)
+	incidents := v1.Group("/incidents")
+	incidents.Use(middleware.AuthMiddleware(authSvc))
+	{
+		incidents.GET("", incidentHandler.List)
+		incidents.GET("/:id", incidentHandler.GetByID)
+
+		incidentsWrite := incidents.Group("")
+		incidentsWrite.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+		{
+			incidentsWrite.POST("", incidentHandler.Create)
+			incidentsWrite.PATCH("/:id", incidentHandler.Update)
+			incidentsWrite.POST("/:id/reports", incidentHandler.LinkReports)
+			incidentsWrite.POST("/:id/merge", incidentHandler.Merge)
+			incidentsWrite.POST("/:id/split", incidentHandler.Split)
+		}
+	}
+
+	// Appeal routes: reporters file and check appeals, reviewers decide them
+	appeals := v1.Group("/appeals")
+	{
+		appeals.POST("", appealHandler.Create)
+		appeals.GET("/:id/status", appealHandler.Status)
+
+		appealsReview := appeals.Group("")
+		appealsReview.Use(middleware.AuthMiddleware(authSvc))
+		appealsReview.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+		{
+			appealsReview.GET("", appealHandler.List)
+			appealsReview.GET("/:id", appealHandler.GetByID)
+			appealsReview.POST("/:id/decide", appealHandler.Decide)
+		}
+	}
+
+	// Coordinated reporting (brigade) routes
+	brigades := v1.Group("/brigades")
+	brigades.Use(middleware.AuthMiddleware(authSvc))
+	brigades.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		brigades.GET("", brigadeHandler.List)
+		brigades.GET("/:id", brigadeHandler.GetByID)
+		brigades.POST("/:id/resolve", brigadeHandler.Resolve)
+	}
+
+	// Scam indicator registry routes; the hash-prefix lookup is public
+	v1.GET("/indicators/lookup/:prefix", lookupLimiter, indicatorHandler.Lookup)
+
+	// STIX export for anti-fraud partners; partner API keys need the intel scope
+	v1.GET("/indicators/stix",
+		middleware.AuthMiddleware(authSvc),
+		middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager, model.RoleAuditor),
+		middleware.ScopeMiddleware(model.ScopeIntel),
+		indicatorHandler.ExportSTIX,
+	)
+
+	indicators := v1.Group("/indicators")
+	indicators.Use(middleware.AuthMiddleware(authSvc))
+	indicators.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		indicators.GET("", indicatorHandler.List)
+		indicators.GET("/:id", indicatorHandler.GetByID)
+		indicators.POST("/:id/verify", indicatorHandler.Verify)
+		indicators.POST("/rescan",
+			middleware.RoleMiddleware(model.RoleAdmin),
+			indicatorHandler.Rescan,
+		)
+	}
+
+	// Misinformation claim routes; published reviews are public
+	v1.GET("/claims/reviews", claimHandler.ReviewFeed)
+	v1.GET("/claims/reviews/:id", claimHandler.Review)
+
+	claims := v1.Group("/claims")
+	claims.Use(middleware.AuthMiddleware(authSvc))
+	claims.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleTriager))
+	{
+		claims.POST("", claimHandler.Create)
+		claims.GET("", claimHandler.List)
+		claims.GET("/:id", claimHandler.GetByID)
+		claims.PATCH("/:id", claimHandler.Update)
+		claims.POST("/:id/verdict", claimHandler.SetVerdict)
+		claims.POST("/:id/links", claimHandler.Link)
 	}
 
 	// Alerts routes
@@ -176,6 +540,38 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		}
 	}
 
+	// Audit log routes (protected)
+	audit := v1.Group("/audit-logs")
+	audit.Use(middleware.AuthMiddleware(authSvc))
+	audit.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleAuditor))
+	{
+		audit.GET("", auditHandler.List)
+	}
+
+	// Data retention routes (protected)
+	retention := v1.Group("/retention")
+	retention.Use(middleware.AuthMiddleware(authSvc))
+	retention.Use(middleware.RoleMiddleware(model.RoleAdmin, model.RoleAuditor))
+	{
+		retention.GET("/preview", retentionHandler.Preview)
+		retention.GET("/runs", retentionHandler.ListRuns)
+	}
+
+	// Data subject access and erasure request routes (protected)
+	subjects := v1.Group("/subject-requests")
+	subjects.Use(middleware.AuthMiddleware(authSvc))
+	subjects.Use(middleware.RoleMiddleware(model.RoleAdmin))
+	{
+		subjects.POST("", subjectHandler.Create)
+		subjects.GET("", subjectHandler.List)
+		subjects.GET("/:id", subjectHandler.GetByID)
+		subjects.POST("/:id/verify", subjectHandler.Verify)
+		subjects.POST("/:id/extend", subjectHandler.Extend)
+		subjects.POST("/:id/reject", subjectHandler.Reject)
+		subjects.GET("/:id/export", subjectHandler.Export)
+		subjects.POST("/:id/fulfil", subjectHandler.Fulfil)
+	}
+
 	// Metrics routes
 	metrics := v1.Group("/metrics")
 	{
@@ -190,16 +586,151 @@ func NewServer(cfg *config.Config) (*Server, error) {
 				middleware.RoleMiddleware(model.RoleAdmin, model.RoleAuditor),
 				metricsHandler.GetKPIMetrics,
 			)
+			metricsProtected.GET("/triage-agreement",
+				middleware.RoleMiddleware(model.RoleAdmin, model.RoleAuditor),
+				metricsHandler.GetTriageAgreement,
+			)
 		}
 	}
 
+	// Background workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	if cfg.SLACheckInterval > 0 {
+		go slaSvc.Run(workerCtx, cfg.SLACheckInterval)
+	}
+	if cfg.ReputationPurgeInterval > 0 {
+		go reputationSvc.Run(workerCtx, cfg.ReputationPurgeInterval)
+	}
+	if cfg.RetentionInterval > 0 {
+		go retentionSvc.Run(workerCtx, cfg.RetentionInterval)
+	}
+	if cfg.SubjectCheckInterval > 0 {
+		go subjectSvc.RunDeadlines(workerCtx, cfg.SubjectCheckInterval)
+	}
+
+	// Chat intake: status updates for reporters who filed through a bot
+	if (linePlatform != nil || whatsappPlatform != nil) && cfg.ChatStatusInterval > 0 {
+		go chatSvc.RunStatusUpdates(workerCtx, cfg.ChatStatusInterval)
+	}
+
+	// Email intake: maildrop poller and SMTP listener
+	if cfg.EmailMaildropDir != "" {
+		go emailSvc.RunMaildrop(workerCtx, cfg.EmailMaildropDir, cfg.EmailMaildropInterval)
+	}
+	if cfg.EmailSMTPAddr != "" {
+		ln, err := net.Listen("tcp", cfg.EmailSMTPAddr)
+		if err != nil {
+			stopWorkers()
+			return nil, fmt.Errorf("email intake listener: %w", err)
+		}
+		smtpServer := &smtpd.Server{
+			Hostname:  cfg.EmailHostname,
+			MaxSize:   cfg.EmailMaxSize,
+			Timeout:   5 * time.Minute,
+			Recipient: recipientFilter(cfg.EmailRecipients),
+			Handler:   emailSvc.HandleSMTP,
+		}
+		go func() {
+			if err := smtpServer.Serve(workerCtx, ln); err != nil {
+				log.Printf("email intake listener stopped: %v", err)
+			}
+		}()
+	}
+
 	return &Server{
-		Router: r,
-		DB:     db,
-		Config: cfg,
+		Router:      r,
+		DB:          db,
+		Config:      cfg,
+		stopWorkers: stopWorkers,
 	}, nil
 }
 
+// newIntakeGates builds the intake gates named in cfg.IntakeGates, in order.
+// "none" disables gating.
+func newIntakeGates(cfg *config.Config, reportRepo *repository.ReportRepository, nonces repository.NonceStore, reputationSvc *service.ReputationService, brigadeSvc *service.BrigadeService) ([]service.IntakeGate, error) {
+	var gates []service.IntakeGate
+	for _, name := range cfg.IntakeGates {
+		switch name {
+		case "none":
+		case "pow":
+			gates = append(gates, service.NewPowGate(cfg.IntakeSecret, cfg.PowDifficulty, cfg.PowTTL, nonces))
+		case "captcha":
+			var verifier captcha.Verifier
+			if cfg.CaptchaVerifyURL == "" && cfg.IsProd() {
+				return nil, fmt.Errorf("captcha intake gate requires CAPTCHA_VERIFY_URL in production")
+			}
+			if cfg.CaptchaVerifyURL != "" {
+				verifier = captcha.NewHTTPVerifier(cfg.CaptchaVerifyURL, cfg.CaptchaSecret)
+			} else {
+				verifier = captcha.NewStaticVerifier(cfg.CaptchaTestToken)
+			}
+			gates = append(gates, service.NewCaptchaGate(verifier))
+		case "device":
+			gates = append(gates, service.NewDeviceGate(reportRepo, cfg.DeviceLimit, cfg.DeviceWindow))
+		case "reputation":
+			gates = append(gates, service.NewReputationGate(reputationSvc))
+		case "brigade":
+			gates = append(gates, service.NewBrigadeGate(brigadeSvc, cfg.BrigadeTightenRisk))
+		default:
+			return nil, fmt.Errorf("unknown intake gate %q", name)
+		}
+	}
+	return gates, nil
+}
+
+// newMailSender sends through the configured SMTP relay, or only logs
+// outgoing mail when there is none
+func newMailSender(cfg *config.Config) mailer.Sender {
+	if cfg.SMTPRelayAddr == "" {
+		return mailer.NewLogSender()
+	}
+	return mailer.NewSMTPSender(cfg.SMTPRelayAddr, cfg.SMTPRelayUsername, cfg.SMTPRelayPassword)
+}
+
+// newChatPlatforms configures the chat platforms that have a webhook secret.
+// Platforms without an access token only log their replies.
+func newChatPlatforms(cfg *config.Config) (linePlatform, whatsappPlatform *service.ChatPlatform) {
+	if cfg.LINEChannelSecret != "" {
+		linePlatform = &service.ChatPlatform{
+			Secret:    cfg.LINEChannelSecret,
+			Messenger: service.NewLogMessenger(model.ChannelLine),
+		}
+		if cfg.LINEChannelAccessToken != "" {
+			linePlatform.Messenger = service.NewLINEMessenger(line.NewClient(cfg.LINEChannelAccessToken, cfg.LINEAPIBaseURL))
+		}
+	}
+	if cfg.WhatsAppAppSecret != "" {
+		whatsappPlatform = &service.ChatPlatform{
+			Secret:        cfg.WhatsAppAppSecret,
+			VerifyToken:   cfg.WhatsAppVerifyToken,
+			PhoneNumberID: cfg.WhatsAppPhoneNumberID,
+			Messenger:     service.NewLogMessenger(model.ChannelWhatsApp),
+		}
+		if cfg.WhatsAppAccessToken != "" && cfg.WhatsAppPhoneNumberID != "" {
+			whatsappPlatform.Messenger = service.NewWhatsAppMessenger(whatsapp.NewClient(cfg.WhatsAppAccessToken, cfg.WhatsAppPhoneNumberID, cfg.WhatsAppAPIBaseURL))
+		}
+	}
+	return linePlatform, whatsappPlatform
+}
+
+// recipientFilter accepts the listed addresses and, for "@domain" entries,
+// any address at the domain. An empty list accepts everything.
+func recipientFilter(recipients []string) func(string) bool {
+	if len(recipients) == 0 {
+		return nil
+	}
+	return func(addr string) bool {
+		addr = strings.ToLower(addr)
+		for _, r := range recipients {
+			r = strings.ToLower(r)
+			if addr == r || (strings.HasPrefix(r, "@") && strings.HasSuffix(addr, r)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // Run starts the HTTP server
 func (s *Server) Run() error {
 	return s.Router.Run(s.Config.HTTPAddr)
@@ -207,5 +738,19 @@ func (s *Server) Run() error {
 
 // Close cleans up server resources
 func (s *Server) Close() error {
+	s.stopWorkers()
 	return s.DB.Close()
 }
+
+// requireSecret returns a configured secret. When it is unset, dev mode
+// falls back to devDefault and any other mode refuses to start.
+func requireSecret(cfg *config.Config, name, value, devDefault string) (string, error) {
+	if value != "" {
+		return value, nil
+	}
+	if !cfg.IsDev() {
+		return "", fmt.Errorf("no %s configured", name)
+	}
+	log.Printf("%s is not set; using the development default", name)
+	return devDefault, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/service"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
 )
@@ -153,7 +154,7 @@ func ScopeMiddleware(requiredScope string) gin.HandlerFunc {
 			return
 		}
 
-		scopes := scopesRaw.([]string)
+		scopes, _ := scopesRaw.(model.StringArray)
 		for _, scope := range scopes {
 			if scope == requiredScope || scope == "admin" {
 				c.Next()
@@ -17,6 +17,18 @@ type RateLimiter struct {
 	redis    *redis.Client
 	requests int
 	window   time.Duration
+
+	// Scope, when set, keeps this limiter's counters apart from other
+	// limiters sharing Redis
+	Scope string
+
+	// Reference, when set, issues a reference for blocked requests that the
+	// client can quote in an appeal
+	Reference func() string
+
+	// Pass, when set, redeems the X-Appeal-Pass header of a request over the
+	// limit; a redeemed pass lets that request through
+	Pass func(ctx context.Context, pass string) bool
 }
 
 // NewRateLimiter creates a new rate limiter
@@ -39,6 +51,9 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 
 		// Use client IP as the key
 		key := fmt.Sprintf("ratelimit:%s", c.ClientIP())
+		if rl.Scope != "" {
+			key = fmt.Sprintf("ratelimit:%s:%s", rl.Scope, c.ClientIP())
+		}
 
 		ctx := context.Background()
 		
@@ -51,9 +66,14 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 		}
 
 		if count >= rl.requests {
+			if redeemPass(c, rl.Pass) {
+				c.Next()
+				return
+			}
 			c.JSON(http.StatusTooManyRequests, vo.ErrorVO{
 				Code:    "RATE_LIMIT_EXCEEDED",
 				Message: fmt.Sprintf("Rate limit exceeded. Try again in %v", rl.window),
+				Details: blockedDetails(rl.Reference),
 			})
 			c.Abort()
 			return
@@ -77,6 +97,12 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 type InMemoryRateLimiter struct {
 	counts   map[string]int
 	requests int
+
+	// Reference, when set, issues a reference for blocked requests
+	Reference func() string
+
+	// Pass, when set, redeems the X-Appeal-Pass header of blocked requests
+	Pass func(ctx context.Context, pass string) bool
 }
 
 // NewInMemoryRateLimiter creates a new in-memory rate limiter
@@ -94,9 +120,14 @@ func (rl *InMemoryRateLimiter) Middleware() gin.HandlerFunc {
 
 		count := rl.counts[key]
 		if count >= rl.requests {
+			if redeemPass(c, rl.Pass) {
+				c.Next()
+				return
+			}
 			c.JSON(http.StatusTooManyRequests, vo.ErrorVO{
 				Code:    "RATE_LIMIT_EXCEEDED",
 				Message: "Rate limit exceeded",
+				Details: blockedDetails(rl.Reference),
 			})
 			c.Abort()
 			return
@@ -110,3 +141,24 @@ func (rl *InMemoryRateLimiter) Middleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// blockedDetails returns the appeal reference for a blocked request, if any
+func blockedDetails(reference func() string) map[string]string {
+	if reference == nil {
+		return nil
+	}
+	if ref := reference(); ref != "" {
+		return map[string]string{"reference": ref}
+	}
+	return nil
+}
+
+// redeemPass reports whether the request carries an appeal pass that was
+// redeemed
+func redeemPass(c *gin.Context, pass func(ctx context.Context, pass string) bool) bool {
+	if pass == nil {
+		return false
+	}
+	token := c.GetHeader("X-Appeal-Pass")
+	return token != "" && pass(c.Request.Context(), token)
+}
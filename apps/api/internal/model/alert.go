@@ -14,12 +14,14 @@ import (
 type Alert struct {
 	ID            uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
 	ReportID      *uuid.UUID  `gorm:"type:uuid;index"`
+	IncidentID    *uuid.UUID  `gorm:"type:uuid;index"`
 	Status        string      `gorm:"size:50;not null;default:'draft'"`
 	Event         string      `gorm:"size:255;not null"`
 	Urgency       string      `gorm:"size:50;not null"`
 	Severity      string      `gorm:"size:50;not null"`
 	Certainty     string      `gorm:"size:50;not null"`
 	Area          string      `gorm:"size:500;not null"`
+	Location      Location    `gorm:"embedded"`
 	Instruction   string      `gorm:"type:text;not null"`
 	PublicMessage string      `gorm:"type:text"`
 	CAPXML        string      `gorm:"column:cap_xml;type:text"`
@@ -30,8 +32,9 @@ type Alert struct {
 	UpdatedAt     time.Time `gorm:"not null;default:now()"`
 
 	// Associations
-	Report   *Report `gorm:"foreignKey:ReportID"`
-	Approver *User   `gorm:"foreignKey:ApprovedBy"`
+	Report   *Report   `gorm:"foreignKey:ReportID"`
+	Incident *Incident `gorm:"foreignKey:IncidentID"`
+	Approver *User     `gorm:"foreignKey:ApprovedBy"`
 }
 
 func (Alert) TableName() string {
@@ -36,16 +36,17 @@ func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
 
 // API key scopes
 const (
-	ScopeRead       = "read"
-	ScopeWrite      = "write"
-	ScopeTriage     = "triage"
-	ScopeAlerts     = "alerts"
-	ScopeAdmin      = "admin"
+	ScopeRead   = "read"
+	ScopeWrite  = "write"
+	ScopeTriage = "triage"
+	ScopeAlerts = "alerts"
+	ScopeAdmin  = "admin"
+	ScopeIntel  = "intel" // STIX export for anti-fraud partners
 )
 
 // ValidScopes returns all valid API key scopes
 func ValidScopes() []string {
-	return []string{ScopeRead, ScopeWrite, ScopeTriage, ScopeAlerts, ScopeAdmin}
+	return []string{ScopeRead, ScopeWrite, ScopeTriage, ScopeAlerts, ScopeAdmin, ScopeIntel}
 }
 
 // HasScope checks if the API key has a specific scope
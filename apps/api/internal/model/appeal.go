@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Appeal is a reporter's request to review a spam decision on their report or
+// a block on their submission
+type Appeal struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Kind           string     `gorm:"size:20;not null"`
+	ReportID       *uuid.UUID `gorm:"type:uuid;index"`
+	BlockedRef     *string    `gorm:"size:64;uniqueIndex"` // reference handed out when intake was blocked
+	BlockedAt      *time.Time // when the block happened, from the reference
+	Message        string     `gorm:"type:text;not null"`
+	Status         string     `gorm:"size:20;not null;default:'pending';index"`
+	DecisionReason string     `gorm:"type:text"`
+	DecidedBy      *uuid.UUID `gorm:"type:uuid"`
+	DecidedAt      *time.Time
+	CreatedAt      time.Time `gorm:"not null;default:now()"`
+
+	// Associations
+	Report  *Report `gorm:"foreignKey:ReportID"`
+	Decider *User   `gorm:"foreignKey:DecidedBy"`
+}
+
+func (Appeal) TableName() string {
+	return "appeals"
+}
+
+func (a *Appeal) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	if a.Status == "" {
+		a.Status = AppealStatusPending
+	}
+	return nil
+}
+
+// Appeal kinds
+const (
+	AppealKindReport  = "report"  // a report marked as spam
+	AppealKindBlocked = "blocked" // a submission turned away by intake limits
+)
+
+// Appeal statuses
+const (
+	AppealStatusPending    = "pending"
+	AppealStatusUpheld     = "upheld"     // the original decision stands
+	AppealStatusOverturned = "overturned" // the original decision was wrong
+)
@@ -47,6 +47,28 @@ const (
 	ActionWithdraw = "withdraw"
 	ActionLogin    = "login"
 	ActionLogout   = "logout"
+	ActionLink     = "link"
+	ActionMerge    = "merge"
+	ActionSplit    = "split"
+	ActionDismiss  = "dismiss"
+	ActionReview   = "review"
+	ActionSpam     = "spam"
+	ActionClose    = "close"
+	ActionReopen   = "reopen"
+	ActionClaim    = "claim"
+	ActionRelease  = "release"
+	ActionAssign   = "assign"
+	ActionAdmit    = "admit"
+	ActionDetect   = "detect"
+	ActionResolve  = "resolve"
+	ActionDownload = "download"
+	ActionImport   = "import"
+	ActionPurge    = "purge"
+	ActionVerify   = "verify"
+	ActionExtend   = "extend"
+	ActionReject   = "reject"
+	ActionErase    = "erase"
+	ActionActivate = "activate"
 )
 
 // Audit object types
@@ -57,6 +79,18 @@ const (
 	ObjectTypeTraining  = "training_event"
 	ObjectTypeUser      = "user"
 	ObjectTypeAPIKey    = "api_key"
+	ObjectTypeIncident  = "incident"
+	ObjectTypeSLAPolicy = "sla_policy"
+	ObjectTypeBrigade   = "brigade_cluster"
+	ObjectTypeAppeal    = "appeal"
+	ObjectTypeIndicator = "indicator"
+	ObjectTypeClaim     = "claim"
+	ObjectTypeEvidence  = "evidence"
+	ObjectTypeImport    = "import_batch"
+	ObjectTypeRetention = "retention_run"
+	ObjectTypeSubject   = "subject_request"
+	ObjectTypeRubric    = "triage_rubric"
+	ObjectTypeConsensus = "consensus_round"
 )
 
 // ValidAuditActions returns all valid audit actions
@@ -64,6 +98,12 @@ func ValidAuditActions() []string {
 	return []string{
 		ActionCreate, ActionUpdate, ActionDelete, ActionTriage,
 		ActionApprove, ActionPublish, ActionWithdraw, ActionLogin, ActionLogout,
+		ActionLink, ActionMerge, ActionSplit, ActionDismiss,
+		ActionReview, ActionSpam, ActionClose, ActionReopen,
+		ActionClaim, ActionRelease, ActionAssign, ActionAdmit,
+		ActionDetect, ActionResolve, ActionDownload, ActionImport,
+		ActionPurge, ActionVerify, ActionExtend, ActionReject, ActionErase,
+		ActionActivate,
 	}
 }
 
@@ -71,6 +111,9 @@ func ValidAuditActions() []string {
 func ValidObjectTypes() []string {
 	return []string{
 		ObjectTypeReport, ObjectTypeTriage, ObjectTypeAlert,
-		ObjectTypeTraining, ObjectTypeUser, ObjectTypeAPIKey,
+		ObjectTypeTraining, ObjectTypeUser, ObjectTypeAPIKey, ObjectTypeIncident,
+		ObjectTypeSLAPolicy, ObjectTypeBrigade, ObjectTypeAppeal, ObjectTypeIndicator,
+		ObjectTypeClaim, ObjectTypeEvidence, ObjectTypeImport, ObjectTypeRetention,
+		ObjectTypeSubject, ObjectTypeRubric, ObjectTypeConsensus,
 	}
 }
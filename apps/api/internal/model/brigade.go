@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BrigadeCluster is a burst of correlated reports that target the same area,
+// category or description, suspected of being coordinated
+type BrigadeCluster struct {
+	ID             uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	TargetType     string      `gorm:"size:20;not null;index:idx_brigade_target"`
+	TargetKey      string      `gorm:"size:100;not null;index:idx_brigade_target"`
+	AreaKey        string      `gorm:"size:100;index"` // area whose intake is tightened
+	Category       string      `gorm:"size:50"`
+	Signals        StringArray `gorm:"type:jsonb;default:'[]'"`
+	ReportCount    int         `gorm:"not null;default:0"`
+	SourceCount    int         `gorm:"not null;default:0"` // distinct devices
+	Status         string      `gorm:"size:20;not null;default:'open'"`
+	TightenedUntil *time.Time
+	DetectedAt     time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt      time.Time  `gorm:"not null;default:now()"`
+	ResolvedBy     *uuid.UUID `gorm:"type:uuid"`
+	ResolvedAt     *time.Time
+
+	// Associations
+	Reports  []Report `gorm:"foreignKey:BrigadeClusterID"`
+	Resolver *User    `gorm:"foreignKey:ResolvedBy"`
+}
+
+func (BrigadeCluster) TableName() string {
+	return "brigade_clusters"
+}
+
+func (b *BrigadeCluster) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	if b.Status == "" {
+		b.Status = BrigadeStatusOpen
+	}
+	return nil
+}
+
+// Brigade cluster statuses
+const (
+	BrigadeStatusOpen      = "open"
+	BrigadeStatusConfirmed = "confirmed"
+	BrigadeStatusDismissed = "dismissed"
+)
+
+// Brigade cluster target types
+const (
+	BrigadeTargetArea     = "area"
+	BrigadeTargetCategory = "category"
+	BrigadeTargetPhrasing = "phrasing"
+)
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ChatSession is the state of a guided reporting conversation with a chat
+// bot user. It lives in the conversation store and expires when the user
+// stops answering; it is never written to the database.
+type ChatSession struct {
+	Step        string    `json:"step"`
+	Lang        string    `json:"lang"`
+	Category    string    `json:"category,omitempty"`
+	AreaHint    string    `json:"areaHint,omitempty"`
+	TimeWindow  string    `json:"timeWindow,omitempty"`
+	Description string    `json:"description,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Chat conversation steps, in order
+const (
+	ChatStepCategory    = "category"
+	ChatStepArea        = "area"
+	ChatStepTimeWindow  = "time_window"
+	ChatStepDescription = "description"
+	ChatStepConfirm     = "confirm"
+)
+
+// ReportSubscriber is a chat user waiting for status updates on a report
+// they filed. Recipient is the platform's user ID, kept encrypted and only so
+// updates can be pushed; the row is deleted when the report is closed or the
+// subscription expires.
+type ReportSubscriber struct {
+	ID         uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ReportID   uuid.UUID       `gorm:"type:uuid;not null;index"`
+	Channel    string          `gorm:"size:20;not null"`
+	Recipient  EncryptedString `gorm:"type:text;not null"`
+	Lang       string          `gorm:"size:10;not null"`
+	LastStatus string          `gorm:"size:50;not null"` // last status sent, as the reporter sees it
+	ExpiresAt  time.Time       `gorm:"not null;index"`
+	CreatedAt  time.Time       `gorm:"not null;default:now()"`
+	UpdatedAt  time.Time       `gorm:"not null;default:now()"`
+}
+
+func (ReportSubscriber) TableName() string {
+	return "report_subscribers"
+}
+
+func (s *ReportSubscriber) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
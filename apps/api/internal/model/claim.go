@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Claim is a rumour or piece of misinformation circulating in a pilot zone,
+// usually surfaced by misinformation_panic reports, with our verdict on it.
+// Rated claims are published as schema.org ClaimReview.
+type Claim struct {
+	ID          uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Text        string      `gorm:"type:text;not null"`               // the claim as it circulates
+	Language    string      `gorm:"size:20"`                          // BCP 47 tag, e.g. zh-TW
+	Appearances StringArray `gorm:"type:jsonb;not null;default:'[]'"` // URLs where the claim was seen
+	Verdict     string      `gorm:"size:20;not null;default:'unverified';index"`
+	Explanation string      `gorm:"type:text"`                        // public explanation of the verdict
+	Evidence    StringArray `gorm:"type:jsonb;not null;default:'[]'"` // source URLs backing the verdict
+	ReviewedBy  *uuid.UUID  `gorm:"type:uuid"`
+	ReviewedAt  *time.Time
+	PublishedAt *time.Time `gorm:"index"` // first rating; cleared when set back to unverified
+	CreatedBy   *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt   time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt   time.Time  `gorm:"not null;default:now()"`
+
+	// Associations
+	Reviewer *User `gorm:"foreignKey:ReviewedBy"`
+	Creator  *User `gorm:"foreignKey:CreatedBy"`
+}
+
+func (Claim) TableName() string {
+	return "claims"
+}
+
+func (c *Claim) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	if c.Verdict == "" {
+		c.Verdict = ClaimVerdictUnverified
+	}
+	return nil
+}
+
+// ClaimReport links a claim to a report that repeats it
+type ClaimReport struct {
+	ClaimID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ReportID  uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (ClaimReport) TableName() string {
+	return "claim_reports"
+}
+
+// ClaimIncident links a claim to an incident it is part of
+type ClaimIncident struct {
+	ClaimID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	IncidentID uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt  time.Time `gorm:"not null;default:now()"`
+}
+
+func (ClaimIncident) TableName() string {
+	return "claim_incidents"
+}
+
+// Claim verdicts
+const (
+	ClaimVerdictUnverified = "unverified"
+	ClaimVerdictFalse      = "false"
+	ClaimVerdictMisleading = "misleading"
+	ClaimVerdictTrue       = "true"
+)
+
+// ValidClaimVerdicts returns all valid claim verdicts
+func ValidClaimVerdicts() []string {
+	return []string{
+		ClaimVerdictUnverified,
+		ClaimVerdictFalse,
+		ClaimVerdictMisleading,
+		ClaimVerdictTrue,
+	}
+}
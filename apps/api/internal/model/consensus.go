@@ -0,0 +1,106 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ConsensusRound collects independent reviews of a report whose triage
+// decision is high severity. The decision becomes final once a second
+// reviewer agrees with the first, or a lead breaks the tie when they do not.
+type ConsensusRound struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ReportID   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Status     string     `gorm:"size:20;not null;default:'open';index"`
+	DecisionID *uuid.UUID `gorm:"type:uuid"` // final triage decision
+	ResolvedBy *uuid.UUID `gorm:"type:uuid"` // lead who broke the tie
+	CreatedAt  time.Time  `gorm:"not null;default:now()"`
+	ClosedAt   *time.Time
+
+	// Associations
+	Reviews  []TriageReview `gorm:"foreignKey:RoundID"`
+	Resolver *User          `gorm:"foreignKey:ResolvedBy"`
+}
+
+func (ConsensusRound) TableName() string {
+	return "consensus_rounds"
+}
+
+func (r *ConsensusRound) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	if r.Status == "" {
+		r.Status = ConsensusStatusOpen
+	}
+	return nil
+}
+
+// Pending reports whether the round still holds up the report's decision
+func (r *ConsensusRound) Pending() bool {
+	return r.Status == ConsensusStatusOpen || r.Status == ConsensusStatusDisputed
+}
+
+// TriageReview is one reviewer's decision in a consensus round. Reviews are
+// blind: they are not shown to anyone while the round is open.
+type TriageReview struct {
+	ID              uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	RoundID         uuid.UUID   `gorm:"type:uuid;not null;uniqueIndex:idx_triage_review_reviewer"`
+	ReviewerID      uuid.UUID   `gorm:"type:uuid;not null;uniqueIndex:idx_triage_review_reviewer"`
+	Decision        string      `gorm:"size:50;not null"`
+	SeverityFinal   string      `gorm:"size:10;not null"`
+	EvidenceLevel   string      `gorm:"size:10"`
+	Rationale       string      `gorm:"type:text"`
+	RationaleFields JSONMap     `gorm:"type:jsonb"`
+	Confirmations   StringArray `gorm:"type:jsonb;default:'[]'"`
+	RubricVersion   *int
+	CreatedAt       time.Time `gorm:"not null;default:now()"`
+
+	// Associations
+	Reviewer *User `gorm:"foreignKey:ReviewerID"`
+}
+
+func (TriageReview) TableName() string {
+	return "triage_reviews"
+}
+
+func (r *TriageReview) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// Agrees reports whether two reviews reach the same decision and severity
+func (r *TriageReview) Agrees(other *TriageReview) bool {
+	return r.Decision == other.Decision && r.SeverityFinal == other.SeverityFinal
+}
+
+// Consensus round statuses
+const (
+	ConsensusStatusOpen     = "open"     // waiting for the second review
+	ConsensusStatusAgreed   = "agreed"   // the reviewers agreed; the decision is final
+	ConsensusStatusDisputed = "disputed" // the reviewers disagreed; waiting for a lead
+	ConsensusStatusResolved = "resolved" // a lead broke the tie
+)
+
+// ValidConsensusStatuses returns all valid consensus round statuses
+func ValidConsensusStatuses() []string {
+	return []string{ConsensusStatusOpen, ConsensusStatusAgreed, ConsensusStatusDisputed, ConsensusStatusResolved}
+}
+
+// ConsensusReviews is the number of independent reviews a round needs
+const ConsensusReviews = 2
+
+// SeverityRank orders severities from S0 (informational) to S4 (critical).
+// An unknown severity ranks -1.
+func SeverityRank(severity string) int {
+	for i, s := range ValidSeverities() {
+		if s == severity {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DuplicateCandidate records a suspected duplicate found at intake
+type DuplicateCandidate struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ReportID    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_duplicate_pair"`
+	CandidateID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_duplicate_pair;index"`
+	Score       float64    `gorm:"type:numeric(5,4);not null"`
+	TextScore   float64    `gorm:"type:numeric(5,4);not null"`
+	AreaScore   float64    `gorm:"type:numeric(5,4);not null"`
+	TimeScore   float64    `gorm:"type:numeric(5,4);not null"`
+	Status      string     `gorm:"size:50;not null;default:'suggested'"`
+	ReviewedBy  *uuid.UUID `gorm:"type:uuid"`
+	ReviewedAt  *time.Time
+	CreatedAt   time.Time `gorm:"not null;default:now()"`
+
+	// Associations
+	Report    Report `gorm:"foreignKey:ReportID"`
+	Candidate Report `gorm:"foreignKey:CandidateID"`
+}
+
+func (DuplicateCandidate) TableName() string {
+	return "duplicate_candidates"
+}
+
+func (d *DuplicateCandidate) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	if d.Status == "" {
+		d.Status = DuplicateStatusSuggested
+	}
+	return nil
+}
+
+// Duplicate candidate statuses
+const (
+	DuplicateStatusSuggested = "suggested"
+	DuplicateStatusLinked    = "linked"
+	DuplicateStatusDismissed = "dismissed"
+)
+
+// ValidDuplicateStatuses returns all valid duplicate candidate statuses
+func ValidDuplicateStatuses() []string {
+	return []string{DuplicateStatusSuggested, DuplicateStatusLinked, DuplicateStatusDismissed}
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/envelope"
+)
+
+// keyring seals and opens encrypted fields; set once at startup by SetKeyring
+var keyring atomic.Pointer[envelope.Keyring]
+
+// SetKeyring sets the keyring encrypted fields are sealed with. Without one
+// they are written in plaintext.
+func SetKeyring(k *envelope.Keyring) {
+	keyring.Store(k)
+}
+
+// errNoKeyring is returned when an encrypted value is read without a keyring
+var errNoKeyring = errors.New("encrypted value read but no encryption key is configured")
+
+// EncryptedString is a string column stored with envelope encryption. The
+// empty string is stored as is, so emptiness can still be queried; values
+// written before encryption was enabled are read back as plaintext.
+type EncryptedString string
+
+func (s EncryptedString) Value() (driver.Value, error) {
+	k := keyring.Load()
+	if s == "" || k == nil {
+		return string(s), nil
+	}
+	return k.SealString(string(s))
+}
+
+func (s *EncryptedString) Scan(value interface{}) error {
+	var raw string
+	switch v := value.(type) {
+	case nil:
+		*s = ""
+		return nil
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return fmt.Errorf("failed to scan EncryptedString from %T", value)
+	}
+
+	if !envelope.IsSealedString(raw) {
+		*s = EncryptedString(raw)
+		return nil
+	}
+	k := keyring.Load()
+	if k == nil {
+		return errNoKeyring
+	}
+	plaintext, err := k.OpenString(raw)
+	if err != nil {
+		return err
+	}
+	*s = EncryptedString(plaintext)
+	return nil
+}
+
+// EncryptedBytes is a binary column stored with envelope encryption, read
+// back as plaintext like EncryptedString
+type EncryptedBytes []byte
+
+func (b EncryptedBytes) Value() (driver.Value, error) {
+	k := keyring.Load()
+	if len(b) == 0 || k == nil {
+		return []byte(b), nil
+	}
+	return k.Seal(b)
+}
+
+func (b *EncryptedBytes) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*b = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("failed to scan EncryptedBytes from %T", value)
+	}
+
+	if !envelope.IsSealed(raw) {
+		*b = append(EncryptedBytes(nil), raw...)
+		return nil
+	}
+	k := keyring.Load()
+	if k == nil {
+		return errNoKeyring
+	}
+	plaintext, err := k.Open(raw)
+	if err != nil {
+		return err
+	}
+	*b = plaintext
+	return nil
+}
+
+// EncryptedColumn names a column stored with envelope encryption
+type EncryptedColumn struct {
+	Table  string
+	Column string
+	Binary bool // bytea holding the sealed value; otherwise text holding its text form
+}
+
+// EncryptedColumns lists every encrypted column, for re-wrapping after a
+// master key rotation
+var EncryptedColumns = []EncryptedColumn{
+	{Table: "reports", Column: "reporter_contact_ref"},
+	{Table: "report_subscribers", Column: "recipient"},
+	{Table: "subject_requests", Column: "contact_ref"},
+	{Table: "evidence_files", Column: "data", Binary: true},
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Evidence is a file kept with a report, such as the original of a forwarded
+// scam email. The report refers to it as "evidence:<id>" in EvidenceRefs.
+type Evidence struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ReportID    uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Kind        string         `gorm:"size:20;not null"`
+	ContentType string         `gorm:"size:100;not null"`
+	Filename    string         `gorm:"size:255"`
+	Size        int64          `gorm:"not null"`
+	SHA256      string         `gorm:"column:sha256;size:64;not null"`
+	Data        EncryptedBytes `gorm:"type:bytea;not null"`
+	CreatedAt   time.Time      `gorm:"not null;default:now()"`
+}
+
+func (Evidence) TableName() string {
+	return "evidence_files"
+}
+
+func (e *Evidence) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// Ref is how the report's EvidenceRefs point at the file
+func (e *Evidence) Ref() string {
+	return EvidenceRefPrefix + e.ID.String()
+}
+
+// EvidenceRefPrefix marks evidence refs that point at stored files
+const EvidenceRefPrefix = "evidence:"
+
+// Evidence kinds
+const (
+	EvidenceKindEmail = "email" // RFC 5322 message, forwarder headers removed
+)
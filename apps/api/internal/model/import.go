@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ImportBatch records one bulk import of reports, such as a tabletop drill
+// scenario or a backfill of paper forms. Imported reports point back at it.
+type ImportBatch struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Name      string     `gorm:"size:200;not null"`
+	Format    string     `gorm:"size:10;not null"`
+	Atomic    bool       `gorm:"not null;default:false"` // all rows or none
+	Status    string     `gorm:"size:20;not null;index"`
+	Total     int        `gorm:"not null;default:0"`
+	Imported  int        `gorm:"not null;default:0"`
+	Failed    int        `gorm:"not null;default:0"`
+	CreatedBy *uuid.UUID `gorm:"type:uuid"` // nil when run from the command line
+	CreatedAt time.Time  `gorm:"not null;default:now()"`
+
+	// Associations
+	Creator *User `gorm:"foreignKey:CreatedBy"`
+}
+
+func (ImportBatch) TableName() string {
+	return "import_batches"
+}
+
+func (b *ImportBatch) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// Import formats
+const (
+	ImportFormatCSV   = "csv"
+	ImportFormatJSONL = "jsonl"
+)
+
+// Import batch statuses
+const (
+	ImportStatusCompleted  = "completed"   // every row imported
+	ImportStatusPartial    = "partial"     // some rows failed and were skipped
+	ImportStatusRolledBack = "rolled_back" // an atomic import failed; nothing was kept
+)
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Incident groups related reports that describe the same real-world event
+type Incident struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Title      string     `gorm:"size:255;not null"`
+	Category   string     `gorm:"size:50;not null"`
+	Severity   string     `gorm:"size:10"`
+	Status     string     `gorm:"size:50;not null;default:'open'"`
+	Summary    string     `gorm:"type:text"`
+	AreaHint   string     `gorm:"size:500"`
+	MergedInto *uuid.UUID `gorm:"type:uuid;index"`
+	CreatedBy  *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt  time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt  time.Time  `gorm:"not null;default:now()"`
+	ResolvedAt *time.Time
+
+	// Associations
+	Reports []Report `gorm:"foreignKey:IncidentID"`
+	Alerts  []Alert  `gorm:"foreignKey:IncidentID"`
+	Creator *User    `gorm:"foreignKey:CreatedBy"`
+}
+
+func (Incident) TableName() string {
+	return "incidents"
+}
+
+func (i *Incident) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	if i.Status == "" {
+		i.Status = IncidentStatusOpen
+	}
+	return nil
+}
+
+// Incident statuses
+const (
+	IncidentStatusOpen       = "open"
+	IncidentStatusMonitoring = "monitoring"
+	IncidentStatusResolved   = "resolved"
+	IncidentStatusClosed     = "closed"
+	IncidentStatusMerged     = "merged"
+)
+
+// ValidIncidentStatuses returns all valid incident statuses
+func ValidIncidentStatuses() []string {
+	return []string{
+		IncidentStatusOpen,
+		IncidentStatusMonitoring,
+		IncidentStatusResolved,
+		IncidentStatusClosed,
+		IncidentStatusMerged,
+	}
+}
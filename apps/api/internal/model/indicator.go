@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Indicator is a normalised scam indicator (URL, domain, phone number, bank
+// account, LINE ID or crypto wallet) extracted from scam_phishing reports.
+// Each type and value is stored once.
+type Indicator struct {
+	ID               uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Type             string     `gorm:"size:20;not null;uniqueIndex:idx_indicator_value"`
+	Value            string     `gorm:"size:500;not null;uniqueIndex:idx_indicator_value"`
+	Hash             string     `gorm:"size:64;not null;index"` // SHA-256 of type:value, for hash-prefix lookups
+	Status           string     `gorm:"size:20;not null;default:'unverified';index"`
+	ReportCount      int        `gorm:"not null;default:0"`
+	FirstSeenAt      time.Time  `gorm:"not null"`
+	LastSeenAt       time.Time  `gorm:"not null;index"`
+	VerifiedBy       *uuid.UUID `gorm:"type:uuid"`
+	VerifiedAt       *time.Time
+	VerificationNote string    `gorm:"type:text"`
+	CreatedAt        time.Time `gorm:"not null;default:now()"`
+	UpdatedAt        time.Time `gorm:"not null;default:now()"`
+
+	// Associations
+	Verifier *User `gorm:"foreignKey:VerifiedBy"`
+}
+
+func (Indicator) TableName() string {
+	return "indicators"
+}
+
+func (i *Indicator) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	if i.Status == "" {
+		i.Status = IndicatorStatusUnverified
+	}
+	return nil
+}
+
+// IndicatorReport links an indicator to a report it was found in
+type IndicatorReport struct {
+	IndicatorID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ReportID    uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	SeenAt      time.Time `gorm:"not null"` // report creation time
+}
+
+func (IndicatorReport) TableName() string {
+	return "indicator_reports"
+}
+
+// Indicator verification statuses
+const (
+	IndicatorStatusUnverified    = "unverified"
+	IndicatorStatusConfirmed     = "confirmed"
+	IndicatorStatusFalsePositive = "false_positive"
+)
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// IntakeNonce records a spent proof-of-work challenge so it cannot be replayed.
+// Only used when Redis is unavailable.
+type IntakeNonce struct {
+	Nonce     string    `gorm:"size:32;primaryKey"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+}
+
+func (IntakeNonce) TableName() string {
+	return "intake_nonces"
+}
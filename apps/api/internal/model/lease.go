@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportLease is a time-limited claim on a report by a triager.
+// Leases live in Redis when available and in this table otherwise.
+type ReportLease struct {
+	ReportID   uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	HolderID   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	AssignedBy *uuid.UUID `gorm:"type:uuid"`
+	AcquiredAt time.Time  `gorm:"not null"`
+	ExpiresAt  time.Time  `gorm:"not null;index"`
+
+	// Associations
+	Holder *User `gorm:"foreignKey:HolderID"`
+}
+
+func (ReportLease) TableName() string {
+	return "report_leases"
+}
+
+// Active reports whether the lease has not yet expired
+func (l *ReportLease) Active(now time.Time) bool {
+	return now.Before(l.ExpiresAt)
+}
@@ -0,0 +1,9 @@
+package model
+
+// Location is the coarsened position of a report or alert. Raw coordinates
+// are never stored: a point is reduced to a geohash cell at the configured
+// precision and, where possible, to a pilot zone from the gazetteer.
+type Location struct {
+	Geohash string `gorm:"size:12;index"`
+	ZoneID  string `gorm:"size:64;index"`
+}
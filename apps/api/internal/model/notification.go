@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Notification is an in-app message for a user
+type Notification struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Kind       string     `gorm:"size:50;not null"`
+	Title      string     `gorm:"size:255;not null"`
+	Body       string     `gorm:"type:text"`
+	ObjectType string     `gorm:"size:50"`
+	ObjectID   *uuid.UUID `gorm:"type:uuid"`
+	ReadAt     *time.Time
+	CreatedAt  time.Time `gorm:"not null;default:now()"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+func (n *Notification) BeforeCreate(tx *gorm.DB) error {
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+	return nil
+}
+
+// Notification kinds
+const (
+	NotificationSLAWarning     = "sla_warning"
+	NotificationSLABreach      = "sla_breach"
+	NotificationBrigade        = "brigade_detected"
+	NotificationSubjectDue     = "subject_request_due"
+	NotificationSubjectOverdue = "subject_request_overdue"
+	NotificationDisputed       = "consensus_disputed"
+)
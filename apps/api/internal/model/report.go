@@ -12,21 +12,35 @@ import (
 
 // Report represents a community incident report
 type Report struct {
-	ID                 uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	Category           string      `gorm:"size:50;not null"`
-	SeveritySuggested  string      `gorm:"size:10"`
-	AreaHint           string      `gorm:"size:500;not null"`
-	TimeWindow         string      `gorm:"size:100"`
-	Description        string      `gorm:"type:text;not null"`
-	EvidenceRefs       StringArray `gorm:"type:jsonb;default:'[]'"`
-	ReporterContactRef string      `gorm:"size:255"`
-	Status             string      `gorm:"size:50;not null;default:'submitted'"`
-	CreatedAt          time.Time   `gorm:"not null;default:now()"`
-	UpdatedAt          time.Time   `gorm:"not null;default:now()"`
+	ID                 uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Category           string          `gorm:"size:50;not null"`
+	SeveritySuggested  string          `gorm:"size:10"`
+	AreaHint           string          `gorm:"size:500;not null"`
+	TimeWindow         string          `gorm:"size:100"`
+	Description        string          `gorm:"type:text;not null"`
+	EvidenceRefs       StringArray     `gorm:"type:jsonb;default:'[]'"`
+	ReporterContactRef EncryptedString `gorm:"type:text"`
+	Status             string          `gorm:"size:50;not null;default:'submitted'"`
+	IncidentID         *uuid.UUID      `gorm:"type:uuid;index"`
+	SimHash            int64           `gorm:"column:simhash"`
+	Location           Location        `gorm:"embedded"`
+	RiskScore          float64         `gorm:"not null;default:0"`             // intake gate risk, 0-1
+	RiskSignals        StringArray     `gorm:"type:jsonb;default:'[]'"`        // gate findings behind the score
+	DeviceHash         string          `gorm:"size:64;index"`                  // pseudonymous device fingerprint
+	DeviceFallback     bool            `gorm:"not null;default:false"`         // device hash is from user agent and network, not a device ID
+	NetworkHash        string          `gorm:"size:64"`                        // pseudonymous /24 or /48 network key
+	BrigadeClusterID   *uuid.UUID      `gorm:"type:uuid;index"`                // suspected coordinated burst
+	FollowUpHash       string          `gorm:"size:64;index"`                  // SHA-256 of the reporter's follow-up token
+	Channel            string          `gorm:"size:20;not null;default:'web'"` // intake channel the report arrived through
+	ImportBatchID      *uuid.UUID      `gorm:"type:uuid;index"`                // batch a bulk-imported report came in
+	PurgedAt           *time.Time      // when retention cleared the report's content
+	CreatedAt          time.Time       `gorm:"not null;default:now()"`
+	UpdatedAt          time.Time       `gorm:"not null;default:now()"`
 
 	// Associations
 	TriageDecisions []TriageDecision `gorm:"foreignKey:ReportID"`
 	Alerts          []Alert          `gorm:"foreignKey:ReportID"`
+	Incident        *Incident        `gorm:"foreignKey:IncidentID"`
 }
 
 func (Report) TableName() string {
@@ -45,14 +59,14 @@ func (r *Report) BeforeCreate(tx *gorm.DB) error {
 
 // Report categories
 const (
-	CategorySuspiciousItem     = "suspicious_item"
-	CategorySuspiciousPerson   = "suspicious_person"
-	CategoryHarassmentStalking = "harassment_stalking"
-	CategoryScamPhishing       = "scam_phishing"
-	CategoryMisinformationPanic = "misinformation_panic"
-	CategoryCrowdDisorder      = "crowd_disorder"
+	CategorySuspiciousItem       = "suspicious_item"
+	CategorySuspiciousPerson     = "suspicious_person"
+	CategoryHarassmentStalking   = "harassment_stalking"
+	CategoryScamPhishing         = "scam_phishing"
+	CategoryMisinformationPanic  = "misinformation_panic"
+	CategoryCrowdDisorder        = "crowd_disorder"
 	CategoryInfrastructureHazard = "infrastructure_hazard"
-	CategoryOther              = "other"
+	CategoryOther                = "other"
 )
 
 // Report statuses
@@ -63,6 +77,16 @@ const (
 	StatusEscalated   = "escalated"
 	StatusClosed      = "closed"
 	StatusSpam        = "spam"
+	StatusQuarantined = "quarantined" // held back from triage by the intake gate
+)
+
+// Intake channels
+const (
+	ChannelWeb      = "web"      // public form or API
+	ChannelEmail    = "email"    // forwarded to the email intake gateway
+	ChannelLine     = "line"     // LINE bot conversation
+	ChannelWhatsApp = "whatsapp" // WhatsApp bot conversation
+	ChannelImport   = "import"   // bulk import of drill or paper-form reports
 )
 
 // Severity levels
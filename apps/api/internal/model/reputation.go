@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReporterReputation tracks triage outcomes for a pseudonymous reporter key.
+// The key is a salted hash that rotates, so it cannot be tied to a person or
+// followed across rotation periods from the outside.
+type ReporterReputation struct {
+	Key         string    `gorm:"size:64;primaryKey"`
+	Accepted    int       `gorm:"not null;default:0"`
+	Rejected    int       `gorm:"not null;default:0"`
+	Spam        int       `gorm:"not null;default:0"`
+	FirstSeenAt time.Time `gorm:"not null;default:now()"`
+	LastSeenAt  time.Time `gorm:"not null;default:now()"`
+	ExpiresAt   time.Time `gorm:"not null;index"`
+}
+
+func (ReporterReputation) TableName() string {
+	return "reporter_reputations"
+}
+
+// Observations returns how many outcomes have been recorded
+func (r *ReporterReputation) Observations() int {
+	return r.Accepted + r.Rejected + r.Spam
+}
+
+// Score returns a smoothed trust score between 0 and 1, starting at 0.5.
+// Spam counts twice as heavily as a plain rejection.
+func (r *ReporterReputation) Score() float64 {
+	good := float64(r.Accepted)
+	bad := float64(r.Rejected + 2*r.Spam)
+	return (good + 1) / (good + bad + 2)
+}
+
+// ReputationOutcome records which outcome a report contributed, so a later
+// decision on the same report replaces it instead of counting twice
+type ReputationOutcome struct {
+	ReportID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ReporterKey string    `gorm:"size:64;not null;index"`
+	Outcome     string    `gorm:"size:20;not null"`
+	RecordedAt  time.Time `gorm:"not null;default:now()"`
+	ExpiresAt   time.Time `gorm:"not null;index"`
+}
+
+func (ReputationOutcome) TableName() string {
+	return "reputation_outcomes"
+}
+
+// Reputation outcomes
+const (
+	OutcomeAccepted = "accepted"
+	OutcomeRejected = "rejected"
+	OutcomeSpam     = "spam"
+)
+
+// Reporter trust levels
+const (
+	TrustNew     = "new"
+	TrustTrusted = "trusted"
+	TrustNeutral = "neutral"
+	TrustLow     = "low"
+)
@@ -0,0 +1,11 @@
+package model
+
+// Retention classes: the kinds of stored data that each have their own
+// retention window
+const (
+	RetentionReports  = "reports"    // content of closed and spam reports; anonymized
+	RetentionContacts = "contacts"   // reporter contact refs and device, network and follow-up hashes; cleared
+	RetentionEvidence = "evidence"   // stored evidence files; deleted
+	RetentionAuditIPs = "audit_ips"  // actor IPs in the audit log; cleared
+	RetentionAuditLog = "audit_logs" // audit log entries other than purge summaries; deleted
+)
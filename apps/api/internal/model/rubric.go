@@ -0,0 +1,96 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TriageRubric is one version of the rules triage decisions are checked
+// against. Versions are never edited: a change is a new version, and exactly
+// one version is active at a time. Each decision records the version it was
+// made under.
+type TriageRubric struct {
+	Version   int         `gorm:"primaryKey;autoIncrement:false"`
+	Note      string      `gorm:"type:text"` // what changed and why
+	Rules     RubricRules `gorm:"type:jsonb;not null"`
+	Active    bool        `gorm:"not null;default:false"`
+	CreatedBy *uuid.UUID  `gorm:"type:uuid"`
+	CreatedAt time.Time   `gorm:"not null;default:now()"`
+
+	// Associations
+	Creator *User `gorm:"foreignKey:CreatedBy"`
+}
+
+func (TriageRubric) TableName() string {
+	return "triage_rubrics"
+}
+
+// RubricRules holds a rule per report category. Categories without a rule
+// of their own use the default rule.
+type RubricRules struct {
+	Default    RubricRule            `json:"default"`
+	Categories map[string]RubricRule `json:"categories,omitempty"`
+}
+
+// RubricRule is what a decision on a report of one category must include
+type RubricRule struct {
+	Decisions     []string          `json:"decisions,omitempty"`     // decisions the rule applies to; empty means all
+	MinEvidence   map[string]string `json:"minEvidence,omitempty"`   // severity -> lowest evidence level allowed
+	Fields        []string          `json:"fields,omitempty"`        // rationale fields that must be filled in
+	Confirmations []string          `json:"confirmations,omitempty"` // checks the triager must confirm
+}
+
+// RuleFor returns the rule for a report category
+func (r RubricRules) RuleFor(category string) RubricRule {
+	if rule, ok := r.Categories[category]; ok {
+		return rule
+	}
+	return r.Default
+}
+
+// AppliesTo reports whether the rule applies to a decision
+func (r RubricRule) AppliesTo(decision string) bool {
+	if len(r.Decisions) == 0 {
+		return true
+	}
+	for _, d := range r.Decisions {
+		if d == decision {
+			return true
+		}
+	}
+	return false
+}
+
+func (r RubricRules) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *RubricRules) Scan(value interface{}) error {
+	if value == nil {
+		*r = RubricRules{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan RubricRules")
+	}
+	return json.Unmarshal(bytes, r)
+}
+
+// EvidenceRank orders evidence levels from E0 (none) to E3 (strong). An
+// empty level ranks as E0; an unknown level ranks -1.
+func EvidenceRank(level string) int {
+	if level == "" {
+		return 0
+	}
+	for i, l := range ValidEvidenceLevels() {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SLAPolicy sets how quickly reports of a severity must be triaged
+type SLAPolicy struct {
+	Severity      string     `gorm:"size:10;primaryKey"`
+	TriageMinutes int        `gorm:"not null"`
+	WarnMinutes   int        `gorm:"not null;default:0"` // warn this long before the deadline
+	UpdatedBy     *uuid.UUID `gorm:"type:uuid"`
+	UpdatedAt     time.Time  `gorm:"not null;default:now()"`
+}
+
+func (SLAPolicy) TableName() string {
+	return "sla_policies"
+}
+
+// Deadline returns when a report created at createdAt must be triaged
+func (p *SLAPolicy) Deadline(createdAt time.Time) time.Time {
+	return createdAt.Add(time.Duration(p.TriageMinutes) * time.Minute)
+}
+
+// WarnAt returns when a report created at createdAt is about to breach
+func (p *SLAPolicy) WarnAt(createdAt time.Time) time.Time {
+	return p.Deadline(createdAt).Add(-time.Duration(p.WarnMinutes) * time.Minute)
+}
+
+// SLABreach records a report that neared or passed its triage deadline
+type SLABreach struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ReportID   uuid.UUID   `gorm:"type:uuid;not null;uniqueIndex:idx_sla_breach_level"`
+	Level      string      `gorm:"size:20;not null;uniqueIndex:idx_sla_breach_level"`
+	Severity   string      `gorm:"size:10;not null;index"`
+	DueAt      time.Time   `gorm:"not null"`
+	DetectedAt time.Time   `gorm:"not null;default:now()"`
+	Notified   StringArray `gorm:"type:jsonb;default:'[]'"` // user IDs notified
+	NotifiedAt *time.Time  // when the notification was sent; unset until it succeeds
+	ResolvedAt *time.Time  // when the report left the queue
+
+	// Associations
+	Report *Report `gorm:"foreignKey:ReportID"`
+}
+
+func (SLABreach) TableName() string {
+	return "sla_breaches"
+}
+
+func (b *SLABreach) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// SLA breach levels
+const (
+	SLALevelWarning = "warning"
+	SLALevelBreach  = "breach"
+)
+
+// ValidSLALevels returns all valid SLA breach levels
+func ValidSLALevels() []string {
+	return []string{SLALevelWarning, SLALevelBreach}
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SubjectRequest is a data subject's request to see or erase what is held
+// about them. The subject is identified by a follow-up token (stored hashed),
+// a contact ref or a training participant hash; these are cleared once the
+// request is closed, leaving only its outcome.
+type SubjectRequest struct {
+	ID               uuid.UUID       `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Kind             string          `gorm:"size:20;not null"`
+	Status           string          `gorm:"size:20;not null;default:'received';index"`
+	FollowUpHash     string          `gorm:"size:64"` // SHA-256 of the follow-up token the subject gave
+	ContactRef       EncryptedString `gorm:"type:text"`
+	ParticipantHash  string          `gorm:"size:64"`
+	Note             string          `gorm:"type:text"` // how the request arrived
+	VerificationNote string          `gorm:"type:text"` // how the subject's identity was checked
+	VerifiedBy       *uuid.UUID      `gorm:"type:uuid"`
+	VerifiedAt       *time.Time
+	ReceivedAt       time.Time  `gorm:"not null"` // when the subject made the request; the deadline runs from here
+	DueAt            time.Time  `gorm:"not null;index"`
+	ExtendedAt       *time.Time // when the deadline was extended
+	ExtensionReason  string     `gorm:"type:text"`
+	RejectionReason  string     `gorm:"type:text"`
+	Result           JSONMap    `gorm:"type:jsonb"` // records found or erased, by class
+	ClosedBy         *uuid.UUID `gorm:"type:uuid"`
+	ClosedAt         *time.Time
+	RemindedAt       *time.Time // when admins were told the deadline is near
+	OverdueAt        *time.Time // when admins were told the deadline has passed
+	CreatedBy        *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt        time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt        time.Time  `gorm:"not null;default:now()"`
+
+	// Associations
+	Creator  *User `gorm:"foreignKey:CreatedBy"`
+	Verifier *User `gorm:"foreignKey:VerifiedBy"`
+	Closer   *User `gorm:"foreignKey:ClosedBy"`
+}
+
+func (SubjectRequest) TableName() string {
+	return "subject_requests"
+}
+
+func (r *SubjectRequest) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	if r.Status == "" {
+		r.Status = SubjectStatusReceived
+	}
+	return nil
+}
+
+// Open reports whether the request still awaits fulfilment
+func (r *SubjectRequest) Open() bool {
+	return r.Status == SubjectStatusReceived || r.Status == SubjectStatusVerified
+}
+
+// Subject request kinds
+const (
+	SubjectKindAccess  = "access"  // a copy of everything held (UK GDPR Art. 15)
+	SubjectKindErasure = "erasure" // erase or anonymize it (UK GDPR Art. 17)
+)
+
+// Subject request statuses
+const (
+	SubjectStatusReceived  = "received"
+	SubjectStatusVerified  = "verified"  // the requester's identity was checked
+	SubjectStatusFulfilled = "fulfilled" // the copy was sent or the data erased
+	SubjectStatusRejected  = "rejected"
+)
+
+// Subject request deadlines: one month from receipt, which can be extended
+// once by two further months for complex or numerous requests
+const (
+	SubjectDeadlineMonths  = 1
+	SubjectExtensionMonths = 2
+)
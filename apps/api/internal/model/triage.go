@@ -9,15 +9,19 @@ import (
 
 // TriageDecision represents a triage decision for a report
 type TriageDecision struct {
-	ID            uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
-	ReportID      uuid.UUID  `gorm:"type:uuid;not null;index"`
-	DecidedBy     *uuid.UUID `gorm:"type:uuid;index"`
-	Decision      string     `gorm:"size:50;not null"`
-	SeverityFinal string     `gorm:"size:10;not null"`
-	EvidenceLevel string     `gorm:"size:10"`
-	Rationale     string     `gorm:"type:text"`
-	AuditHash     string     `gorm:"size:64"`
-	DecidedAt     time.Time  `gorm:"not null;default:now()"`
+	ID              uuid.UUID   `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ReportID        uuid.UUID   `gorm:"type:uuid;not null;index"`
+	DecidedBy       *uuid.UUID  `gorm:"type:uuid;index"`
+	Decision        string      `gorm:"size:50;not null"`
+	SeverityFinal   string      `gorm:"size:10;not null"`
+	EvidenceLevel   string      `gorm:"size:10"`
+	Rationale       string      `gorm:"type:text"`
+	AuditHash       string      `gorm:"size:64"`
+	RubricVersion   *int        // rubric version the decision was checked against
+	RationaleFields JSONMap     `gorm:"type:jsonb"`              // structured rationale required by the rubric
+	Confirmations   StringArray `gorm:"type:jsonb;default:'[]'"` // rubric checks the triager confirmed
+	RoundID         *uuid.UUID  `gorm:"type:uuid"`               // consensus round the decision closed
+	DecidedAt       time.Time   `gorm:"not null;default:now()"`
 
 	// Associations
 	Report  Report `gorm:"foreignKey:ReportID"`
@@ -15,6 +15,7 @@ type User struct {
 	Role         string    `gorm:"size:50;not null;default:'triager'"`
 	DisplayName  string    `gorm:"size:255"`
 	IsActive     bool      `gorm:"not null;default:true"`
+	OnCall       bool      `gorm:"not null;default:false"`
 	CreatedAt    time.Time `gorm:"not null;default:now()"`
 	UpdatedAt    time.Time `gorm:"not null;default:now()"`
 
@@ -0,0 +1,45 @@
+// Package agreement measures how often two raters give the same label to the
+// same items, corrected for the agreement expected by chance.
+package agreement
+
+// Stats summarizes the agreement of two raters over a set of items
+type Stats struct {
+	Items    int     // items both raters labelled
+	Observed float64 // share of items with the same label
+	Expected float64 // share expected to match by chance
+	// Kappa is Cohen's kappa, (observed - expected) / (1 - expected): 1 is
+	// perfect agreement, 0 is what chance alone gives and below 0 is worse.
+	// It is undefined, and left nil, when there are no items or both raters
+	// gave every item the same single label.
+	Kappa *float64
+}
+
+// Cohen computes Cohen's kappa for two raters. a[i] and b[i] are the labels
+// the raters gave item i; a and b must have the same length.
+func Cohen(a, b []string) Stats {
+	n := len(a)
+	if n == 0 || len(b) != n {
+		return Stats{}
+	}
+
+	countA := make(map[string]int)
+	countB := make(map[string]int)
+	same := 0
+	for i := range a {
+		countA[a[i]]++
+		countB[b[i]]++
+		if a[i] == b[i] {
+			same++
+		}
+	}
+
+	stats := Stats{Items: n, Observed: float64(same) / float64(n)}
+	for label, ca := range countA {
+		stats.Expected += float64(ca) / float64(n) * float64(countB[label]) / float64(n)
+	}
+	if stats.Expected < 1 {
+		kappa := (stats.Observed - stats.Expected) / (1 - stats.Expected)
+		stats.Kappa = &kappa
+	}
+	return stats
+}
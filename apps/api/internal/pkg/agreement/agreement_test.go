@@ -0,0 +1,132 @@
+package agreement
+
+import (
+	"math"
+	"testing"
+)
+
+// repeat builds a label list from (label, count) runs
+func repeat(runs ...any) []string {
+	var labels []string
+	for i := 0; i < len(runs); i += 2 {
+		for j := 0; j < runs[i+1].(int); j++ {
+			labels = append(labels, runs[i].(string))
+		}
+	}
+	return labels
+}
+
+func TestCohen(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []string
+		items    int
+		observed float64
+		expected float64
+		kappa    *float64
+	}{
+		{
+			name:     "perfect agreement",
+			a:        []string{"S1", "S3", "S1", "S3"},
+			b:        []string{"S1", "S3", "S1", "S3"},
+			items:    4,
+			observed: 1,
+			expected: 0.5,
+			kappa:    ptr(1),
+		},
+		{
+			name:     "chance level",
+			a:        []string{"S1", "S1", "S3", "S3"},
+			b:        []string{"S1", "S3", "S1", "S3"},
+			items:    4,
+			observed: 0.5,
+			expected: 0.5,
+			kappa:    ptr(0),
+		},
+		{
+			name:     "complete disagreement",
+			a:        []string{"S1", "S3"},
+			b:        []string{"S3", "S1"},
+			items:    2,
+			observed: 0,
+			expected: 0.5,
+			kappa:    ptr(-1),
+		},
+		{
+			// 20 both yes, 5 a yes b no, 10 a no b yes, 15 both no
+			name:     "partial agreement",
+			a:        repeat("yes", 25, "no", 25),
+			b:        repeat("yes", 20, "no", 5, "yes", 10, "no", 15),
+			items:    50,
+			observed: 0.7,
+			expected: 0.5,
+			kappa:    ptr(0.4),
+		},
+		{
+			name:     "labels only one rater used",
+			a:        []string{"S2", "S2", "S4"},
+			b:        []string{"S2", "S3", "S3"},
+			items:    3,
+			observed: 1.0 / 3,
+			expected: 2.0 / 9,
+			kappa:    ptr(1.0 / 7),
+		},
+		{
+			name:     "one label throughout",
+			a:        []string{"S2", "S2", "S2"},
+			b:        []string{"S2", "S2", "S2"},
+			items:    3,
+			observed: 1,
+			expected: 1,
+		},
+		{
+			name: "no items",
+		},
+		{
+			name: "length mismatch",
+			a:    []string{"S1", "S2"},
+			b:    []string{"S1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Cohen(tt.a, tt.b)
+			if got.Items != tt.items {
+				t.Errorf("Items = %d, want %d", got.Items, tt.items)
+			}
+			if !near(got.Observed, tt.observed) {
+				t.Errorf("Observed = %v, want %v", got.Observed, tt.observed)
+			}
+			if !near(got.Expected, tt.expected) {
+				t.Errorf("Expected = %v, want %v", got.Expected, tt.expected)
+			}
+			switch {
+			case tt.kappa == nil && got.Kappa != nil:
+				t.Errorf("Kappa = %v, want nil", *got.Kappa)
+			case tt.kappa != nil && got.Kappa == nil:
+				t.Errorf("Kappa = nil, want %v", *tt.kappa)
+			case tt.kappa != nil && !near(*got.Kappa, *tt.kappa):
+				t.Errorf("Kappa = %v, want %v", *got.Kappa, *tt.kappa)
+			}
+		})
+	}
+}
+
+func TestCohenSymmetric(t *testing.T) {
+	a := repeat("S1", 3, "S2", 4, "S3", 2, "S4", 1)
+	b := repeat("S1", 2, "S2", 5, "S3", 1, "S4", 2)
+
+	ab, ba := Cohen(a, b), Cohen(b, a)
+	if !near(*ab.Kappa, *ba.Kappa) {
+		t.Errorf("Cohen(a, b) kappa = %v, Cohen(b, a) kappa = %v", *ab.Kappa, *ba.Kappa)
+	}
+}
+
+func ptr(f float64) *float64 {
+	return &f
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
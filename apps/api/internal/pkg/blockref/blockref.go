@@ -0,0 +1,127 @@
+// Package blockref issues and checks references for blocked requests.
+//
+// When intake turns a client away (for example on a rate limit) it hands out
+// a reference the reporter can quote in an appeal. The reference carries a
+// random nonce and the time it was issued, signed with an HMAC, so the server
+// can check it later without storing anything and without recording who was
+// blocked.
+//
+// When an appeal against a block is upheld in the reporter's favour, they get
+// a pass: a signed token naming the appeal that lets one blocked request
+// through. Passes are signed apart from references, so neither can stand in
+// for the other; spending a pass only once is up to the caller.
+package blockref
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformed = errors.New("malformed reference")
+	ErrExpired   = errors.New("reference expired")
+)
+
+// Issue creates a signed reference stamped with now
+func Issue(secret []byte, now time.Time) (string, error) {
+	// payload: 8-byte nonce | 8-byte issue time (unix seconds)
+	payload := make([]byte, 16)
+	if _, err := rand.Read(payload[:8]); err != nil {
+		return "", err
+	}
+	binary.BigEndian.PutUint64(payload[8:], uint64(now.Unix()))
+
+	return encode(secret, refPurpose, payload), nil
+}
+
+// Verify checks a reference's signature and that it was issued no more than
+// maxAge before now. It returns the issue time.
+func Verify(secret []byte, ref string, maxAge time.Duration, now time.Time) (time.Time, error) {
+	payload, err := decode(secret, refPurpose, ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return issuedAt(payload[8:], maxAge, now)
+}
+
+// IssuePass creates a pass for the appeal with the given ID, stamped with
+// now. The same appeal and time always give the same pass.
+func IssuePass(secret []byte, appealID [16]byte, now time.Time) string {
+	// payload: 16-byte appeal ID | 8-byte issue time (unix seconds)
+	payload := make([]byte, 24)
+	copy(payload, appealID[:])
+	binary.BigEndian.PutUint64(payload[16:], uint64(now.Unix()))
+	return encode(secret, passPurpose, payload)
+}
+
+// VerifyPass checks a pass's signature and that it was issued no more than
+// maxAge before now. It returns the appeal ID.
+func VerifyPass(secret []byte, pass string, maxAge time.Duration, now time.Time) ([16]byte, error) {
+	var appealID [16]byte
+	payload, err := decode(secret, passPurpose, pass)
+	if err != nil {
+		return appealID, err
+	}
+	if len(payload) != 24 {
+		return appealID, ErrMalformed
+	}
+	if _, err := issuedAt(payload[16:], maxAge, now); err != nil {
+		return appealID, err
+	}
+	copy(appealID[:], payload)
+	return appealID, nil
+}
+
+// Signing purposes, keeping references and passes apart
+const (
+	refPurpose  = "blockref:"
+	passPurpose = "blockpass:"
+)
+
+// encode joins a payload and its signature
+func encode(secret []byte, purpose string, payload []byte) string {
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(sign(secret, purpose, payload))
+}
+
+// decode checks a token's signature and returns its payload
+func decode(secret []byte, purpose, token string) ([]byte, error) {
+	encoded, mac, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrMalformed
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || (purpose == refPurpose && len(payload) != 16) {
+		return nil, ErrMalformed
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(mac)
+	if err != nil || !hmac.Equal(sig, sign(secret, purpose, payload)) {
+		return nil, ErrMalformed
+	}
+	return payload, nil
+}
+
+// issuedAt reads an 8-byte issue time and checks it is no more than maxAge
+// before now
+func issuedAt(stamp []byte, maxAge time.Duration, now time.Time) (time.Time, error) {
+	at := time.Unix(int64(binary.BigEndian.Uint64(stamp)), 0).UTC()
+	if now.Sub(at) > maxAge || at.After(now.Add(time.Minute)) {
+		return time.Time{}, ErrExpired
+	}
+	return at, nil
+}
+
+// sign returns a truncated HMAC-SHA256 of payload; 16 bytes is plenty for a
+// token that only unlocks an appeal form or a single request
+func sign(secret []byte, purpose string, payload []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(purpose))
+	h.Write(payload)
+	return h.Sum(nil)[:16]
+}
@@ -0,0 +1,105 @@
+package blockref
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	secret := []byte("test-secret")
+	issued := time.Date(2026, 1, 8, 14, 30, 0, 0, time.UTC)
+	const maxAge = 30 * 24 * time.Hour
+
+	ref, err := Issue(secret, issued)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	encoded, mac, _ := strings.Cut(ref, ".")
+	pass := IssuePass(secret, [16]byte{1}, issued)
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		ref     string
+		now     time.Time
+		wantErr error
+	}{
+		{"fresh", secret, ref, issued, nil},
+		{"at max age", secret, ref, issued.Add(maxAge), nil},
+		{"slight clock skew", secret, ref, issued.Add(-30 * time.Second), nil},
+		{"too old", secret, ref, issued.Add(maxAge + time.Second), ErrExpired},
+		{"from the future", secret, ref, issued.Add(-2 * time.Minute), ErrExpired},
+		{"wrong secret", []byte("other-secret"), ref, issued, ErrMalformed},
+		{"forged signature", secret, encoded + "." + strings.Repeat("A", len(mac)), issued, ErrMalformed},
+		{"missing signature", secret, encoded, issued, ErrMalformed},
+		{"pass is not a reference", secret, pass, issued, ErrMalformed},
+		{"empty", secret, "", issued, ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := Verify(tt.secret, tt.ref, maxAge, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !at.Equal(issued) {
+				t.Errorf("Verify() = %v, want %v", at, issued)
+			}
+		})
+	}
+}
+
+func TestIssueUnique(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Date(2026, 1, 8, 14, 30, 0, 0, time.UTC)
+
+	a, _ := Issue(secret, now)
+	b, _ := Issue(secret, now)
+	if a == b {
+		t.Errorf("two references issued at once are equal: %q", a)
+	}
+}
+
+func TestVerifyPass(t *testing.T) {
+	secret := []byte("test-secret")
+	decided := time.Date(2026, 1, 8, 14, 30, 0, 0, time.UTC)
+	const maxAge = 7 * 24 * time.Hour
+	appealID := [16]byte{0x55, 0x0e, 0x84, 0x00, 0xe2, 0x9b, 0x41, 0xd4}
+
+	pass := IssuePass(secret, appealID, decided)
+	ref, _ := Issue(secret, decided)
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		pass    string
+		now     time.Time
+		wantErr error
+	}{
+		{"fresh", secret, pass, decided, nil},
+		{"at max age", secret, pass, decided.Add(maxAge), nil},
+		{"too old", secret, pass, decided.Add(maxAge + time.Second), ErrExpired},
+		{"wrong secret", []byte("other-secret"), pass, decided, ErrMalformed},
+		{"reference is not a pass", secret, ref, decided, ErrMalformed},
+		{"another appeal's payload", secret, IssuePass(secret, [16]byte{2}, decided)[:32] + pass[32:], decided, ErrMalformed},
+		{"empty", secret, "", decided, ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyPass(tt.secret, tt.pass, maxAge, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyPass() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != appealID {
+				t.Errorf("VerifyPass() = %x, want %x", got, appealID)
+			}
+		})
+	}
+
+	if again := IssuePass(secret, appealID, decided); again != pass {
+		t.Errorf("IssuePass() is not deterministic: %q, %q", pass, again)
+	}
+}
@@ -0,0 +1,176 @@
+// Package claimreview renders claim verdicts as schema.org ClaimReview
+// JSON-LD, the markup fact-check aggregators read.
+package claimreview
+
+import "time"
+
+// Context is the JSON-LD context of every document built here
+const Context = "https://schema.org"
+
+// Ratings map verdicts onto a 1 (worst) to 3 (best) scale; aggregators show
+// the alternateName, the numbers only order the verdicts
+const (
+	worstRating = 1
+	bestRating  = 3
+)
+
+// ratings holds the numeric rating and label of each publishable verdict
+var ratings = map[string]struct {
+	value int
+	name  string
+}{
+	"false":      {1, "False"},
+	"misleading": {2, "Misleading"},
+	"true":       {3, "True"},
+}
+
+// Publishable reports whether a verdict can be published as a ClaimReview
+func Publishable(verdict string) bool {
+	_, ok := ratings[verdict]
+	return ok
+}
+
+// Organization is a schema.org Organization
+type Organization struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// NewOrganization returns the organization publishing reviews
+func NewOrganization(name, url string) Organization {
+	return Organization{Type: "Organization", Name: name, URL: url}
+}
+
+// CreativeWork is a schema.org CreativeWork, used for claim appearances
+type CreativeWork struct {
+	Type string `json:"@type"`
+	URL  string `json:"url"`
+}
+
+// Claim is the schema.org Claim a review is about
+type Claim struct {
+	Type          string         `json:"@type"`
+	DatePublished string         `json:"datePublished,omitempty"`
+	Appearance    []CreativeWork `json:"appearance,omitempty"`
+}
+
+// Rating is a schema.org Rating
+type Rating struct {
+	Type          string `json:"@type"`
+	RatingValue   int    `json:"ratingValue"`
+	BestRating    int    `json:"bestRating"`
+	WorstRating   int    `json:"worstRating"`
+	AlternateName string `json:"alternateName"`
+}
+
+// ClaimReview is a schema.org ClaimReview
+type ClaimReview struct {
+	Context       string       `json:"@context,omitempty"`
+	Type          string       `json:"@type"`
+	ID            string       `json:"@id"`
+	URL           string       `json:"url"`
+	ClaimReviewed string       `json:"claimReviewed"`
+	ReviewBody    string       `json:"reviewBody,omitempty"`
+	InLanguage    string       `json:"inLanguage,omitempty"`
+	DatePublished string       `json:"datePublished"`
+	DateModified  string       `json:"dateModified,omitempty"`
+	Author        Organization `json:"author"`
+	ItemReviewed  Claim        `json:"itemReviewed"`
+	ReviewRating  Rating       `json:"reviewRating"`
+	Citation      []string     `json:"citation,omitempty"`
+}
+
+// Review holds what a ClaimReview is built from
+type Review struct {
+	URL         string // public page of the review
+	Claim       string
+	Language    string
+	Appearances []string
+	FirstSeen   time.Time // when the claim was first recorded
+	Verdict     string
+	Explanation string
+	Evidence    []string
+	Published   time.Time
+	Modified    time.Time
+}
+
+// Build renders a review by author. It returns false when the verdict is not
+// publishable (unverified).
+func Build(author Organization, r Review) (*ClaimReview, bool) {
+	rating, ok := ratings[r.Verdict]
+	if !ok {
+		return nil, false
+	}
+
+	review := &ClaimReview{
+		Context:       Context,
+		Type:          "ClaimReview",
+		ID:            r.URL,
+		URL:           r.URL,
+		ClaimReviewed: r.Claim,
+		ReviewBody:    r.Explanation,
+		InLanguage:    r.Language,
+		DatePublished: date(r.Published),
+		Author:        author,
+		ItemReviewed: Claim{
+			Type:          "Claim",
+			DatePublished: date(r.FirstSeen),
+		},
+		ReviewRating: Rating{
+			Type:          "Rating",
+			RatingValue:   rating.value,
+			BestRating:    bestRating,
+			WorstRating:   worstRating,
+			AlternateName: rating.name,
+		},
+		Citation: r.Evidence,
+	}
+	if !r.Modified.IsZero() && date(r.Modified) != review.DatePublished {
+		review.DateModified = date(r.Modified)
+	}
+	for _, url := range r.Appearances {
+		review.ItemReviewed.Appearance = append(review.ItemReviewed.Appearance, CreativeWork{Type: "CreativeWork", URL: url})
+	}
+
+	return review, true
+}
+
+// DataFeed is a schema.org DataFeed of reviews, for aggregators that ingest
+// feeds instead of crawling review pages
+type DataFeed struct {
+	Context         string        `json:"@context"`
+	Type            string        `json:"@type"`
+	Name            string        `json:"name"`
+	Publisher       Organization  `json:"publisher"`
+	DateModified    string        `json:"dateModified,omitempty"`
+	DataFeedElement []ClaimReview `json:"dataFeedElement"`
+}
+
+// Feed wraps reviews in a DataFeed. Reviews in a feed drop their own
+// @context, which the feed provides.
+func Feed(publisher Organization, reviews []ClaimReview, modified time.Time) *DataFeed {
+	feed := &DataFeed{
+		Context:         Context,
+		Type:            "DataFeed",
+		Name:            publisher.Name + " fact checks",
+		Publisher:       publisher,
+		DataFeedElement: make([]ClaimReview, len(reviews)),
+	}
+	if !modified.IsZero() {
+		feed.DateModified = modified.UTC().Format(time.RFC3339)
+	}
+	for i, review := range reviews {
+		review.Context = ""
+		feed.DataFeedElement[i] = review
+	}
+	return feed
+}
+
+// date formats a time as a schema.org Date
+func date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02")
+}
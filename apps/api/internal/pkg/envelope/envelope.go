@@ -0,0 +1,285 @@
+// Package envelope encrypts stored fields with envelope encryption.
+//
+// Every value gets its own random data key. The value is sealed with the data
+// key (AES-256-GCM) and the data key is sealed with a master key from a
+// Keyring; both go into the output together with the master key's ID. To
+// rotate master keys only the data keys need re-sealing (Rewrap), so values
+// are never decrypted in bulk and old and new master keys can be used side by
+// side while that happens.
+//
+// Sealed values start with a magic prefix, so plaintext stored before
+// encryption was enabled can be told apart and still read.
+package envelope
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize is the length of master and data keys in bytes (AES-256)
+const KeySize = 32
+
+// magic starts every sealed value; stringPrefix starts its text form
+var magic = []byte("HVE1")
+
+const stringPrefix = "enc1:"
+
+var (
+	ErrMalformed  = errors.New("malformed sealed value")
+	ErrUnknownKey = errors.New("unknown master key")
+)
+
+// Keyring holds the master keys. New values are sealed with the primary key;
+// any key in the ring can open values sealed with it.
+type Keyring struct {
+	primary string
+	keys    map[string][]byte
+}
+
+// NewKeyring creates a keyring from master keys by ID
+func NewKeyring(primary string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("primary key %q is not in the keyring", primary)
+	}
+	ring := &Keyring{primary: primary, keys: make(map[string][]byte, len(keys))}
+	for id, key := range keys {
+		if id == "" || len(id) > 255 || strings.ContainsAny(id, ":,") {
+			return nil, fmt.Errorf("invalid key ID %q", id)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("key %q is %d bytes, want %d", id, len(key), KeySize)
+		}
+		ring.keys[id] = key
+	}
+	return ring, nil
+}
+
+// keyringFile is the JSON layout of a keyring file
+type keyringFile struct {
+	Primary string            `json:"primary"`
+	Keys    map[string]string `json:"keys"` // base64 master keys by ID
+}
+
+// LoadKeyringFile reads a keyring from a JSON file of the form
+//
+//	{"primary": "2026-10", "keys": {"2026-01": "<base64>", "2026-10": "<base64>"}}
+func LoadKeyringFile(path string) (*Keyring, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file keyringFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("invalid keyring file: %w", err)
+	}
+	keys := make(map[string][]byte, len(file.Keys))
+	for id, encoded := range file.Keys {
+		key, err := ParseKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return NewKeyring(file.Primary, keys)
+}
+
+// ParseKey decodes a base64 master key
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 key: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key is %d bytes, want %d", len(key), KeySize)
+	}
+	return key, nil
+}
+
+// Primary returns the ID of the key new values are sealed with
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// Seal encrypts plaintext under a new data key wrapped by the primary key.
+//
+// Layout: magic | key ID length (1) | key ID | wrapped data key length (1) |
+// wrapped data key | nonce | ciphertext.
+func (k *Keyring) Seal(plaintext []byte) ([]byte, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	wrapped, err := seal(k.keys[k.primary], dataKey)
+	if err != nil {
+		return nil, err
+	}
+	body, err := seal(dataKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return join(k.primary, wrapped, body), nil
+}
+
+// Open decrypts a sealed value
+func (k *Keyring) Open(sealed []byte) ([]byte, error) {
+	id, wrapped, body, err := split(sealed)
+	if err != nil {
+		return nil, err
+	}
+	master, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, id)
+	}
+	dataKey, err := open(master, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return open(dataKey, body)
+}
+
+// Rewrap re-seals a value's data key under the primary key. The value itself
+// is not decrypted. Values already under the primary key are returned as is.
+func (k *Keyring) Rewrap(sealed []byte) ([]byte, error) {
+	id, wrapped, body, err := split(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if id == k.primary {
+		return sealed, nil
+	}
+	master, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, id)
+	}
+	dataKey, err := open(master, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	rewrapped, err := seal(k.keys[k.primary], dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return join(k.primary, rewrapped, body), nil
+}
+
+// SealString seals a string into its text form
+func (k *Keyring) SealString(plaintext string) (string, error) {
+	sealed, err := k.Seal([]byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return EncodeString(sealed), nil
+}
+
+// OpenString opens the text form of a sealed value
+func (k *Keyring) OpenString(sealed string) (string, error) {
+	raw, err := DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := k.Open(raw)
+	return string(plaintext), err
+}
+
+// IsSealed reports whether b is a sealed value
+func IsSealed(b []byte) bool {
+	return bytes.HasPrefix(b, magic)
+}
+
+// IsSealedString reports whether s is the text form of a sealed value
+func IsSealedString(s string) bool {
+	return strings.HasPrefix(s, stringPrefix)
+}
+
+// DecodeString turns the text form of a sealed value into its binary form
+func DecodeString(s string) ([]byte, error) {
+	if !IsSealedString(s) {
+		return nil, ErrMalformed
+	}
+	raw, err := base64.RawStdEncoding.DecodeString(s[len(stringPrefix):])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	return raw, nil
+}
+
+// EncodeString turns a sealed value into its text form
+func EncodeString(sealed []byte) string {
+	return stringPrefix + base64.RawStdEncoding.EncodeToString(sealed)
+}
+
+// KeyID returns the ID of the master key a value is sealed under
+func KeyID(sealed []byte) (string, error) {
+	id, _, _, err := split(sealed)
+	return id, err
+}
+
+// join lays out a sealed value
+func join(id string, wrapped, body []byte) []byte {
+	out := make([]byte, 0, len(magic)+2+len(id)+len(wrapped)+len(body))
+	out = append(out, magic...)
+	out = append(out, byte(len(id)))
+	out = append(out, id...)
+	out = append(out, byte(len(wrapped)))
+	out = append(out, wrapped...)
+	return append(out, body...)
+}
+
+// split parses a sealed value into its key ID, wrapped data key and body
+func split(sealed []byte) (string, []byte, []byte, error) {
+	if !IsSealed(sealed) {
+		return "", nil, nil, ErrMalformed
+	}
+	rest := sealed[len(magic):]
+	if len(rest) < 1 || len(rest) < 1+int(rest[0]) {
+		return "", nil, nil, ErrMalformed
+	}
+	id := string(rest[1 : 1+int(rest[0])])
+	rest = rest[1+int(rest[0]):]
+	if len(rest) < 1 || len(rest) < 1+int(rest[0]) {
+		return "", nil, nil, ErrMalformed
+	}
+	wrapped := rest[1 : 1+int(rest[0])]
+	return id, wrapped, rest[1+int(rest[0]):], nil
+}
+
+// seal encrypts with AES-256-GCM; the output is nonce | ciphertext
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts the output of seal
+func open(key, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrMalformed
+	}
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,268 @@
+package envelope
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, KeySize)
+}
+
+func TestNewKeyring(t *testing.T) {
+	tests := []struct {
+		name    string
+		primary string
+		keys    map[string][]byte
+		wantErr bool
+	}{
+		{"one key", "k1", map[string][]byte{"k1": testKey(1)}, false},
+		{"with retired key", "k2", map[string][]byte{"k1": testKey(1), "k2": testKey(2)}, false},
+		{"primary missing", "k3", map[string][]byte{"k1": testKey(1)}, true},
+		{"short key", "k1", map[string][]byte{"k1": testKey(1)[:16]}, true},
+		{"empty ID", "k1", map[string][]byte{"k1": testKey(1), "": testKey(2)}, true},
+		{"ID with separator", "k1", map[string][]byte{"k1": testKey(1), "a:b": testKey(2)}, true},
+		{"ID too long", "k1", map[string][]byte{"k1": testKey(1), strings.Repeat("k", 256): testKey(2)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring, err := NewKeyring(tt.primary, tt.keys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewKeyring() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && ring.Primary() != tt.primary {
+				t.Errorf("Primary() = %q, want %q", ring.Primary(), tt.primary)
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		wantErr bool
+	}{
+		{"valid", base64.StdEncoding.EncodeToString(testKey(7)), false},
+		{"surrounding whitespace", " " + base64.StdEncoding.EncodeToString(testKey(7)) + "\n", false},
+		{"wrong length", base64.StdEncoding.EncodeToString(testKey(7)[:24]), true},
+		{"not base64", "not-a-key!", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParseKey(tt.encoded)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !bytes.Equal(key, testKey(7)) {
+				t.Errorf("ParseKey() = %x, want %x", key, testKey(7))
+			}
+		})
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	ring, _ := NewKeyring("k1", map[string][]byte{"k1": testKey(1)})
+
+	tests := []struct {
+		name      string
+		plaintext []byte
+	}{
+		{"empty", []byte{}},
+		{"ascii", []byte("reporter@example.com")},
+		{"unicode", []byte("台北市信義區 0912-345-678")},
+		{"binary", bytes.Repeat([]byte{0, 0xff}, 4096)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed, err := ring.Seal(tt.plaintext)
+			if err != nil {
+				t.Fatalf("Seal() error = %v", err)
+			}
+			if !IsSealed(sealed) {
+				t.Error("IsSealed() = false for a sealed value")
+			}
+			if len(tt.plaintext) > 0 && bytes.Contains(sealed, tt.plaintext) {
+				t.Error("sealed value contains the plaintext")
+			}
+			if id, _ := KeyID(sealed); id != "k1" {
+				t.Errorf("KeyID() = %q, want k1", id)
+			}
+
+			opened, err := ring.Open(sealed)
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			if !bytes.Equal(opened, tt.plaintext) {
+				t.Errorf("Open() = %q, want %q", opened, tt.plaintext)
+			}
+
+			again, _ := ring.Seal(tt.plaintext)
+			if bytes.Equal(again, sealed) {
+				t.Error("sealing twice gave the same output")
+			}
+		})
+	}
+}
+
+func TestOpenErrors(t *testing.T) {
+	ring, _ := NewKeyring("k1", map[string][]byte{"k1": testKey(1)})
+	other, _ := NewKeyring("k2", map[string][]byte{"k2": testKey(2)})
+	impostor, _ := NewKeyring("k1", map[string][]byte{"k1": testKey(9)})
+
+	sealed, _ := ring.Seal([]byte("secret"))
+	tampered := append([]byte(nil), sealed...)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name    string
+		ring    *Keyring
+		sealed  []byte
+		wantErr error // nil accepts any error
+	}{
+		{"plaintext", ring, []byte("secret"), ErrMalformed},
+		{"magic only", ring, magic, ErrMalformed},
+		{"truncated key ID", ring, append(append([]byte(nil), magic...), 10, 'k'), ErrMalformed},
+		{"unknown key", other, sealed, ErrUnknownKey},
+		{"same ID, different key", impostor, sealed, nil},
+		{"tampered ciphertext", ring, tampered, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.ring.Open(tt.sealed)
+			if err == nil {
+				t.Fatal("Open() succeeded")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Open() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRewrap(t *testing.T) {
+	old, _ := NewKeyring("k1", map[string][]byte{"k1": testKey(1)})
+	rotated, _ := NewKeyring("k2", map[string][]byte{"k1": testKey(1), "k2": testKey(2)})
+	unrelated, _ := NewKeyring("k3", map[string][]byte{"k3": testKey(3)})
+
+	underOld, _ := old.Seal([]byte("secret"))
+	underNew, _ := rotated.Seal([]byte("secret"))
+
+	tests := []struct {
+		name      string
+		ring      *Keyring
+		sealed    []byte
+		wantID    string
+		unchanged bool
+		wantErr   error
+	}{
+		{"retired key moves to primary", rotated, underOld, "k2", false, nil},
+		{"primary key left as is", rotated, underNew, "k2", true, nil},
+		{"unknown key", unrelated, underOld, "", false, ErrUnknownKey},
+		{"plaintext", rotated, []byte("secret"), "", false, ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rewrapped, err := tt.ring.Rewrap(tt.sealed)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rewrap() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if id, _ := KeyID(rewrapped); id != tt.wantID {
+				t.Errorf("KeyID() = %q, want %q", id, tt.wantID)
+			}
+			if got := bytes.Equal(rewrapped, tt.sealed); got != tt.unchanged {
+				t.Errorf("unchanged = %v, want %v", got, tt.unchanged)
+			}
+			// The value body is not re-encrypted
+			if !bytes.HasSuffix(rewrapped, tt.sealed[len(tt.sealed)-16:]) {
+				t.Error("Rewrap() changed the sealed value body")
+			}
+			opened, err := tt.ring.Open(rewrapped)
+			if err != nil || string(opened) != "secret" {
+				t.Errorf("Open() = %q, %v, want secret", opened, err)
+			}
+		})
+	}
+}
+
+func TestStringForm(t *testing.T) {
+	ring, _ := NewKeyring("k1", map[string][]byte{"k1": testKey(1)})
+	sealed, _ := ring.SealString("reporter@example.com")
+
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{"sealed", sealed, "reporter@example.com", false},
+		{"plaintext", "reporter@example.com", "", true},
+		{"bad base64", "enc1:***", "", true},
+		{"prefix only", "enc1:", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ring.OpenString(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OpenString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("OpenString() = %q, want %q", got, tt.want)
+			}
+			if IsSealedString(tt.s) != strings.HasPrefix(tt.s, "enc1:") {
+				t.Errorf("IsSealedString(%q) = %v", tt.s, IsSealedString(tt.s))
+			}
+		})
+	}
+}
+
+func TestLoadKeyringFile(t *testing.T) {
+	k1 := base64.StdEncoding.EncodeToString(testKey(1))
+	k2 := base64.StdEncoding.EncodeToString(testKey(2))
+
+	tests := []struct {
+		name    string
+		content string
+		primary string
+		wantErr bool
+	}{
+		{"valid", `{"primary": "2026-10", "keys": {"2026-01": "` + k1 + `", "2026-10": "` + k2 + `"}}`, "2026-10", false},
+		{"primary missing", `{"primary": "2027-01", "keys": {"2026-01": "` + k1 + `"}}`, "", true},
+		{"bad key", `{"primary": "2026-01", "keys": {"2026-01": "short"}}`, "", true},
+		{"not json", `primary=2026-01`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keyring.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			ring, err := LoadKeyringFile(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadKeyringFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && ring.Primary() != tt.primary {
+				t.Errorf("Primary() = %q, want %q", ring.Primary(), tt.primary)
+			}
+		})
+	}
+
+	if _, err := LoadKeyringFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadKeyringFile() succeeded for a missing file")
+	}
+}
@@ -0,0 +1,178 @@
+package geo
+
+import (
+	_ "embed"
+	"encoding/json"
+	"math"
+	"strings"
+	"unicode"
+)
+
+//go:embed gazetteer.json
+var gazetteerJSON []byte
+
+// Zone is a named pilot area (a Taipei district or a Glasgow ward)
+type Zone struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	LocalName string     `json:"localName,omitempty"`
+	Center    [2]float64 `json:"center"`
+	Aliases   []string   `json:"aliases"`
+
+	City *City `json:"-"`
+}
+
+// City groups the zones of one pilot site
+type City struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	LocalName string     `json:"localName,omitempty"`
+	Country   string     `json:"country"`
+	Bounds    [4]float64 `json:"bounds"` // minLat, minLng, maxLat, maxLng
+	Zones     []Zone     `json:"zones"`
+}
+
+// Gazetteer resolves free text and coordinates to pilot zones.
+// Zone centres are approximate; a point is assigned to the nearest centre
+// within its city's bounds, which is close enough for coarse aggregation.
+type Gazetteer struct {
+	Cities []City `json:"cities"`
+
+	zones   map[string]*Zone
+	aliases []alias
+}
+
+type alias struct {
+	text string
+	cjk  bool
+	zone *Zone
+}
+
+// LoadGazetteer parses the embedded pilot-zone gazetteer
+func LoadGazetteer() (*Gazetteer, error) {
+	var g Gazetteer
+	if err := json.Unmarshal(gazetteerJSON, &g); err != nil {
+		return nil, err
+	}
+
+	g.zones = make(map[string]*Zone)
+	for ci := range g.Cities {
+		city := &g.Cities[ci]
+		for zi := range city.Zones {
+			zone := &city.Zones[zi]
+			zone.City = city
+			g.zones[zone.ID] = zone
+			for _, a := range zone.Aliases {
+				text := normalize(a)
+				if text == "" {
+					continue
+				}
+				g.aliases = append(g.aliases, alias{text: text, cjk: hasCJK(text), zone: zone})
+			}
+		}
+	}
+
+	return &g, nil
+}
+
+// Zone returns the zone with the given ID, or nil
+func (g *Gazetteer) Zone(id string) *Zone {
+	return g.zones[id]
+}
+
+// Zones returns all zones in gazetteer order
+func (g *Gazetteer) Zones() []*Zone {
+	var zones []*Zone
+	for ci := range g.Cities {
+		for zi := range g.Cities[ci].Zones {
+			zones = append(zones, &g.Cities[ci].Zones[zi])
+		}
+	}
+	return zones
+}
+
+// Resolve matches free text against zone names and aliases. The longest
+// matching alias wins; nil is returned when nothing matches.
+func (g *Gazetteer) Resolve(text string) *Zone {
+	padded := " " + normalize(text) + " "
+
+	var best *alias
+	for i := range g.aliases {
+		a := &g.aliases[i]
+		// Latin aliases must match whole words, CJK text has no word boundaries
+		needle := " " + a.text + " "
+		if a.cjk {
+			needle = a.text
+		}
+		if !strings.Contains(padded, needle) {
+			continue
+		}
+		if best == nil || len([]rune(a.text)) > len([]rune(best.text)) {
+			best = a
+		}
+	}
+
+	if best == nil {
+		return nil
+	}
+	return best.zone
+}
+
+// Locate returns the zone nearest to a point inside a pilot city, or nil
+func (g *Gazetteer) Locate(lat, lng float64) *Zone {
+	for ci := range g.Cities {
+		city := &g.Cities[ci]
+		b := city.Bounds
+		if lat < b[0] || lat > b[2] || lng < b[1] || lng > b[3] {
+			continue
+		}
+
+		var nearest *Zone
+		best := math.MaxFloat64
+		for zi := range city.Zones {
+			zone := &city.Zones[zi]
+			if d := distance(lat, lng, zone.Center[0], zone.Center[1]); d < best {
+				best, nearest = d, zone
+			}
+		}
+		return nearest
+	}
+	return nil
+}
+
+// distance is an equirectangular approximation, adequate within a city
+func distance(lat1, lng1, lat2, lng2 float64) float64 {
+	x := (lng2 - lng1) * math.Cos((lat1+lat2)/2*math.Pi/180)
+	y := lat2 - lat1
+	return math.Sqrt(x*x + y*y)
+}
+
+// normalize lowercases text and reduces punctuation to single spaces
+func normalize(text string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r == '\'' || r == '’':
+			// "Da'an" and "Daan" should match alike
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		default:
+			space = true
+		}
+	}
+	return b.String()
+}
+
+func hasCJK(text string) bool {
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package geo
+
+import "strings"
+
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxPrecision caps geohash length. Seven characters is a cell of roughly
+// 150m x 150m, which is as fine as a community report should ever be stored.
+const MaxPrecision = 7
+
+// Encode returns the geohash of a point at the given precision (1..MaxPrecision)
+func Encode(lat, lng float64, precision int) string {
+	precision = ClampPrecision(precision)
+
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+
+	var hash strings.Builder
+	bit, ch := 0, 0
+	even := true
+	for hash.Len() < precision {
+		if even {
+			mid := (lngRange[0] + lngRange[1]) / 2
+			if lng >= mid {
+				ch |= 1 << uint(4-bit)
+				lngRange[0] = mid
+			} else {
+				lngRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if lat >= mid {
+				ch |= 1 << uint(4-bit)
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+		} else {
+			hash.WriteByte(base32[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return hash.String()
+}
+
+// Decode returns the centre of a geohash cell. ok is false for invalid hashes.
+func Decode(hash string) (lat, lng float64, ok bool) {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		idx := strings.IndexRune(base32, c)
+		if idx < 0 {
+			return 0, 0, false
+		}
+		for bit := 4; bit >= 0; bit-- {
+			set := idx&(1<<uint(bit)) != 0
+			if even {
+				mid := (lngRange[0] + lngRange[1]) / 2
+				if set {
+					lngRange[0] = mid
+				} else {
+					lngRange[1] = mid
+				}
+			} else {
+				mid := (latRange[0] + latRange[1]) / 2
+				if set {
+					latRange[0] = mid
+				} else {
+					latRange[1] = mid
+				}
+			}
+			even = !even
+		}
+	}
+
+	return (latRange[0] + latRange[1]) / 2, (lngRange[0] + lngRange[1]) / 2, hash != ""
+}
+
+// ClampPrecision keeps a geohash precision within 1..MaxPrecision
+func ClampPrecision(precision int) int {
+	if precision < 1 {
+		return 1
+	}
+	if precision > MaxPrecision {
+		return MaxPrecision
+	}
+	return precision
+}
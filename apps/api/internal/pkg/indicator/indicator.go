@@ -0,0 +1,498 @@
+// Package indicator extracts scam indicators from free-text reports: URLs,
+// domains, email addresses, phone numbers, bank accounts, LINE IDs and crypto
+// wallets.
+//
+// Values are normalised so that one indicator written several ways is stored
+// once. Defanged links ("hxxps://evil[.]com") are refanged, URLs, domains and
+// email addresses are lower-cased, phone numbers become E.164 using the report's region (TW or
+// GB) for national numbers, and wallet addresses are checksum-verified where
+// the format has a checksum.
+package indicator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Indicator types
+const (
+	TypeURL          = "url"
+	TypeDomain       = "domain"
+	TypeEmail        = "email"
+	TypePhone        = "phone"
+	TypeBankAccount  = "bank_account"
+	TypeLineID       = "line_id"
+	TypeCryptoWallet = "crypto_wallet"
+)
+
+// Types returns all indicator types
+func Types() []string {
+	return []string{TypeURL, TypeDomain, TypeEmail, TypePhone, TypeBankAccount, TypeLineID, TypeCryptoWallet}
+}
+
+// LookupPrefixLength is the number of hex characters of Hash a lookup
+// client sends. Each prefix covers 1/16^5 of the hash space, so a client
+// reveals only which of about a million buckets its value falls in.
+const LookupPrefixLength = 5
+
+// Indicator is one normalised indicator found in a text
+type Indicator struct {
+	Type  string
+	Value string
+}
+
+// refang undoes the usual ways of defanging links, and full-width punctuation
+// common in Chinese text
+var refanger = strings.NewReplacer(
+	"[.]", ".", "(.)", ".", "{.}", ".", "[dot]", ".", "(dot)", ".", "．", ".", "。", ". ",
+	"[:]", ":", "：", ": ", "[://]", "://",
+	"hxxps", "https", "hxxp", "http", "hXXps", "https", "hXXp", "http", "HXXPS", "https", "HXXP", "http",
+)
+
+var (
+	urlPattern    = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"'\x60{}|\\^\p{Han}，、！？「」（）【】]+`)
+	emailPattern  = regexp.MustCompile(`(?i)\b[a-z0-9][a-z0-9._%+-]{0,63}@((?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,24})\b`)
+	domainPattern = regexp.MustCompile(`(?i)\b(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+([a-z]{2,24})\b`)
+	phonePattern  = regexp.MustCompile(`(?:\+|\b00|\b0)[1-9][\d \-.()]{6,18}\d`)
+
+	ibanPattern       = regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,4})?\b`)
+	sortCodePattern   = regexp.MustCompile(`(?i)\b(\d{2})[- ](\d{2})[- ](\d{2})[\s,/]*(?:a/?c|acc(?:oun)?t(?:\s*(?:no|number))?)?[\s:.#]*(\d{8})\b`)
+	twAccountPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`[(（](\d{3})[)）]\s*(?:帳號|帳戶|账号|账户)?\s*[:#]?\s*()(\d[\d -]{8,20}\d)`),
+		regexp.MustCompile(`(?i)(?:帳號|帳戶|账号|账户|戶頭|匯款|轉帳|转账|\bacct|\baccount(?:\s*(?:no|number))?|\ba/c)(?:到|至|是|為|为)?\s*[:#]?\s*(?:[(（](\d{3})[)）]\s*-?\s*|(\d{3})\s*-\s*)?(\d[\d -]{8,20}\d)`),
+	}
+
+	linePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(?:\bline\s*id|加\s*(?:line|賴)(?:\s*id)?)\s*:?\s*(@?[a-z0-9][a-z0-9._-]{3,19})`),
+		regexp.MustCompile(`(?i)(?:\bline|賴)\s*:\s*(@?[a-z0-9][a-z0-9._-]{3,19})`),
+		regexp.MustCompile(`(?i)\bline\s+(@[a-z0-9][a-z0-9._-]{2,19})`),
+		regexp.MustCompile(`(?i)\bline\.me/(?:r/)?ti/p/([~@]?[a-z0-9._-]{3,20})`),
+	}
+	lineURLPath = regexp.MustCompile(`(?i)^/(?:r/)?ti/p/([~@]?[a-z0-9._-]{3,20})$`)
+
+	evmPattern    = regexp.MustCompile(`\b0x[0-9a-fA-F]{40}\b`)
+	bech32Pattern = regexp.MustCompile(`(?i)\bbc1[ac-hj-np-z02-9]{11,71}\b`)
+	base58Pattern = regexp.MustCompile(`\b[13T][1-9A-HJ-NP-Za-km-z]{25,34}\b`)
+)
+
+// tlds are the top-level domains a bare domain (one written without a
+// scheme) must end in. Links with a scheme are taken as they are.
+var tlds = map[string]bool{
+	"com": true, "net": true, "org": true, "info": true, "biz": true, "xyz": true,
+	"top": true, "io": true, "co": true, "cc": true, "me": true, "tv": true,
+	"app": true, "site": true, "online": true, "shop": true, "store": true,
+	"vip": true, "club": true, "live": true, "link": true, "asia": true,
+	"pro": true, "icu": true, "buzz": true, "fun": true, "win": true,
+	"bet": true, "cyou": true, "sbs": true, "tk": true, "ml": true, "ga": true,
+	"cf": true, "gq": true, "cn": true, "hk": true, "tw": true, "uk": true,
+	"jp": true, "kr": true, "sg": true, "my": true, "vn": true, "th": true,
+	"ph": true, "id": true, "ru": true,
+}
+
+// freeMail are mailbox providers anyone can sign up with. Scammers use them
+// too, but their domains say nothing about the sender, so an address there
+// is kept without its domain.
+var freeMail = map[string]bool{
+	"gmail.com": true, "googlemail.com": true, "yahoo.com": true, "yahoo.com.tw": true,
+	"yahoo.co.uk": true, "ymail.com": true, "hotmail.com": true, "hotmail.co.uk": true,
+	"outlook.com": true, "live.com": true, "msn.com": true, "icloud.com": true,
+	"me.com": true, "aol.com": true, "proton.me": true, "protonmail.com": true,
+	"gmx.com": true, "mail.com": true, "yandex.com": true, "yandex.ru": true,
+	"qq.com": true, "163.com": true, "126.com": true, "pchome.com.tw": true,
+	"btinternet.com": true, "sky.com": true, "msa.hinet.net": true,
+}
+
+// Extract finds and normalises the indicators in text. region is the ISO
+// country code ("TW" or "GB") used to read national phone numbers; when it is
+// empty the number's length decides. Each indicator appears once.
+func Extract(text, region string) []Indicator {
+	text = refanger.Replace(text)
+	e := extractor{seen: make(map[Indicator]bool)}
+
+	// URLs first, so their hosts and digits are not read again as bare
+	// domains or phone numbers
+	for _, loc := range urlPattern.FindAllStringIndex(text, -1) {
+		e.take(loc)
+		raw := strings.TrimRight(text[loc[0]:loc[1]], ".,;:!?)]}'\"")
+		u, ok := normaliseURL(raw)
+		if !ok {
+			continue
+		}
+		e.add(TypeURL, u.String())
+		if net.ParseIP(u.Hostname()) == nil {
+			e.add(TypeDomain, strings.TrimPrefix(u.Hostname(), "www."))
+		}
+		if u.Hostname() == "line.me" {
+			if m := lineURLPath.FindStringSubmatch(u.Path); m != nil {
+				e.add(TypeLineID, normaliseLineID(m[1]))
+			}
+		}
+	}
+
+	for _, loc := range emailPattern.FindAllStringIndex(text, -1) {
+		if e.taken(loc[0], loc[1]) {
+			continue
+		}
+		e.take(loc)
+		for _, ind := range FromAddress(text[loc[0]:loc[1]]) {
+			e.add(ind.Type, ind.Value)
+		}
+	}
+
+	for _, loc := range domainPattern.FindAllStringSubmatchIndex(text, -1) {
+		if e.taken(loc[0], loc[1]) || (loc[0] > 0 && text[loc[0]-1] == '@') {
+			continue
+		}
+		if !tlds[strings.ToLower(text[loc[2]:loc[3]])] {
+			continue
+		}
+		e.add(TypeDomain, strings.TrimPrefix(strings.ToLower(text[loc[0]:loc[1]]), "www."))
+	}
+
+	// Bank accounts before phones, which look alike
+	for _, loc := range ibanPattern.FindAllStringIndex(text, -1) {
+		iban := strings.ReplaceAll(text[loc[0]:loc[1]], " ", "")
+		if validIBAN(iban) {
+			e.take(loc)
+			e.add(TypeBankAccount, iban)
+		}
+	}
+	for _, m := range sortCodePattern.FindAllStringSubmatchIndex(text, -1) {
+		e.take(m)
+		e.add(TypeBankAccount, text[m[2]:m[3]]+"-"+text[m[4]:m[5]]+"-"+text[m[6]:m[7]]+" "+text[m[8]:m[9]])
+	}
+	for _, pattern := range twAccountPatterns {
+		for _, m := range pattern.FindAllStringSubmatchIndex(text, -1) {
+			if e.taken(m[6], m[7]) {
+				continue
+			}
+			account := digits(text[m[6]:m[7]])
+			if len(account) < 10 || len(account) > 16 {
+				continue
+			}
+			e.take(m)
+			code := group(text, m, 1) + group(text, m, 2)
+			if code != "" {
+				account = code + "-" + account
+			}
+			e.add(TypeBankAccount, account)
+		}
+	}
+
+	for _, loc := range phonePattern.FindAllStringIndex(text, -1) {
+		// A number glued to other digits is part of something longer
+		if e.taken(loc[0], loc[1]) || (loc[0] > 0 && strings.ContainsRune("0123456789-", rune(text[loc[0]-1]))) {
+			continue
+		}
+		if phone, ok := normalisePhone(text[loc[0]:loc[1]], region); ok {
+			e.take(loc)
+			e.add(TypePhone, phone)
+		}
+	}
+
+	for _, pattern := range linePatterns {
+		for _, m := range pattern.FindAllStringSubmatchIndex(text, -1) {
+			if e.taken(m[2], m[3]) {
+				continue
+			}
+			e.add(TypeLineID, normaliseLineID(text[m[2]:m[3]]))
+		}
+	}
+
+	for _, addr := range evmPattern.FindAllString(text, -1) {
+		e.add(TypeCryptoWallet, strings.ToLower(addr))
+	}
+	for _, addr := range bech32Pattern.FindAllString(text, -1) {
+		e.add(TypeCryptoWallet, strings.ToLower(addr))
+	}
+	for _, loc := range base58Pattern.FindAllStringIndex(text, -1) {
+		if e.taken(loc[0], loc[1]) {
+			continue
+		}
+		addr := text[loc[0]:loc[1]]
+		if validBase58Address(addr) {
+			e.add(TypeCryptoWallet, addr)
+		}
+	}
+
+	return e.found
+}
+
+// Normalize normalises a single value of the given type the way Extract does,
+// so a client can build the same Hash for a lookup. It reports false when raw
+// is not a valid value of that type.
+func Normalize(typ, raw, region string) (string, bool) {
+	raw = strings.TrimSpace(refanger.Replace(strings.TrimSpace(raw)))
+	switch typ {
+	case TypeURL:
+		if !strings.Contains(raw, "://") && !strings.HasPrefix(strings.ToLower(raw), "www.") {
+			raw = "http://" + raw
+		}
+		u, ok := normaliseURL(raw)
+		if !ok {
+			return "", false
+		}
+		return u.String(), true
+
+	case TypeDomain:
+		domain := strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(raw), "."), "www.")
+		if !domainPattern.MatchString(domain) || domainPattern.FindString(domain) != domain {
+			return "", false
+		}
+		return domain, true
+
+	case TypeEmail:
+		email := strings.ToLower(strings.Trim(raw, "<>"))
+		if emailPattern.FindString(email) != email {
+			return "", false
+		}
+		return email, true
+
+	case TypePhone:
+		return normalisePhone(raw, region)
+
+	case TypeBankAccount:
+		if iban := strings.ToUpper(strings.ReplaceAll(raw, " ", "")); validIBAN(iban) {
+			return iban, true
+		}
+		if m := sortCodePattern.FindStringSubmatch(raw); m != nil && m[0] == raw {
+			return m[1] + "-" + m[2] + "-" + m[3] + " " + m[4], true
+		}
+		code, account, ok := strings.Cut(raw, "-")
+		if ok && len(digits(code)) == 3 && len(code) == 3 {
+			account = digits(account)
+		} else {
+			code, account = "", digits(raw)
+		}
+		if len(account) < 10 || len(account) > 16 {
+			return "", false
+		}
+		if code != "" {
+			return code + "-" + account, true
+		}
+		return account, true
+
+	case TypeLineID:
+		id := normaliseLineID(raw)
+		if len(strings.TrimPrefix(id, "@")) < 3 {
+			return "", false
+		}
+		return id, true
+
+	case TypeCryptoWallet:
+		switch {
+		case evmPattern.MatchString(raw) && len(raw) == 42:
+			return strings.ToLower(raw), true
+		case bech32Pattern.MatchString(raw) && bech32Pattern.FindString(raw) == raw:
+			return strings.ToLower(raw), true
+		case validBase58Address(raw):
+			return raw, true
+		}
+	}
+	return "", false
+}
+
+// FromAddress returns the indicators of an email address: the normalised
+// address and, unless it belongs to a free mailbox provider, its domain
+func FromAddress(addr string) []Indicator {
+	email, ok := Normalize(TypeEmail, addr, "")
+	if !ok {
+		return nil
+	}
+	found := []Indicator{{Type: TypeEmail, Value: email}}
+	if _, domain, _ := strings.Cut(email, "@"); !freeMail[domain] {
+		found = append(found, Indicator{Type: TypeDomain, Value: domain})
+	}
+	return found
+}
+
+// Hash is the SHA-256 hex digest of type ":" value, the key of the
+// hash-prefix lookup. value must already be normalised.
+func Hash(typ, value string) string {
+	sum := sha256.Sum256([]byte(typ + ":" + value))
+	return hex.EncodeToString(sum[:])
+}
+
+// extractor collects indicators and remembers which parts of the text have
+// already been claimed by one
+type extractor struct {
+	found []Indicator
+	seen  map[Indicator]bool
+	spans [][2]int
+}
+
+func (e *extractor) add(typ, value string) {
+	ind := Indicator{Type: typ, Value: value}
+	if value == "" || e.seen[ind] {
+		return
+	}
+	e.seen[ind] = true
+	e.found = append(e.found, ind)
+}
+
+func (e *extractor) take(loc []int) {
+	e.spans = append(e.spans, [2]int{loc[0], loc[1]})
+}
+
+func (e *extractor) taken(start, end int) bool {
+	for _, s := range e.spans {
+		if start < s[1] && end > s[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// normaliseURL lower-cases the scheme and host, drops default ports,
+// fragments and a bare trailing slash
+func normaliseURL(raw string) (*url.URL, bool) {
+	if strings.HasPrefix(strings.ToLower(raw), "www.") {
+		raw = "http://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return nil, false
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if port := u.Port(); port != "" && !(u.Scheme == "http" && port == "80") && !(u.Scheme == "https" && port == "443") {
+		host = net.JoinHostPort(host, port)
+	}
+	u.Host = host
+	u.User = nil
+	u.Fragment = ""
+	u.RawFragment = ""
+	if u.Path == "/" {
+		u.Path = ""
+		u.RawPath = ""
+	}
+	return u, true
+}
+
+// normalisePhone converts a phone number to E.164. International numbers
+// (+ or 00) are kept as written, dropping a "(0)" trunk prefix; national
+// numbers need a leading 0 and a known region.
+func normalisePhone(raw, region string) (string, bool) {
+	d := digits(raw)
+	switch {
+	case strings.HasPrefix(raw, "+") || strings.HasPrefix(raw, "00"):
+		d = strings.TrimPrefix(d, "00")
+		if strings.HasPrefix(d, "0") {
+			return "", false
+		}
+		for _, cc := range []string{"886", "44"} {
+			if strings.HasPrefix(d, cc+"0") {
+				d = cc + d[len(cc)+1:]
+			}
+		}
+		if len(d) < 8 || len(d) > 15 {
+			return "", false
+		}
+		return "+" + d, true
+
+	case strings.HasPrefix(d, "0"):
+		if region == "" {
+			switch {
+			case len(d) == 10 && strings.HasPrefix(d, "09"):
+				region = "TW"
+			case len(d) == 11 && strings.HasPrefix(d, "07"):
+				region = "GB"
+			}
+		}
+		switch {
+		case region == "TW" && (len(d) == 9 || len(d) == 10):
+			return "+886" + d[1:], true
+		case region == "GB" && (len(d) == 10 || len(d) == 11):
+			return "+44" + d[1:], true
+		}
+	}
+	return "", false
+}
+
+// normaliseLineID lower-cases a LINE ID, keeping the @ of official accounts
+// and dropping the ~ of personal-profile links
+func normaliseLineID(id string) string {
+	return strings.ToLower(strings.TrimPrefix(id, "~"))
+}
+
+// validIBAN checks the ISO 13616 mod-97 checksum
+func validIBAN(iban string) bool {
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+	var b strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			b.WriteString(big.NewInt(int64(r - 'A' + 10)).String())
+		default:
+			return false
+		}
+	}
+	n, ok := new(big.Int).SetString(b.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// validBase58Address checks a Bitcoin legacy (version 0x00 or 0x05) or TRON
+// (version 0x41) address against its double-SHA-256 checksum
+func validBase58Address(addr string) bool {
+	n := new(big.Int)
+	for _, r := range addr {
+		i := strings.IndexRune(base58Alphabet, r)
+		if i < 0 {
+			return false
+		}
+		n.Mul(n, big.NewInt(58))
+		n.Add(n, big.NewInt(int64(i)))
+	}
+	decoded := n.Bytes()
+	for _, r := range addr {
+		if r != '1' {
+			break
+		}
+		decoded = append([]byte{0}, decoded...)
+	}
+	if len(decoded) != 25 {
+		return false
+	}
+
+	switch {
+	case addr[0] == 'T' && decoded[0] != 0x41,
+		addr[0] == '1' && decoded[0] != 0x00,
+		addr[0] == '3' && decoded[0] != 0x05:
+		return false
+	}
+
+	first := sha256.Sum256(decoded[:21])
+	second := sha256.Sum256(first[:])
+	return string(second[:4]) == string(decoded[21:])
+}
+
+// digits keeps only the ASCII digits of s
+func digits(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// group returns submatch i of m, or "" when it did not participate
+func group(text string, m []int, i int) string {
+	if m[2*i] < 0 {
+		return ""
+	}
+	return text[m[2*i]:m[2*i+1]]
+}
@@ -0,0 +1,208 @@
+package indicator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		region string
+		want   []Indicator
+	}{
+		{
+			name: "nothing to find",
+			text: "Someone was rude to me at the station",
+		},
+		{
+			name: "defanged link",
+			text: "Click hxxps://Evil[.]com/Login#pay now",
+			want: []Indicator{{TypeURL, "https://evil.com/Login"}, {TypeDomain, "evil.com"}},
+		},
+		{
+			name: "www link without scheme",
+			text: "go to www.example.com/ to claim",
+			want: []Indicator{{TypeURL, "http://www.example.com"}, {TypeDomain, "example.com"}},
+		},
+		{
+			name: "bare domain with known tld",
+			text: "they said visit Fake-Bank.xyz, or evil.com and EVIL.com",
+			want: []Indicator{{TypeDomain, "fake-bank.xyz"}, {TypeDomain, "evil.com"}},
+		},
+		{
+			name: "bare domain with unknown tld",
+			text: "the file was called invoice.pdf",
+		},
+		{
+			name: "email on own domain",
+			text: "mail came from Support@Fake-Bank.com",
+			want: []Indicator{{TypeEmail, "support@fake-bank.com"}, {TypeDomain, "fake-bank.com"}},
+		},
+		{
+			name: "email on free mailbox",
+			text: "reply to winner2026@gmail.com",
+			want: []Indicator{{TypeEmail, "winner2026@gmail.com"}},
+		},
+		{
+			name:   "national phone with region",
+			text:   "Call 0912-345-678 today",
+			region: "TW",
+			want:   []Indicator{{TypePhone, "+886912345678"}},
+		},
+		{
+			name: "national phone guessed from length",
+			text: "text 07700 900123",
+			want: []Indicator{{TypePhone, "+447700900123"}},
+		},
+		{
+			name: "international phone with trunk prefix",
+			text: "ring +44 (0)20 7946 0018",
+			want: []Indicator{{TypePhone, "+442079460018"}},
+		},
+		{
+			name: "national phone without region",
+			text: "call 02 2345 6789",
+		},
+		{
+			name: "taiwan bank account with bank code",
+			text: "請轉帳到 (822) 123456789012",
+			want: []Indicator{{TypeBankAccount, "822-123456789012"}},
+		},
+		{
+			name: "iban",
+			text: "pay IBAN GB82 WEST 1234 5698 7654 32 now",
+			want: []Indicator{{TypeBankAccount, "GB82WEST12345698765432"}},
+		},
+		{
+			name: "iban with bad checksum",
+			text: "pay IBAN GB83 WEST 1234 5698 7654 32 now",
+		},
+		{
+			name: "uk sort code and account",
+			text: "sort code 12-34-56 account 12345678",
+			want: []Indicator{{TypeBankAccount, "12-34-56 12345678"}},
+		},
+		{
+			name: "line id",
+			text: "加LINE: @Scam123",
+			want: []Indicator{{TypeLineID, "@scam123"}},
+		},
+		{
+			name: "line profile link",
+			text: "https://line.me/ti/p/~Scammer01",
+			want: []Indicator{
+				{TypeURL, "https://line.me/ti/p/~Scammer01"},
+				{TypeDomain, "line.me"},
+				{TypeLineID, "scammer01"},
+			},
+		},
+		{
+			name: "wallets",
+			text: "send to 0x52908400098527886E0F7030069857D2E4169EE7 or bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq or 1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			want: []Indicator{
+				{TypeCryptoWallet, "0x52908400098527886e0f7030069857d2e4169ee7"},
+				{TypeCryptoWallet, "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"},
+				{TypeCryptoWallet, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+			},
+		},
+		{
+			name: "base58 address with bad checksum",
+			text: "send to 1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Extract(tt.text, tt.region); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    string
+		raw    string
+		region string
+		want   string
+		ok     bool
+	}{
+		{"url without scheme", TypeURL, "Evil.com/a", "", "http://evil.com/a", true},
+		{"url default port and slash", TypeURL, "https://evil.com:443/", "", "https://evil.com", true},
+		{"url other port kept", TypeURL, "http://evil.com:8080", "", "http://evil.com:8080", true},
+		{"url defanged", TypeURL, "hxxp://evil[.]com", "", "http://evil.com", true},
+		{"domain", TypeDomain, "WWW.Evil.com.", "", "evil.com", true},
+		{"domain with spaces", TypeDomain, "not a domain", "", "", false},
+		{"email in brackets", TypeEmail, "<Scam@Fake.com>", "", "scam@fake.com", true},
+		{"email without domain", TypeEmail, "scam@", "", "", false},
+		{"phone national", TypePhone, "0912 345 678", "TW", "+886912345678", true},
+		{"phone international", TypePhone, "00886 912 345 678", "", "+886912345678", true},
+		{"phone too short", TypePhone, "12345", "TW", "", false},
+		{"bank account with code", TypeBankAccount, "822-1234 5678 9012", "", "822-123456789012", true},
+		{"bank account digits only", TypeBankAccount, "1234-5678-9012", "", "123456789012", true},
+		{"bank account sort code", TypeBankAccount, "12-34-56 12345678", "", "12-34-56 12345678", true},
+		{"bank account iban", TypeBankAccount, "gb82 west 1234 5698 7654 32", "", "GB82WEST12345698765432", true},
+		{"bank account too short", TypeBankAccount, "12345", "", "", false},
+		{"line id", TypeLineID, "~Abc123", "", "abc123", true},
+		{"line official account", TypeLineID, "@Shop", "", "@shop", true},
+		{"line id too short", TypeLineID, "ab", "", "", false},
+		{"wallet evm", TypeCryptoWallet, "0x52908400098527886E0F7030069857D2E4169EE7", "", "0x52908400098527886e0f7030069857d2e4169ee7", true},
+		{"wallet bitcoin p2sh", TypeCryptoWallet, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", "", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", true},
+		{"wallet bad checksum", TypeCryptoWallet, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLz", "", "", false},
+		{"unknown type", "fax", "12345678", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Normalize(tt.typ, tt.raw, tt.region)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("Normalize(%q, %q) = %q, %v, want %q, %v", tt.typ, tt.raw, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestFromAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		want []Indicator
+	}{
+		{"own domain", "Billing@Fake-Bank.com", []Indicator{{TypeEmail, "billing@fake-bank.com"}, {TypeDomain, "fake-bank.com"}}},
+		{"free mailbox", "someone@yahoo.com.tw", []Indicator{{TypeEmail, "someone@yahoo.com.tw"}}},
+		{"not an address", "someone", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromAddress(tt.addr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromAddress(%q) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		name  string
+		typ   string
+		value string
+		want  string
+	}{
+		{"domain", TypeDomain, "evil.com", "3b7ef89c43703cece22da40e006ff815cd3093138edcc6158fd32a3cdf74ecfd"},
+		{"type is part of the key", TypeURL, "evil.com", "5eb268cfa1bece1a1998e949bd06bde41a102f9bf634fe9e7dcffee4be5938d4"},
+		{"empty", "", "", "e7ac0786668e0ff0f02b62bd04f45ff636fd82db63b1104601c975dc005f3a67"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hash(tt.typ, tt.value); got != tt.want {
+				t.Errorf("Hash(%q, %q) = %q, want %q", tt.typ, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Package line receives LINE Messaging API webhooks and sends replies.
+//
+// Webhook bodies are signed with the channel secret: X-Line-Signature is the
+// base64 HMAC-SHA256 of the raw body. Verify the signature before parsing.
+package line
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader carries the webhook body signature
+const SignatureHeader = "X-Line-Signature"
+
+// DefaultBaseURL is the Messaging API endpoint
+const DefaultBaseURL = "https://api.line.me"
+
+// maxMessages is the most messages one reply or push may carry
+const maxMessages = 5
+
+// VerifySignature reports whether signature is the channel secret's
+// signature of body
+func VerifySignature(secret string, body []byte, signature string) bool {
+	got, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || secret == "" {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// Webhook is a webhook request body
+type Webhook struct {
+	Destination string  `json:"destination"`
+	Events      []Event `json:"events"`
+}
+
+// Event is one webhook event. Only the fields the intake uses are decoded.
+type Event struct {
+	Type            string          `json:"type"` // message, follow, unfollow, ...
+	WebhookEventID  string          `json:"webhookEventId"`
+	ReplyToken      string          `json:"replyToken"`
+	Timestamp       int64           `json:"timestamp"` // milliseconds
+	Source          Source          `json:"source"`
+	Message         *Message        `json:"message,omitempty"`
+	DeliveryContext DeliveryContext `json:"deliveryContext"`
+}
+
+// Source is where an event came from
+type Source struct {
+	Type    string `json:"type"` // user, group or room
+	UserID  string `json:"userId"`
+	GroupID string `json:"groupId,omitempty"`
+	RoomID  string `json:"roomId,omitempty"`
+}
+
+// Message is the message of a message event
+type Message struct {
+	ID   string `json:"id"`
+	Type string `json:"type"` // text, image, sticker, ...
+	Text string `json:"text,omitempty"`
+}
+
+// DeliveryContext tells whether an event is being redelivered
+type DeliveryContext struct {
+	IsRedelivery bool `json:"isRedelivery"`
+}
+
+// ParseWebhook decodes a webhook body
+func ParseWebhook(body []byte) (*Webhook, error) {
+	var w Webhook
+	if err := json.Unmarshal(body, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Client sends messages with a channel access token
+type Client struct {
+	token   string
+	baseURL string
+	http    *http.Client
+}
+
+// NewClient creates a client for the Messaging API at baseURL
+func NewClient(token, baseURL string) *Client {
+	return &Client{
+		token:   token,
+		baseURL: baseURL,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// textMessage is an outgoing text message
+type textMessage struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Reply answers an event with its reply token. Reply tokens are single use
+// and expire shortly after the event.
+func (c *Client) Reply(ctx context.Context, replyToken string, texts ...string) error {
+	return c.post(ctx, "/v2/bot/message/reply", map[string]interface{}{
+		"replyToken": replyToken,
+		"messages":   textMessages(texts),
+	})
+}
+
+// Push sends messages to a user at any time
+func (c *Client) Push(ctx context.Context, to string, texts ...string) error {
+	return c.post(ctx, "/v2/bot/message/push", map[string]interface{}{
+		"to":       to,
+		"messages": textMessages(texts),
+	})
+}
+
+func textMessages(texts []string) []textMessage {
+	if len(texts) > maxMessages {
+		texts = texts[:maxMessages]
+	}
+	messages := make([]textMessage, len(texts))
+	for i, text := range texts {
+		messages[i] = textMessage{Type: "text", Text: text}
+	}
+	return messages
+}
+
+func (c *Client) post(ctx context.Context, path string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("line: %s returned %d: %s", path, resp.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
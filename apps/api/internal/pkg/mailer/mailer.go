@@ -0,0 +1,68 @@
+// Package mailer sends outgoing mail.
+//
+// SMTPSender hands messages to an SMTP relay, authenticating with PLAIN when
+// credentials are configured. LogSender is a local stand-in for development
+// that only logs that a message would have been sent.
+package mailer
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+// Sender delivers a complete RFC 5322 message to the given recipients
+type Sender interface {
+	Send(ctx context.Context, from string, to []string, msg []byte) error
+}
+
+// SMTPSender sends through an SMTP relay
+type SMTPSender struct {
+	addr string
+	auth smtp.Auth
+}
+
+// NewSMTPSender creates a sender for the relay at addr (host:port). With a
+// username, it authenticates with PLAIN, which net/smtp only allows over TLS
+// or to localhost.
+func NewSMTPSender(addr, username, password string) *SMTPSender {
+	s := &SMTPSender{addr: addr}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		s.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return s
+}
+
+// Send delivers the message. The context bounds the whole exchange.
+func (s *SMTPSender) Send(ctx context.Context, from string, to []string, msg []byte) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(s.addr, s.auth, from, to, msg)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Minute):
+		return context.DeadlineExceeded
+	}
+}
+
+// LogSender logs instead of sending
+type LogSender struct{}
+
+// NewLogSender creates a sender that only logs
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+// Send logs the message size; recipients and content are not logged
+func (s *LogSender) Send(ctx context.Context, from string, to []string, msg []byte) error {
+	log.Printf("mail not sent, no SMTP relay configured: %d bytes to %d recipient(s)", len(msg), len(to))
+	return nil
+}
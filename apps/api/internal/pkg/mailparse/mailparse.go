@@ -0,0 +1,599 @@
+// Package mailparse reads RFC 5322 / MIME messages forwarded to the email
+// intake. It decodes the readable text of a message, finds the forwarded
+// original, attached as message/rfc822 or quoted inline below a forwarding
+// marker, and reads the original's sender headers.
+//
+// Inline forwards are recognised by the markers Gmail, Outlook and Apple Mail
+// write in English and Chinese, followed by a block of pseudo-headers
+// ("From:", "寄件者：", ...).
+package mailparse
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// Limits on what is read from a message
+const (
+	maxDepth    = 5       // nested multiparts and attached messages
+	maxPartSize = 4 << 20 // decoded bytes read per part
+)
+
+// ErrNotMessage is returned for input that is not an RFC 5322 message
+var ErrNotMessage = errors.New("not an RFC 5322 message")
+
+// Message is a message received by the intake
+type Message struct {
+	From      *mail.Address // sender of the received message, i.e. the forwarder
+	Subject   string
+	MessageID string
+	Automated bool // bounce, auto-reply or list mail
+	// Authentication-Results headers, topmost (most recently added) first
+	AuthResults []string
+	Note        string    // what the forwarder wrote above the forwarded original
+	Original    *Original // nil when no forwarded original was found
+	Text        string    // the whole text when no original was found
+}
+
+// Original is the forwarded message
+type Original struct {
+	From       string
+	ReplyTo    string
+	ReturnPath string
+	Sender     string
+	Subject    string
+	Date       string
+	Addresses  []string // addresses in From, Reply-To, Return-Path and Sender
+	Text       string
+	Raw        []byte // the message itself when it was attached
+}
+
+// SenderHeaders are the headers of a forwarded original that describe its
+// sender rather than its recipient, for use with Redact
+var SenderHeaders = []string{"From", "Reply-To", "Return-Path", "Sender", "Message-Id", "X-Mailer", "List-Unsubscribe"}
+
+// contentHeaders are always kept by Redact: without them the body cannot be
+// decoded
+var contentHeaders = []string{"Subject", "Date", "Mime-Version", "Content-Type", "Content-Transfer-Encoding", "Content-Language"}
+
+// Parse reads a message. Parts it cannot decode are skipped rather than
+// failing the message.
+func Parse(raw []byte) (*Message, error) {
+	m, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotMessage, err)
+	}
+
+	msg := &Message{
+		Subject:     decodeHeader(m.Header.Get("Subject")),
+		MessageID:   strings.TrimSpace(m.Header.Get("Message-Id")),
+		Automated:   automated(m.Header),
+		AuthResults: m.Header["Authentication-Results"],
+	}
+	if from := addressList(m.Header.Get("From")); len(from) > 0 {
+		msg.From = from[0]
+	}
+
+	var body content
+	body.walk(textproto.MIMEHeader(m.Header), m.Body, 0)
+	text := body.text()
+
+	for _, attached := range body.attached {
+		if original, err := parseOriginal(attached); err == nil {
+			msg.Note = text
+			msg.Original = original
+			return msg, nil
+		}
+	}
+	if note, original, ok := inlineForward(text); ok {
+		msg.Note = note
+		msg.Original = original
+		return msg, nil
+	}
+
+	msg.Text = text
+	return msg, nil
+}
+
+// Redact returns the message with only its content headers and the named
+// headers kept; the body is unchanged
+func Redact(raw []byte, keep ...string) []byte {
+	kept := make(map[string]bool, len(contentHeaders)+len(keep))
+	for _, name := range contentHeaders {
+		kept[textproto.CanonicalMIMEHeaderKey(name)] = true
+	}
+	for _, name := range keep {
+		kept[textproto.CanonicalMIMEHeaderKey(name)] = true
+	}
+
+	end := len(raw)
+	if i := bytes.Index(raw, []byte("\n\n")); i >= 0 {
+		end = i + 1
+	}
+	if i := bytes.Index(raw, []byte("\r\n\r\n")); i >= 0 && i+2 < end {
+		end = i + 2
+	}
+
+	var out bytes.Buffer
+	keeping := false
+	for _, line := range bytes.SplitAfter(raw[:end], []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			// Folded continuation of the previous header
+			if keeping {
+				out.Write(line)
+			}
+			continue
+		}
+		name, _, _ := bytes.Cut(line, []byte(":"))
+		keeping = kept[textproto.CanonicalMIMEHeaderKey(string(bytes.TrimSpace(name)))]
+		if keeping {
+			out.Write(line)
+		}
+	}
+	out.Write(raw[end:])
+	return out.Bytes()
+}
+
+// parseOriginal reads an attached original
+func parseOriginal(raw []byte) (*Original, error) {
+	m, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	var body content
+	body.walk(textproto.MIMEHeader(m.Header), m.Body, 1)
+	original := &Original{
+		From:       decodeHeader(m.Header.Get("From")),
+		ReplyTo:    decodeHeader(m.Header.Get("Reply-To")),
+		ReturnPath: strings.TrimSpace(m.Header.Get("Return-Path")),
+		Sender:     decodeHeader(m.Header.Get("Sender")),
+		Subject:    decodeHeader(m.Header.Get("Subject")),
+		Date:       strings.TrimSpace(m.Header.Get("Date")),
+		Text:       body.text(),
+		Raw:        raw,
+	}
+	original.Addresses = addresses(original.From, original.ReplyTo, original.ReturnPath, original.Sender)
+	return original, nil
+}
+
+// forwardMarker matches the line mail clients put above an inline forward
+var forwardMarker = regexp.MustCompile(`(?m)^[ \t>]*(?:-{2,}[ \t]*(?:Forwarded message|Forwarded Message|Original Message|轉寄的郵件|转发的邮件|原始郵件|原始邮件)[ \t]*-{2,}|Begin forwarded message:|開始轉寄的郵件：|_{10,})[ \t]*$`)
+
+// pseudoHeader matches a header line of an inline forward
+var pseudoHeader = regexp.MustCompile(`^[ \t>]*([A-Za-z-]+|[\p{Han}]{2,4})[ \t]*[:：][ \t]*(.*)$`)
+
+// pseudoHeaderNames maps the header names clients write, in English and
+// Chinese, onto the headers read here. Other names (To, Cc, ...) are skipped.
+var pseudoHeaderNames = map[string]string{
+	"from": "From", "寄件者": "From", "寄件人": "From", "發件人": "From", "发件人": "From",
+	"reply-to": "Reply-To", "回覆至": "Reply-To", "回复至": "Reply-To", "答覆至": "Reply-To",
+	"return-path": "Return-Path",
+	"sender":      "Sender",
+	"subject":     "Subject", "主旨": "Subject", "主題": "Subject", "主题": "Subject",
+	"date": "Date", "sent": "Date", "日期": "Date", "傳送時間": "Date", "发送时间": "Date", "時間": "Date",
+}
+
+// inlineForward splits text at the first forwarding marker followed by
+// pseudo-headers naming a sender or subject
+func inlineForward(text string) (string, *Original, bool) {
+	for _, loc := range forwardMarker.FindAllStringIndex(text, -1) {
+		headers, rest, ok := pseudoHeaders(text[loc[1]:])
+		if !ok {
+			continue
+		}
+		original := &Original{
+			From:       headers["From"],
+			ReplyTo:    headers["Reply-To"],
+			ReturnPath: headers["Return-Path"],
+			Sender:     headers["Sender"],
+			Subject:    headers["Subject"],
+			Date:       headers["Date"],
+			Text:       strings.TrimSpace(rest),
+		}
+		original.Addresses = addresses(original.From, original.ReplyTo, original.ReturnPath, original.Sender)
+		return strings.TrimSpace(text[:loc[0]]), original, true
+	}
+	return "", nil, false
+}
+
+// pseudoHeaders reads the header block below a forwarding marker. Blank lines
+// before the block are skipped; the block ends at the first line that is not
+// a header.
+func pseudoHeaders(text string) (map[string]string, string, bool) {
+	headers := make(map[string]string)
+	seen := false
+	rest := text
+	for rest != "" {
+		line, next, _ := strings.Cut(rest, "\n")
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			if seen {
+				break
+			}
+			rest = next
+			continue
+		}
+		m := pseudoHeader.FindStringSubmatch(line)
+		if m == nil {
+			break
+		}
+		seen = true
+		if name, ok := pseudoHeaderNames[strings.ToLower(m[1])]; ok && headers[name] == "" {
+			headers[name] = strings.TrimSpace(m[2])
+		}
+		rest = next
+	}
+	return headers, rest, headers["From"] != "" || headers["Subject"] != ""
+}
+
+// emailPattern finds addresses in header values that do not parse as
+// address lists, e.g. Outlook's "a@b.com [mailto:a@b.com]"
+var emailPattern = regexp.MustCompile(`(?i)[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,24}`)
+
+// addresses returns the distinct, lower-cased addresses in header values
+func addresses(values ...string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	add := func(addr string) {
+		addr = strings.ToLower(strings.TrimSpace(addr))
+		if addr != "" && !seen[addr] {
+			seen[addr] = true
+			result = append(result, addr)
+		}
+	}
+	for _, v := range values {
+		if list := addressList(v); len(list) > 0 {
+			for _, a := range list {
+				add(a.Address)
+			}
+			continue
+		}
+		for _, addr := range emailPattern.FindAllString(v, -1) {
+			add(addr)
+		}
+	}
+	return result
+}
+
+// addressList parses an address header, decoding encoded words in names
+func addressList(value string) []*mail.Address {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+	list, err := parser.ParseList(value)
+	if err != nil {
+		return nil
+	}
+	return list
+}
+
+// automated reports whether a message was sent by software rather than a
+// person: auto-replies, bounces and mailing list traffic. Replying to these
+// risks mail loops.
+func automated(h mail.Header) bool {
+	if v := strings.ToLower(strings.TrimSpace(h.Get("Auto-Submitted"))); v != "" && v != "no" {
+		return true
+	}
+	switch strings.ToLower(strings.TrimSpace(h.Get("Precedence"))) {
+	case "bulk", "junk", "list", "auto_reply":
+		return true
+	}
+	if h.Get("X-Autoreply") != "" || h.Get("X-Autorespond") != "" || h.Get("List-Id") != "" {
+		return true
+	}
+	if mediaType, _, err := mime.ParseMediaType(h.Get("Content-Type")); err == nil && mediaType == "multipart/report" {
+		return true
+	}
+	for _, a := range addressList(h.Get("From")) {
+		local, _, _ := strings.Cut(strings.ToLower(a.Address), "@")
+		if local == "mailer-daemon" || local == "postmaster" {
+			return true
+		}
+	}
+	return false
+}
+
+// content collects the readable text and attached messages of a message
+type content struct {
+	plain    []string
+	html     []string
+	attached [][]byte
+}
+
+// walk descends into a MIME entity
+func (c *content) walk(header textproto.MIMEHeader, body io.Reader, depth int) {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", nil
+	}
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := dispositionParams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+	body = transferDecoder(header.Get("Content-Transfer-Encoding"), body)
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if depth >= maxDepth || params["boundary"] == "" {
+			return
+		}
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			// Raw parts: transfer encodings are decoded by walk itself
+			part, err := mr.NextRawPart()
+			if err != nil {
+				return
+			}
+			c.walk(part.Header, part, depth+1)
+		}
+
+	case mediaType == "message/rfc822" || strings.HasSuffix(strings.ToLower(filename), ".eml"):
+		if data := readPart(body); len(data) > 0 && depth < maxDepth {
+			c.attached = append(c.attached, data)
+		}
+
+	case disposition == "attachment":
+		// Other attachments (images, documents) are not read
+
+	case mediaType == "text/plain":
+		c.plain = append(c.plain, decodeText(readPart(body), params["charset"]))
+
+	case mediaType == "text/html":
+		c.html = append(c.html, htmlText(decodeText(readPart(body), params["charset"])))
+	}
+}
+
+// text returns the plain text parts, or the HTML parts rendered as text when
+// the message has no plain text
+func (c *content) text() string {
+	parts := c.plain
+	if len(strings.TrimSpace(strings.Join(parts, ""))) == 0 {
+		parts = c.html
+	}
+	return strings.TrimSpace(strings.Join(parts, "\n\n"))
+}
+
+// transferDecoder undoes a Content-Transfer-Encoding
+func transferDecoder(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	}
+	return body
+}
+
+// readPart reads up to maxPartSize bytes of a part. Decoding errors keep
+// what was read before them.
+func readPart(body io.Reader) []byte {
+	data, _ := io.ReadAll(io.LimitReader(body, maxPartSize))
+	return data
+}
+
+// decodeText converts text in the given charset to UTF-8 with LF line
+// endings. Unknown charsets are read as UTF-8.
+func decodeText(data []byte, charset string) string {
+	if charset != "" && !strings.EqualFold(charset, "utf-8") && !strings.EqualFold(charset, "us-ascii") {
+		if enc, err := htmlindex.Get(charset); err == nil {
+			if decoded, err := enc.NewDecoder().Bytes(data); err == nil {
+				data = decoded
+			}
+		}
+	}
+	text := string(data)
+	if !utf8.ValidString(text) {
+		text = strings.ToValidUTF8(text, "�")
+	}
+	return strings.ReplaceAll(text, "\r\n", "\n")
+}
+
+// wordDecoder decodes RFC 2047 encoded words in any charset htmlindex knows
+var wordDecoder = &mime.WordDecoder{
+	CharsetReader: func(charset string, input io.Reader) (io.Reader, error) {
+		enc, err := htmlindex.Get(charset)
+		if err != nil {
+			return nil, err
+		}
+		return enc.NewDecoder().Reader(input), nil
+	},
+}
+
+// decodeHeader decodes encoded words in a header value, keeping the value as
+// is when it cannot be decoded
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+// blockTags start a new line when rendering HTML as text
+var blockTags = map[string]bool{
+	"br": true, "p": true, "div": true, "li": true, "tr": true, "table": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "hr": true, "pre": true,
+}
+
+// htmlText renders HTML as plain text. Link targets are kept after their
+// text, since phishing mail hides its URLs behind link text.
+func htmlText(src string) string {
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(src))
+	skip := 0
+	href, anchorStart := "", 0
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return tidy(b.String())
+
+		case html.TextToken:
+			if skip == 0 {
+				b.Write(z.Text())
+			}
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			tag := string(name)
+			switch tag {
+			case "head", "script", "style", "title":
+				if tt == html.StartTagToken {
+					skip++
+				}
+			case "a":
+				href, anchorStart = "", b.Len()
+				for hasAttr {
+					var key, val []byte
+					key, val, hasAttr = z.TagAttr()
+					if string(key) == "href" {
+						href = strings.TrimSpace(string(val))
+					}
+				}
+			}
+			if blockTags[tag] {
+				b.WriteByte('\n')
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			switch tag {
+			case "head", "script", "style", "title":
+				if skip > 0 {
+					skip--
+				}
+			case "a":
+				lower := strings.ToLower(href)
+				shown := strings.TrimSpace(b.String()[anchorStart:])
+				if (strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")) && shown != href {
+					b.WriteString(" <" + href + ">")
+				}
+				href = ""
+			}
+			if blockTags[tag] {
+				b.WriteByte('\n')
+			}
+		}
+	}
+}
+
+// tidy collapses runs of spaces within lines and runs of blank lines
+func tidy(text string) string {
+	var lines []string
+	blank := false
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			if blank || len(lines) == 0 {
+				continue
+			}
+			blank = true
+		} else {
+			blank = false
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// Authenticated reports whether the sender address of a message was verified
+// upstream: the topmost Authentication-Results header (RFC 8601) must come
+// from authservID and show a DMARC pass, or a DKIM or SPF pass for a domain
+// aligned with the From domain. Lower headers are ignored, since the sender
+// can write them. An empty authservID trusts nothing.
+func Authenticated(results []string, authservID, from string) bool {
+	_, fromDomain, ok := strings.Cut(strings.ToLower(from), "@")
+	if authservID == "" || len(results) == 0 || !ok || fromDomain == "" {
+		return false
+	}
+
+	statements := strings.Split(stripComments(results[0]), ";")
+	if id := strings.Fields(statements[0]); len(id) == 0 || !strings.EqualFold(id[0], authservID) {
+		return false
+	}
+	for _, statement := range statements[1:] {
+		fields := strings.Fields(statement)
+		if len(fields) == 0 {
+			continue
+		}
+		method, result, _ := strings.Cut(strings.ToLower(fields[0]), "=")
+		if result != "pass" {
+			continue
+		}
+		props := make(map[string]string)
+		for _, f := range fields[1:] {
+			if k, v, ok := strings.Cut(f, "="); ok {
+				props[strings.ToLower(k)] = strings.Trim(strings.ToLower(v), `"`)
+			}
+		}
+
+		var domain string
+		switch method {
+		case "dmarc":
+			domain = props["header.from"]
+			if domain == "" {
+				domain = fromDomain
+			}
+		case "dkim":
+			domain = props["header.d"]
+			if domain == "" {
+				_, domain, _ = strings.Cut(props["header.i"], "@")
+			}
+		case "spf":
+			domain = props["smtp.mailfrom"]
+			if _, d, ok := strings.Cut(domain, "@"); ok {
+				domain = d
+			}
+		}
+		if domain != "" && aligned(domain, fromDomain) {
+			return true
+		}
+	}
+	return false
+}
+
+// aligned reports whether two domains are in relaxed DMARC alignment: equal,
+// or one a subdomain of the other
+func aligned(a, b string) bool {
+	a, b = strings.TrimSuffix(a, "."), strings.TrimSuffix(b, ".")
+	return a == b || strings.HasSuffix(a, "."+b) || strings.HasSuffix(b, "."+a)
+}
+
+// stripComments removes the parenthesised comments of a header value
+func stripComments(value string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range value {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,115 @@
+// Package pow issues and checks hashcash-style proof-of-work challenges.
+//
+// A challenge token carries a random nonce, its expiry and difficulty, and an
+// HMAC so the server does not need to remember what it issued. A solution is
+// any string s for which SHA-256(token + ":" + s) starts with at least
+// difficulty zero bits.
+package pow
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxDifficulty is the largest difficulty a token may carry
+const MaxDifficulty = 32
+
+var (
+	ErrMalformed        = errors.New("malformed challenge")
+	ErrExpired          = errors.New("challenge expired")
+	ErrInsufficientWork = errors.New("solution does not meet difficulty")
+)
+
+// Challenge is an issued proof-of-work puzzle
+type Challenge struct {
+	Token      string
+	Difficulty int
+	ExpiresAt  time.Time
+}
+
+// Issue creates a signed challenge that expires after ttl
+func Issue(secret []byte, difficulty int, ttl time.Duration, now time.Time) (Challenge, error) {
+	if difficulty < 0 || difficulty > MaxDifficulty {
+		return Challenge{}, ErrMalformed
+	}
+
+	// payload: 16-byte nonce | 8-byte expiry (unix seconds) | 1-byte difficulty
+	payload := make([]byte, 25)
+	if _, err := rand.Read(payload[:16]); err != nil {
+		return Challenge{}, err
+	}
+	expiresAt := now.Add(ttl).Truncate(time.Second)
+	binary.BigEndian.PutUint64(payload[16:24], uint64(expiresAt.Unix()))
+	payload[24] = byte(difficulty)
+
+	token := base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(sign(secret, payload))
+
+	return Challenge{Token: token, Difficulty: difficulty, ExpiresAt: expiresAt}, nil
+}
+
+// Verify checks the token signature and expiry and that solution does the
+// required work. It returns the challenge nonce so callers can reject replays.
+func Verify(secret []byte, token, solution string, now time.Time) (string, error) {
+	encoded, mac, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", ErrMalformed
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(payload) != 25 {
+		return "", ErrMalformed
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(mac)
+	if err != nil || !hmac.Equal(sig, sign(secret, payload)) {
+		return "", ErrMalformed
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[16:24])), 0)
+	if now.After(expiresAt) {
+		return "", ErrExpired
+	}
+
+	if LeadingZeroBits(token, solution) < int(payload[24]) {
+		return "", ErrInsufficientWork
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload[:16]), nil
+}
+
+// Solve finds a solution for token by brute force. Clients do this work; the
+// server uses it only in tooling.
+func Solve(token string, difficulty int) string {
+	for i := 0; ; i++ {
+		s := strconv.Itoa(i)
+		if LeadingZeroBits(token, s) >= difficulty {
+			return s
+		}
+	}
+}
+
+// LeadingZeroBits counts the leading zero bits of SHA-256(token + ":" + solution)
+func LeadingZeroBits(token, solution string) int {
+	sum := sha256.Sum256([]byte(token + ":" + solution))
+	n := 0
+	for _, b := range sum {
+		if b != 0 {
+			return n + bits.LeadingZeros8(b)
+		}
+		n += 8
+	}
+	return n
+}
+
+func sign(secret, payload []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write(payload)
+	return h.Sum(nil)
+}
@@ -0,0 +1,143 @@
+package pow
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIssue(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Date(2026, 1, 8, 14, 30, 0, 500, time.UTC)
+
+	tests := []struct {
+		name       string
+		difficulty int
+		wantErr    error
+	}{
+		{"no work", 0, nil},
+		{"typical", 16, nil},
+		{"maximum", MaxDifficulty, nil},
+		{"negative", -1, ErrMalformed},
+		{"too hard", MaxDifficulty + 1, ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			challenge, err := Issue(secret, tt.difficulty, time.Minute, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Issue() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if challenge.Difficulty != tt.difficulty {
+				t.Errorf("Difficulty = %d, want %d", challenge.Difficulty, tt.difficulty)
+			}
+			if want := now.Add(time.Minute).Truncate(time.Second); !challenge.ExpiresAt.Equal(want) {
+				t.Errorf("ExpiresAt = %v, want %v", challenge.ExpiresAt, want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Date(2026, 1, 8, 14, 30, 0, 0, time.UTC)
+	const difficulty = 8
+
+	challenge, err := Issue(secret, difficulty, time.Minute, now)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	solution := Solve(challenge.Token, difficulty)
+
+	// A solution that falls short of the difficulty
+	weak := ""
+	for i := 0; weak == ""; i++ {
+		if s := strconv.Itoa(i); LeadingZeroBits(challenge.Token, s) < difficulty {
+			weak = s
+		}
+	}
+
+	encoded, mac, _ := strings.Cut(challenge.Token, ".")
+	tampered := []byte(encoded)
+	tampered[0] ^= 1
+
+	tests := []struct {
+		name     string
+		secret   []byte
+		token    string
+		solution string
+		now      time.Time
+		wantErr  error
+	}{
+		{"valid", secret, challenge.Token, solution, now, nil},
+		{"valid until expiry", secret, challenge.Token, solution, challenge.ExpiresAt, nil},
+		{"expired", secret, challenge.Token, solution, challenge.ExpiresAt.Add(time.Second), ErrExpired},
+		{"insufficient work", secret, challenge.Token, weak, now, ErrInsufficientWork},
+		{"wrong secret", []byte("other-secret"), challenge.Token, solution, now, ErrMalformed},
+		{"tampered payload", secret, string(tampered) + "." + mac, solution, now, ErrMalformed},
+		{"missing signature", secret, encoded, solution, now, ErrMalformed},
+		{"not base64", secret, "!!!." + mac, solution, now, ErrMalformed},
+		{"empty", secret, "", solution, now, ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nonce, err := Verify(tt.secret, tt.token, tt.solution, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && nonce == "" {
+				t.Error("Verify() returned an empty nonce")
+			}
+		})
+	}
+}
+
+func TestVerifyNonce(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Date(2026, 1, 8, 14, 30, 0, 0, time.UTC)
+
+	first, _ := Issue(secret, 0, time.Minute, now)
+	second, _ := Issue(secret, 0, time.Minute, now)
+
+	a, err := Verify(secret, first.Token, "x", now)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	again, _ := Verify(secret, first.Token, "y", now)
+	b, _ := Verify(secret, second.Token, "x", now)
+
+	if a != again {
+		t.Errorf("nonce changed between solutions of one challenge: %q, %q", a, again)
+	}
+	if a == b {
+		t.Errorf("two challenges share nonce %q", a)
+	}
+}
+
+func TestLeadingZeroBits(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty int
+	}{
+		{"zero", 0},
+		{"one", 1},
+		{"byte", 8},
+		{"past a byte", 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := "token-" + tt.name
+			solution := Solve(token, tt.difficulty)
+			if got := LeadingZeroBits(token, solution); got < tt.difficulty {
+				t.Errorf("LeadingZeroBits(Solve()) = %d, want at least %d", got, tt.difficulty)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Package search turns free-text report queries into terms the database can match.
+//
+// Postgres' built-in text search splits on whitespace and punctuation, which
+// works for English but leaves unsegmented Chinese as one long token. Words in
+// scripts that use spaces go to tsvector matching; runs of Han, kana or Hangul
+// are cut into overlapping bigrams and matched as substrings instead, as
+// simhash does for duplicate detection. Both split text with textseg.
+package search
+
+import (
+	"strings"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/textseg"
+)
+
+// MaxTerms caps how many words and bigrams a single query may produce
+const MaxTerms = 16
+
+// Query is a parsed search string
+type Query struct {
+	Words   []string // lower-cased words for tsvector matching
+	Bigrams []string // CJK character pairs for substring matching
+}
+
+// Empty reports whether the query has nothing to match
+func (q Query) Empty() bool {
+	return len(q.Words) == 0 && len(q.Bigrams) == 0
+}
+
+// TSQuery renders the words as a prefix-matching tsquery, e.g. "atm:* & card:*"
+func (q Query) TSQuery() string {
+	parts := make([]string, len(q.Words))
+	for i, w := range q.Words {
+		parts[i] = w + ":*"
+	}
+	return strings.Join(parts, " & ")
+}
+
+// Parse splits text into words and CJK bigrams. Duplicates are dropped and
+// the result is capped at MaxTerms.
+func Parse(text string) Query {
+	var q Query
+	seen := make(map[string]bool)
+	add := func(list *[]string, term string) {
+		if seen[term] || len(q.Words)+len(q.Bigrams) >= MaxTerms {
+			return
+		}
+		seen[term] = true
+		*list = append(*list, term)
+	}
+
+	var run []string
+	flushRun := func() {
+		for _, b := range textseg.Bigrams(run) {
+			add(&q.Bigrams, b)
+		}
+		run = run[:0]
+	}
+
+	for _, t := range textseg.Split(text) {
+		if t.CJK {
+			run = append(run, t.Text)
+			continue
+		}
+		flushRun()
+		add(&q.Words, t.Text)
+	}
+	flushRun()
+
+	return q
+}
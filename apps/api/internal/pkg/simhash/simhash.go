@@ -0,0 +1,97 @@
+package simhash
+
+import (
+	"hash/fnv"
+	"math/bits"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/textseg"
+)
+
+// Fingerprint computes a 64-bit SimHash over the shingles of text.
+// Texts that share most of their shingles produce fingerprints with a small
+// Hamming distance.
+func Fingerprint(text string) uint64 {
+	var weights [64]int
+	for _, shingle := range Shingles(text) {
+		h := hash64(shingle)
+		for i := 0; i < 64; i++ {
+			if h&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for i := 0; i < 64; i++ {
+		if weights[i] > 0 {
+			fingerprint |= 1 << uint(i)
+		}
+	}
+	return fingerprint
+}
+
+// Similarity returns the share of matching bits between two fingerprints (0..1)
+func Similarity(a, b uint64) float64 {
+	return 1 - float64(bits.OnesCount64(a^b))/64
+}
+
+// Shingles splits text into overlapping features. Latin words contribute
+// themselves and word bigrams; runs of CJK characters, which have no word
+// boundaries, contribute character bigrams.
+func Shingles(text string) []string {
+	tokens := textseg.Split(text)
+	shingles := make([]string, 0, len(tokens)*2)
+
+	for i, t := range tokens {
+		if !t.CJK {
+			shingles = append(shingles, t.Text)
+		}
+		if i+1 < len(tokens) {
+			next := tokens[i+1]
+			if t.CJK && next.CJK {
+				shingles = append(shingles, t.Text+next.Text)
+			} else {
+				shingles = append(shingles, t.Text+" "+next.Text)
+			}
+		} else if t.CJK && (i == 0 || !tokens[i-1].CJK) {
+			// A lone CJK character still carries meaning
+			shingles = append(shingles, t.Text)
+		}
+	}
+
+	return shingles
+}
+
+// Jaccard returns the Jaccard similarity of the shingle sets of two texts
+func Jaccard(a, b string) float64 {
+	setA := toSet(Shingles(a))
+	setB := toSet(Shingles(b))
+	if len(setA) == 0 || len(setB) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for s := range setA {
+		if setB[s] {
+			intersection++
+		}
+	}
+	union := len(setA) + len(setB) - intersection
+	return float64(intersection) / float64(union)
+}
+
+func hash64(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+	return set
+}
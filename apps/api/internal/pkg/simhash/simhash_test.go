@@ -0,0 +1,103 @@
+package simhash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShingles(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"empty", "", []string{}},
+		{"punctuation only", "?!, ...", []string{}},
+		{"latin words and bigrams", "Hello, World!", []string{"hello", "hello world", "world"}},
+		{"digits are word characters", "Call 110", []string{"call", "call 110", "110"}},
+		{"cjk character bigrams", "詐騙電話", []string{"詐騙", "騙電", "電話"}},
+		{"lone cjk character", "騙", []string{"騙"}},
+		{"mixed scripts", "call 騙子", []string{"call", "call 騙", "騙子"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Shingles(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Shingles(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b uint64
+		want float64
+	}{
+		{"identical", 0xdeadbeef, 0xdeadbeef, 1},
+		{"all bits differ", 0, ^uint64(0), 0},
+		{"eight bits differ", 0, 0xff, 0.875},
+		{"symmetric", 0xff, 0, 0.875},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Similarity(tt.a, tt.b); got != tt.want {
+				t.Errorf("Similarity(%#x, %#x) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"identical", "fake bank call", "fake bank call", 1},
+		{"case and punctuation ignored", "Fake bank call!", "fake, BANK call", 1},
+		{"disjoint", "fake bank", "parcel delivery", 0},
+		{"one word differs", "a b", "a c", 0.2},
+		{"empty text", "", "fake bank", 0},
+		{"both empty", "", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Jaccard(tt.a, tt.b); got != tt.want {
+				t.Errorf("Jaccard(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	base := "Someone called pretending to be from the bank and asked for my card number and the code sent by text"
+
+	tests := []struct {
+		name    string
+		a, b    string
+		minimum float64 // lowest acceptable similarity
+		maximum float64 // highest acceptable similarity
+	}{
+		{"identical text", base, base, 1, 1},
+		{"formatting ignored", base, "SOMEONE called, pretending to be from the bank; and asked for my card number and the code sent by text.", 1, 1},
+		{"one word changed", base, "Someone called pretending to be from the bank and asked for my card number and the code sent by email", 0.8, 1},
+		{"unrelated text", base, "Flooded road near the river bridge, two cars stuck and the water is still rising fast", 0, 0.8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Similarity(Fingerprint(tt.a), Fingerprint(tt.b))
+			if got < tt.minimum || got > tt.maximum {
+				t.Errorf("similarity = %v, want between %v and %v", got, tt.minimum, tt.maximum)
+			}
+		})
+	}
+
+	if got := Fingerprint(""); got != 0 {
+		t.Errorf("Fingerprint(\"\") = %#x, want 0", got)
+	}
+}
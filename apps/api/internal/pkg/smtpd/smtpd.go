@@ -0,0 +1,356 @@
+// Package smtpd is a minimal SMTP server (RFC 5321) for receiving mail
+// addressed to the service. It does not relay: accepted messages are handed
+// to a Handler, and recipients can be restricted to the service's own
+// addresses.
+//
+// Only the commands a sending MTA needs are implemented: HELO, EHLO, MAIL,
+// RCPT, DATA, RSET, NOOP, VRFY and QUIT. There is no STARTTLS or AUTH;
+// run it behind an MTA or on a private network.
+package smtpd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Session limits
+const (
+	maxRecipients  = 50
+	maxConnections = 32
+	maxErrors      = 10
+	maxLineLength  = 2048
+)
+
+// Envelope is the SMTP envelope of a received message
+type Envelope struct {
+	From       string // reverse path; empty for bounces
+	To         []string
+	RemoteAddr net.Addr
+}
+
+// Handler receives each accepted message. Returning an *Error answers the
+// client with its code; any other error is reported as a temporary failure
+// so the sender retries.
+type Handler func(ctx context.Context, env Envelope, data []byte) error
+
+// Error is an SMTP reply returned by a Handler
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return strconv.Itoa(e.Code) + " " + e.Message
+}
+
+// Server accepts mail for a Handler
+type Server struct {
+	Hostname string // name announced in the greeting
+	MaxSize  int64  // largest message accepted, in bytes
+	Timeout  time.Duration
+	// Recipient reports whether mail for an address is accepted; nil accepts all
+	Recipient func(addr string) bool
+	Handler   Handler
+}
+
+// ListenAndServe accepts connections on addr until ctx is cancelled
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, ln)
+}
+
+// Serve accepts connections on ln until ctx is cancelled. It waits for open
+// sessions to finish before returning.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	slots := make(chan struct{}, maxConnections)
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			return err
+		}
+
+		select {
+		case slots <- struct{}{}:
+		default:
+			fmt.Fprintf(conn, "421 4.3.2 %s too busy, try again later\r\n", s.Hostname)
+			conn.Close()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			s.serve(ctx, conn)
+		}()
+	}
+}
+
+// session is the state of one SMTP conversation
+type session struct {
+	server *Server
+	conn   net.Conn
+	text   *textproto.Conn
+	helo   bool
+	env    *Envelope
+	errors int
+}
+
+// serve runs one SMTP conversation
+func (s *Server) serve(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	sess := &session{
+		server: s,
+		conn:   conn,
+		text:   textproto.NewConn(conn),
+	}
+	sess.reply(220, s.Hostname+" ESMTP ready")
+
+	for sess.errors < maxErrors {
+		if ctx.Err() != nil {
+			sess.reply(421, "4.3.2 "+s.Hostname+" shutting down")
+			return
+		}
+		sess.deadline()
+		line, err := sess.readLine()
+		if errors.Is(err, errLineTooLong) {
+			sess.fail(500, "5.5.2 line too long")
+			continue
+		}
+		if err != nil {
+			return
+		}
+
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			sess.helo = true
+			sess.env = nil
+			sess.reply(250, s.Hostname)
+		case "EHLO":
+			sess.helo = true
+			sess.env = nil
+			sess.reply(250, s.Hostname, "8BITMIME", "SIZE "+strconv.FormatInt(s.MaxSize, 10))
+		case "MAIL":
+			sess.mail(arg)
+		case "RCPT":
+			sess.rcpt(arg)
+		case "DATA":
+			if !sess.data(ctx) {
+				return
+			}
+		case "RSET":
+			sess.env = nil
+			sess.reply(250, "2.0.0 OK")
+		case "NOOP":
+			sess.reply(250, "2.0.0 OK")
+		case "VRFY":
+			sess.reply(252, "2.1.5 cannot verify, send some mail")
+		case "QUIT":
+			sess.reply(221, "2.0.0 bye")
+			return
+		default:
+			sess.fail(502, "5.5.2 command not implemented")
+		}
+	}
+	sess.reply(421, "4.7.0 too many errors")
+}
+
+// errLineTooLong is returned by readLine for a line over maxLineLength
+var errLineTooLong = errors.New("line too long")
+
+// readLine reads a command line without its line ending. The line is read
+// in buffer-sized slices and at most maxLineLength bytes are kept, so an
+// endless line cannot exhaust memory; the rest of an overlong line is
+// discarded and errLineTooLong returned.
+func (sess *session) readLine() (string, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, err := sess.text.R.ReadSlice('\n')
+		if !tooLong {
+			if len(line)+len(chunk) > maxLineLength+2 {
+				tooLong, line = true, nil
+			} else {
+				line = append(line, chunk...)
+			}
+		}
+		if errors.Is(err, bufio.ErrBufferFull) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		break
+	}
+	if tooLong {
+		return "", errLineTooLong
+	}
+	return strings.TrimRight(string(line), "\r\n"), nil
+}
+
+// mail starts a transaction
+func (sess *session) mail(arg string) {
+	if !sess.helo {
+		sess.fail(503, "5.5.1 send HELO or EHLO first")
+		return
+	}
+	if sess.env != nil {
+		sess.fail(503, "5.5.1 nested MAIL command")
+		return
+	}
+	from, params, ok := path(arg, "FROM:")
+	if !ok {
+		sess.fail(501, "5.5.4 syntax: MAIL FROM:<address>")
+		return
+	}
+	for _, p := range params {
+		key, value, _ := strings.Cut(p, "=")
+		if strings.EqualFold(key, "SIZE") {
+			if size, err := strconv.ParseInt(value, 10, 64); err == nil && size > sess.server.MaxSize {
+				sess.fail(552, "5.3.4 message too big")
+				return
+			}
+		}
+	}
+	sess.env = &Envelope{From: from, RemoteAddr: sess.conn.RemoteAddr()}
+	sess.reply(250, "2.1.0 OK")
+}
+
+// rcpt adds a recipient
+func (sess *session) rcpt(arg string) {
+	if sess.env == nil {
+		sess.fail(503, "5.5.1 send MAIL first")
+		return
+	}
+	to, _, ok := path(arg, "TO:")
+	if !ok || to == "" {
+		sess.fail(501, "5.5.4 syntax: RCPT TO:<address>")
+		return
+	}
+	if len(sess.env.To) >= maxRecipients {
+		sess.reply(452, "4.5.3 too many recipients")
+		return
+	}
+	if sess.server.Recipient != nil && !sess.server.Recipient(to) {
+		sess.fail(550, "5.1.1 no such mailbox")
+		return
+	}
+	sess.env.To = append(sess.env.To, to)
+	sess.reply(250, "2.1.5 OK")
+}
+
+// data receives the message and hands it to the handler. It returns false
+// when the connection cannot continue.
+func (sess *session) data(ctx context.Context) bool {
+	if sess.env == nil || len(sess.env.To) == 0 {
+		sess.fail(503, "5.5.1 send RCPT first")
+		return true
+	}
+	sess.reply(354, "end data with <CR><LF>.<CR><LF>")
+
+	sess.deadline()
+	dot := sess.text.DotReader()
+	data, err := io.ReadAll(io.LimitReader(dot, sess.server.MaxSize+1))
+	if err != nil {
+		return false
+	}
+	env := *sess.env
+	sess.env = nil
+	if int64(len(data)) > sess.server.MaxSize {
+		// Drain the rest of the message before answering
+		if _, err := io.Copy(io.Discard, dot); err != nil {
+			return false
+		}
+		sess.reply(552, "5.3.4 message too big")
+		return true
+	}
+
+	err = sess.server.Handler(ctx, env, data)
+	var smtpErr *Error
+	switch {
+	case err == nil:
+		sess.reply(250, "2.0.0 message accepted")
+	case errors.As(err, &smtpErr):
+		sess.reply(smtpErr.Code, smtpErr.Message)
+	default:
+		log.Printf("smtpd: handler failed: %v", err)
+		sess.reply(451, "4.3.0 temporary failure, try again later")
+	}
+	return true
+}
+
+// reply writes a (possibly multiline) reply
+func (sess *session) reply(code int, lines ...string) {
+	w := bufio.NewWriter(sess.conn)
+	for i, line := range lines {
+		sep := " "
+		if i < len(lines)-1 {
+			sep = "-"
+		}
+		fmt.Fprintf(w, "%d%s%s\r\n", code, sep, line)
+	}
+	w.Flush()
+}
+
+// fail writes an error reply and counts it against the session
+func (sess *session) fail(code int, message string) {
+	sess.errors++
+	sess.reply(code, message)
+}
+
+// deadline gives the client Timeout for its next command or message
+func (sess *session) deadline() {
+	if sess.server.Timeout > 0 {
+		sess.conn.SetDeadline(time.Now().Add(sess.server.Timeout))
+	}
+}
+
+// path parses "FROM:<addr> PARAM=..." or "TO:<addr>" arguments
+func path(arg, prefix string) (string, []string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+	fields := strings.Fields(strings.TrimSpace(arg[len(prefix):]))
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+	addr := fields[0]
+	if !strings.HasPrefix(addr, "<") || !strings.HasSuffix(addr, ">") {
+		return "", nil, false
+	}
+	addr = addr[1 : len(addr)-1]
+	// Drop a source route (@a,@b:user@host)
+	if i := strings.LastIndex(addr, ":"); strings.HasPrefix(addr, "@") && i >= 0 {
+		addr = addr[i+1:]
+	}
+	return addr, fields[1:], true
+}
@@ -0,0 +1,255 @@
+// Package stix builds STIX 2.1 bundles of scam indicators for anti-fraud
+// partners.
+//
+// Object IDs are stable: the same indicator always gets the same STIX ID, so
+// a consumer pulling repeatedly replaces objects by (id, modified) instead of
+// piling up copies. Cyber observables use the STIX deterministic ID scheme
+// (UUIDv5 over their ID-contributing properties); SDOs and relationships use
+// UUIDv5 in a namespace of our own.
+//
+// Phone numbers, bank accounts and crypto wallets have no STIX Cyber
+// Observable type and are exported as custom x-phone-number, x-bank-account
+// and x-crypto-wallet observables; LINE IDs are user-account objects with
+// account_type "line".
+package stix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SpecVersion is the STIX version of every object built here
+const SpecVersion = "2.1"
+
+// scoNamespace is the namespace the STIX 2.1 specification (section 2.9)
+// fixes for deterministic Cyber Observable IDs
+var scoNamespace = uuid.MustParse("00abedb4-aa42-466c-9c01-fed23315a9b7")
+
+// namespace derives stable IDs for our own SDOs and relationships
+var namespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://github.com/dennislee928/rotary-global-grant-safety-resilience/stix"))
+
+// TLP marking definitions predefined by STIX 2.1 (section 7.2.1.4)
+var tlpMarkings = map[string]string{
+	"white": "marking-definition--613f2e26-407d-48c7-9eca-b8e91df99dc9",
+	"green": "marking-definition--34098fce-860f-48ae-8e50-ebd3cc5e41da",
+	"amber": "marking-definition--f88d31f6-486f-44da-b317-01333bde0b82",
+	"red":   "marking-definition--5e57c739-391a-4eb3-b6be-7d15ca92d5ed",
+}
+
+// tlpCreated is the creation time STIX gives the predefined TLP markings
+var tlpCreated = time.Date(2017, 1, 20, 0, 0, 0, 0, time.UTC)
+
+// ValidTLP reports whether level is a TLP level this package can mark with
+func ValidTLP(level string) bool {
+	_, ok := tlpMarkings[level]
+	return ok
+}
+
+// Object is a STIX object; properties are kept in a map so SDOs, SCOs and
+// custom observables share one type
+type Object map[string]interface{}
+
+// Bundle is a STIX 2.1 bundle
+type Bundle struct {
+	Type    string   `json:"type"`
+	ID      string   `json:"id"`
+	Objects []Object `json:"objects"`
+}
+
+// Indicator is one scam indicator to export
+type Indicator struct {
+	Type        string // indicator.Type* value
+	Value       string
+	ReportCount int
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+	Created     time.Time // when the indicator was first stored; never changes
+	Modified    time.Time
+	Revoked     bool // no longer believed malicious
+}
+
+// Builder accumulates indicators into a bundle
+type Builder struct {
+	identity Object
+	marking  string
+	objects  []Object
+}
+
+// NewBuilder starts a bundle whose objects are created by the named identity
+// and marked with the given TLP level (white, green, amber or red)
+func NewBuilder(identityName, tlp string) (*Builder, error) {
+	marking, ok := tlpMarkings[tlp]
+	if !ok {
+		return nil, fmt.Errorf("unknown TLP level %q", tlp)
+	}
+
+	identity := Object{
+		"type":           "identity",
+		"spec_version":   SpecVersion,
+		"id":             "identity--" + uuid.NewSHA1(namespace, []byte("identity:"+identityName)).String(),
+		"created":        timestamp(tlpCreated),
+		"modified":       timestamp(tlpCreated),
+		"name":           identityName,
+		"identity_class": "organization",
+	}
+
+	return &Builder{
+		identity: identity,
+		marking:  marking,
+		objects: []Object{
+			identity,
+			{
+				"type":            "marking-definition",
+				"spec_version":    SpecVersion,
+				"id":              marking,
+				"created":         timestamp(tlpCreated),
+				"definition_type": "tlp",
+				"name":            "TLP:" + strings.ToUpper(tlp),
+				"definition":      map[string]string{"tlp": tlp},
+			},
+		},
+	}, nil
+}
+
+// Add appends an indicator, the observed-data that counts its reports, the
+// observable they refer to and the relationship between them. Indicators of
+// unknown type are skipped and reported false.
+func (b *Builder) Add(ind Indicator) bool {
+	sco, pattern, ok := observable(ind.Type, ind.Value)
+	if !ok {
+		return false
+	}
+
+	key := ind.Type + ":" + ind.Value
+	indicatorID := "indicator--" + uuid.NewSHA1(namespace, []byte("indicator:"+key)).String()
+	observedID := "observed-data--" + uuid.NewSHA1(namespace, []byte("observed-data:"+key)).String()
+	relationshipID := "relationship--" + uuid.NewSHA1(namespace, []byte("based-on:"+key)).String()
+	common := func(o Object) Object {
+		o["spec_version"] = SpecVersion
+		o["created_by_ref"] = b.identity["id"]
+		o["created"] = timestamp(ind.Created)
+		o["modified"] = timestamp(ind.Modified)
+		o["object_marking_refs"] = []string{b.marking}
+		return o
+	}
+
+	indicator := common(Object{
+		"type":            "indicator",
+		"id":              indicatorID,
+		"name":            fmt.Sprintf("Scam %s: %s", strings.ReplaceAll(ind.Type, "_", " "), ind.Value),
+		"indicator_types": []string{"malicious-activity"},
+		"pattern":         pattern,
+		"pattern_type":    "stix",
+		"valid_from":      timestamp(ind.FirstSeenAt),
+	})
+	if ind.Revoked {
+		indicator["revoked"] = true
+	}
+
+	observed := common(Object{
+		"type":            "observed-data",
+		"id":              observedID,
+		"first_observed":  timestamp(ind.FirstSeenAt),
+		"last_observed":   timestamp(ind.LastSeenAt),
+		"number_observed": max(ind.ReportCount, 1),
+		"object_refs":     []string{sco["id"].(string)},
+	})
+
+	relationship := common(Object{
+		"type":              "relationship",
+		"id":                relationshipID,
+		"relationship_type": "based-on",
+		"source_ref":        indicatorID,
+		"target_ref":        observedID,
+	})
+
+	b.objects = append(b.objects, sco, observed, indicator, relationship)
+	return true
+}
+
+// Bundle returns the bundle built so far. Bundles are transport envelopes,
+// so each gets a fresh ID.
+func (b *Builder) Bundle() *Bundle {
+	return &Bundle{
+		Type:    "bundle",
+		ID:      "bundle--" + uuid.NewString(),
+		Objects: b.objects,
+	}
+}
+
+// observable returns the Cyber Observable for an indicator value and the
+// STIX pattern that matches it
+func observable(typ, value string) (Object, string, bool) {
+	var sco Object
+	var pattern string
+	switch typ {
+	case "url":
+		sco = Object{"type": "url", "value": value}
+		pattern = comparison("url:value", value)
+	case "domain":
+		sco = Object{"type": "domain-name", "value": value}
+		pattern = comparison("domain-name:value", value)
+	case "email":
+		sco = Object{"type": "email-addr", "value": value}
+		pattern = comparison("email-addr:value", value)
+	case "phone":
+		sco = Object{"type": "x-phone-number", "value": value}
+		pattern = comparison("x-phone-number:value", value)
+	case "bank_account":
+		sco = Object{"type": "x-bank-account", "value": value}
+		pattern = comparison("x-bank-account:value", value)
+	case "crypto_wallet":
+		sco = Object{"type": "x-crypto-wallet", "value": value}
+		pattern = comparison("x-crypto-wallet:value", value)
+	case "line_id":
+		sco = Object{"type": "user-account", "account_type": "line", "account_login": value}
+		pattern = "[user-account:account_type = 'line' AND user-account:account_login = " + quote(value) + "]"
+	default:
+		return nil, "", false
+	}
+
+	// Deterministic ID over the ID-contributing properties: value for url,
+	// domain-name, email-addr and the custom types; account_type and account_login
+	// (with user_id, absent here) for user-account
+	contributing := Object{}
+	for k, v := range sco {
+		if k != "type" {
+			contributing[k] = v
+		}
+	}
+	sco["id"] = sco["type"].(string) + "--" + uuid.NewSHA1(scoNamespace, canonical(contributing)).String()
+	sco["spec_version"] = SpecVersion
+	return sco, pattern, true
+}
+
+// comparison renders a single-comparison pattern
+func comparison(path, value string) string {
+	return "[" + path + " = " + quote(value) + "]"
+}
+
+// quote renders a STIX pattern string literal, escaping \ and '
+func quote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// canonical serialises flat string properties as JSON with sorted keys and
+// without HTML escaping, which is what RFC 8785 canonicalisation gives for
+// such objects
+func canonical(o Object) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(map[string]interface{}(o)) // map keys are sorted
+	return bytes.TrimRight(buf.Bytes(), "\n")
+}
+
+// timestamp formats a time the way STIX requires: UTC with millisecond
+// precision
+func timestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
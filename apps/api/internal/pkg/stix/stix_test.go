@@ -0,0 +1,187 @@
+package stix
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewBuilder(t *testing.T) {
+	tests := []struct {
+		tlp     string
+		marking string
+		wantErr bool
+	}{
+		{"white", tlpMarkings["white"], false},
+		{"green", tlpMarkings["green"], false},
+		{"amber", tlpMarkings["amber"], false},
+		{"red", tlpMarkings["red"], false},
+		{"GREEN", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tlp, func(t *testing.T) {
+			b, err := NewBuilder("The Hive", tt.tlp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewBuilder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			objects := b.Bundle().Objects
+			if len(objects) != 2 || objects[0]["type"] != "identity" || objects[1]["id"] != tt.marking {
+				t.Errorf("bundle starts with %v, want the identity and marking %s", objects, tt.marking)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     string
+		value   string
+		scoType string
+		pattern string
+	}{
+		{"url", "url", "https://evil.com/login", "url", "[url:value = 'https://evil.com/login']"},
+		{"domain", "domain", "evil.com", "domain-name", "[domain-name:value = 'evil.com']"},
+		{"email", "email", "scam@evil.com", "email-addr", "[email-addr:value = 'scam@evil.com']"},
+		{"phone", "phone", "+886912345678", "x-phone-number", "[x-phone-number:value = '+886912345678']"},
+		{"bank account", "bank_account", "822-123456789012", "x-bank-account", "[x-bank-account:value = '822-123456789012']"},
+		{"crypto wallet", "crypto_wallet", "bc1qexample", "x-crypto-wallet", "[x-crypto-wallet:value = 'bc1qexample']"},
+		{"line id", "line_id", "@scam123", "user-account", "[user-account:account_type = 'line' AND user-account:account_login = '@scam123']"},
+		{"quotes escaped", "url", `http://evil.com/a'b\c`, "url", `[url:value = 'http://evil.com/a\'b\\c']`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := NewBuilder("The Hive", "green")
+			if !b.Add(Indicator{Type: tt.typ, Value: tt.value, ReportCount: 3}) {
+				t.Fatalf("Add() = false")
+			}
+
+			objects := b.Bundle().Objects[2:]
+			if len(objects) != 4 {
+				t.Fatalf("Add() appended %d objects, want 4", len(objects))
+			}
+			sco, observed, indicator, relationship := objects[0], objects[1], objects[2], objects[3]
+
+			if sco["type"] != tt.scoType || !strings.HasPrefix(sco["id"].(string), tt.scoType+"--") {
+				t.Errorf("observable = %v, want type %s", sco, tt.scoType)
+			}
+			if indicator["pattern"] != tt.pattern {
+				t.Errorf("pattern = %v, want %v", indicator["pattern"], tt.pattern)
+			}
+			if observed["number_observed"] != 3 {
+				t.Errorf("number_observed = %v, want 3", observed["number_observed"])
+			}
+			if refs := observed["object_refs"].([]string); len(refs) != 1 || refs[0] != sco["id"] {
+				t.Errorf("object_refs = %v, want [%v]", refs, sco["id"])
+			}
+			if relationship["source_ref"] != indicator["id"] || relationship["target_ref"] != observed["id"] {
+				t.Errorf("relationship %v does not link %v to %v", relationship, indicator["id"], observed["id"])
+			}
+		})
+	}
+}
+
+func TestAddUnknownType(t *testing.T) {
+	b, _ := NewBuilder("The Hive", "green")
+	if b.Add(Indicator{Type: "fax", Value: "12345678"}) {
+		t.Error("Add() = true for an unknown type")
+	}
+	if n := len(b.Bundle().Objects); n != 2 {
+		t.Errorf("bundle has %d objects, want 2", n)
+	}
+}
+
+func TestAddStableIDs(t *testing.T) {
+	created := time.Date(2026, 1, 8, 14, 30, 0, 0, time.UTC)
+	first := Indicator{
+		Type:        "domain",
+		Value:       "evil.com",
+		ReportCount: 1,
+		FirstSeenAt: created,
+		LastSeenAt:  created,
+		Created:     created,
+		Modified:    created,
+	}
+	// Later export: an older report was recorded, which moves first seen
+	// earlier, and the indicator was since revoked
+	later := first
+	later.ReportCount = 5
+	later.FirstSeenAt = created.Add(-48 * time.Hour)
+	later.LastSeenAt = created.Add(24 * time.Hour)
+	later.Modified = created.Add(24 * time.Hour)
+	later.Revoked = true
+
+	tests := []struct {
+		name     string
+		ind      Indicator
+		modified string
+		revoked  bool
+	}{
+		{"first export", first, "2026-01-08T14:30:00.000Z", false},
+		{"later export", later, "2026-01-09T14:30:00.000Z", true},
+	}
+
+	var ids []string
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := NewBuilder("The Hive", "green")
+			b.Add(tt.ind)
+			objects := b.Bundle().Objects[2:]
+
+			var got []string
+			for _, o := range objects {
+				got = append(got, o["id"].(string))
+				if o["type"] == "url" || o["type"] == "domain-name" {
+					continue
+				}
+				if o["created"] != "2026-01-08T14:30:00.000Z" {
+					t.Errorf("%s created = %v, want the indicator's creation time", o["type"], o["created"])
+				}
+				if o["modified"] != tt.modified {
+					t.Errorf("%s modified = %v, want %v", o["type"], o["modified"], tt.modified)
+				}
+			}
+			if revoked, _ := objects[2]["revoked"].(bool); revoked != tt.revoked {
+				t.Errorf("revoked = %v, want %v", revoked, tt.revoked)
+			}
+
+			if ids == nil {
+				ids = got
+			} else if strings.Join(got, ",") != strings.Join(ids, ",") {
+				t.Errorf("IDs changed between exports: %v, then %v", ids, got)
+			}
+		})
+	}
+
+	b, _ := NewBuilder("The Hive", "green")
+	b.Add(Indicator{Type: "domain", Value: "other.com"})
+	if other := b.Bundle().Objects[2]["id"]; other == ids[0] {
+		t.Errorf("two values share observable ID %v", other)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"utc", time.Date(2026, 1, 8, 14, 30, 0, 0, time.UTC), "2026-01-08T14:30:00.000Z"},
+		{"milliseconds kept", time.Date(2026, 1, 8, 14, 30, 0, 123456789, time.UTC), "2026-01-08T14:30:00.123Z"},
+		{"converted to utc", time.Date(2026, 1, 8, 22, 30, 0, 0, time.FixedZone("CST", 8*60*60)), "2026-01-08T14:30:00.000Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timestamp(tt.t); got != tt.want {
+				t.Errorf("timestamp() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
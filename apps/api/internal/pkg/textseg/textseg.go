@@ -0,0 +1,66 @@
+// Package textseg splits free text into words and CJK characters. Text in
+// scripts written with spaces splits into words; Han, kana and Hangul are kept
+// one character per token, to be paired into bigrams by the caller, since
+// Chinese and Japanese have no spaces to split on.
+package textseg
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Token is a lower-cased word or a single CJK character
+type Token struct {
+	Text string
+	CJK  bool
+}
+
+// Split lower-cases text and splits it into words and CJK characters.
+// Letters and digits make up words; everything else separates them.
+func Split(text string) []Token {
+	var tokens []Token
+	var word strings.Builder
+
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, Token{Text: word.String()})
+			word.Reset()
+		}
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case IsCJK(r):
+			flush()
+			tokens = append(tokens, Token{Text: string(r), CJK: true})
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// Bigrams returns the overlapping character pairs of a CJK run. A single
+// character is returned as is.
+func Bigrams(run []string) []string {
+	if len(run) == 1 {
+		return []string{run[0]}
+	}
+	var out []string
+	for i := 0; i+1 < len(run); i++ {
+		out = append(out, run[i]+run[i+1])
+	}
+	return out
+}
+
+// IsCJK reports whether r belongs to a script written without spaces
+func IsCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,51 @@
+package textseg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Token
+	}{
+		{"empty", "", nil},
+		{"punctuation only", "?!, ...", nil},
+		{"words lower-cased", "Fake BANK, call 110!", []Token{{"fake", false}, {"bank", false}, {"call", false}, {"110", false}}},
+		{"han", "詐騙", []Token{{"詐", true}, {"騙", true}}},
+		{"kana", "サギ", []Token{{"サ", true}, {"ギ", true}}},
+		{"hangul", "사기 전화", []Token{{"사", true}, {"기", true}, {"전", true}, {"화", true}}},
+		{"mixed scripts", "ATM詐騙", []Token{{"atm", false}, {"詐", true}, {"騙", true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Split(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBigrams(t *testing.T) {
+	tests := []struct {
+		name string
+		run  []string
+		want []string
+	}{
+		{"empty", nil, nil},
+		{"single character", []string{"騙"}, []string{"騙"}},
+		{"pair", []string{"詐", "騙"}, []string{"詐騙"}},
+		{"overlapping", []string{"詐", "騙", "電", "話"}, []string{"詐騙", "騙電", "電話"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bigrams(tt.run); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Bigrams(%q) = %q, want %q", tt.run, got, tt.want)
+			}
+		})
+	}
+}
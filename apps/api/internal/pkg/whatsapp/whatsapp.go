@@ -0,0 +1,183 @@
+// Package whatsapp receives WhatsApp Cloud API webhooks and sends messages.
+//
+// Webhook bodies are signed with the app secret: X-Hub-Signature-256 is
+// "sha256=" followed by the hex HMAC-SHA256 of the raw body. Verify the
+// signature before parsing. Subscribing a webhook is confirmed with a GET
+// handshake; see VerifySubscription.
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SignatureHeader carries the webhook body signature
+const SignatureHeader = "X-Hub-Signature-256"
+
+// DefaultBaseURL is the Graph API endpoint, including its version
+const DefaultBaseURL = "https://graph.facebook.com/v19.0"
+
+// maxTextLength is the longest text message body the API accepts
+const maxTextLength = 4096
+
+// VerifySignature reports whether header is the app secret's signature of
+// body
+func VerifySignature(appSecret string, body []byte, header string) bool {
+	sig, ok := strings.CutPrefix(header, "sha256=")
+	if !ok || appSecret == "" {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// VerifySubscription checks the hub.mode and hub.verify_token parameters of
+// a subscription handshake. When it passes, hub.challenge is echoed back.
+func VerifySubscription(verifyToken, mode, token string) bool {
+	return verifyToken != "" && mode == "subscribe" &&
+		subtle.ConstantTimeCompare([]byte(verifyToken), []byte(token)) == 1
+}
+
+// Webhook is a webhook request body
+type Webhook struct {
+	Object string  `json:"object"` // whatsapp_business_account
+	Entry  []Entry `json:"entry"`
+}
+
+// Entry holds the changes for one business account
+type Entry struct {
+	ID      string   `json:"id"`
+	Changes []Change `json:"changes"`
+}
+
+// Change is one change notification
+type Change struct {
+	Field string `json:"field"` // messages
+	Value Value  `json:"value"`
+}
+
+// Value is the content of a messages change. Only the fields the intake
+// uses are decoded; delivery statuses are ignored.
+type Value struct {
+	MessagingProduct string    `json:"messaging_product"`
+	Metadata         Metadata  `json:"metadata"`
+	Messages         []Message `json:"messages,omitempty"`
+}
+
+// Metadata identifies the business phone number that received a change
+type Metadata struct {
+	DisplayPhoneNumber string `json:"display_phone_number"`
+	PhoneNumberID      string `json:"phone_number_id"`
+}
+
+// Message is an incoming message
+type Message struct {
+	From      string `json:"from"` // sender's WhatsApp ID
+	ID        string `json:"id"`
+	Timestamp string `json:"timestamp"` // Unix seconds
+	Type      string `json:"type"`      // text, image, audio, ...
+	Text      *Text  `json:"text,omitempty"`
+}
+
+// Text is the body of a text message
+type Text struct {
+	Body string `json:"body"`
+}
+
+// Messages returns the incoming messages of a webhook for the given phone
+// number ID, or for any number when phoneNumberID is empty
+func (w *Webhook) Messages(phoneNumberID string) []Message {
+	var messages []Message
+	for _, entry := range w.Entry {
+		for _, change := range entry.Changes {
+			if change.Field != "messages" {
+				continue
+			}
+			if phoneNumberID != "" && change.Value.Metadata.PhoneNumberID != phoneNumberID {
+				continue
+			}
+			messages = append(messages, change.Value.Messages...)
+		}
+	}
+	return messages
+}
+
+// ParseWebhook decodes a webhook body
+func ParseWebhook(body []byte) (*Webhook, error) {
+	var w Webhook
+	if err := json.Unmarshal(body, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Client sends messages from one business phone number
+type Client struct {
+	token         string
+	phoneNumberID string
+	baseURL       string
+	http          *http.Client
+}
+
+// NewClient creates a client for the Graph API at baseURL
+func NewClient(token, phoneNumberID, baseURL string) *Client {
+	return &Client{
+		token:         token,
+		phoneNumberID: phoneNumberID,
+		baseURL:       baseURL,
+		http:          &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Send sends a text message. Outside the 24-hour window that follows the
+// user's last message, the API only delivers template messages and rejects
+// this call.
+func (c *Client) Send(ctx context.Context, to, text string) error {
+	if r := []rune(text); len(r) > maxTextLength {
+		text = string(r[:maxTextLength])
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"messaging_product": "whatsapp",
+		"recipient_type":    "individual",
+		"to":                to,
+		"type":              "text",
+		"text":              map[string]interface{}{"preview_url": false, "body": text},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/"+c.phoneNumberID+"/messages", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("whatsapp: send returned %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
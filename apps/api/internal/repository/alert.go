@@ -39,7 +39,8 @@ func (r *AlertRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Ale
 	return &alert, err
 }
 
-// List retrieves alerts with pagination and filtering
+// List retrieves alerts with pagination and filtering. When params.After is
+// set the list continues from that cursor and the total is not counted.
 func (r *AlertRepository) List(ctx context.Context, params ListAlertParams) ([]model.Alert, int64, error) {
 	var alerts []model.Alert
 	var total int64
@@ -50,19 +51,22 @@ func (r *AlertRepository) List(ctx context.Context, params ListAlertParams) ([]m
 	if params.Status != "" {
 		query = query.Where("status = ?", params.Status)
 	}
+	if params.IncidentID != uuid.Nil {
+		query = query.Where("incident_id = ?", params.IncidentID)
+	}
+	if params.ZoneID != "" {
+		query = query.Where("zone_id = ?", params.ZoneID)
+	}
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	// Get total count (skipped when following a cursor)
+	if params.After == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// Apply pagination
-	offset := (params.Page - 1) * params.PageSize
-	query = query.
-		Preload("Approver").
-		Order("created_at DESC").
-		Offset(offset).
-		Limit(params.PageSize)
+	query = paginate(query.Preload("Approver"), "created_at", true, params.Page, params.PageSize, params.After)
 
 	err := query.Find(&alerts).Error
 	return alerts, total, err
@@ -152,7 +156,10 @@ func (r *AlertRepository) CountByStatus(ctx context.Context, status string) (int
 
 // ListAlertParams represents parameters for listing alerts
 type ListAlertParams struct {
-	Page     int
-	PageSize int
-	Status   string
+	Page       int
+	PageSize   int
+	Status     string
+	IncidentID uuid.UUID
+	ZoneID     string
+	After      *Cursor
 }
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// AppealRepository handles appeal database operations
+type AppealRepository struct {
+	db *gorm.DB
+}
+
+// NewAppealRepository creates a new appeal repository
+func NewAppealRepository(db *DB) *AppealRepository {
+	return &AppealRepository{db: db.Gorm}
+}
+
+// ListAppealParams contains parameters for listing appeals
+type ListAppealParams struct {
+	Page     int
+	PageSize int
+	Status   string
+	Kind     string
+}
+
+// AppealStats summarises appeal outcomes
+type AppealStats struct {
+	Pending    int64
+	Decided    int64
+	Overturned int64
+}
+
+// Create creates a new appeal
+func (r *AppealRepository) Create(ctx context.Context, appeal *model.Appeal) error {
+	return r.db.WithContext(ctx).Create(appeal).Error
+}
+
+// GetByID retrieves an appeal with its report and decider
+func (r *AppealRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Appeal, error) {
+	var appeal model.Appeal
+	err := r.db.WithContext(ctx).
+		Preload("Report").
+		Preload("Decider").
+		First(&appeal, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &appeal, err
+}
+
+// GetByBlockedRef retrieves the appeal filed for a blocked-intake reference
+func (r *AppealRepository) GetByBlockedRef(ctx context.Context, ref string) (*model.Appeal, error) {
+	var appeal model.Appeal
+	err := r.db.WithContext(ctx).First(&appeal, "blocked_ref = ?", ref).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &appeal, err
+}
+
+// HasAppeal reports whether a report has been appealed before, whatever
+// the outcome
+func (r *AppealRepository) HasAppeal(ctx context.Context, reportID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Appeal{}).
+		Where("report_id = ?", reportID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// List retrieves appeals with pagination. Pending appeals are listed oldest
+// first so the review queue is worked in order; others newest first.
+func (r *AppealRepository) List(ctx context.Context, params ListAppealParams) ([]model.Appeal, int64, error) {
+	var appeals []model.Appeal
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Appeal{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Kind != "" {
+		query = query.Where("kind = ?", params.Kind)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "created_at DESC"
+	if params.Status == model.AppealStatusPending {
+		order = "created_at ASC"
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Preload("Report").
+		Order(order).
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&appeals).Error
+	return appeals, total, err
+}
+
+// Decide records the decision on a pending appeal. It returns false if the
+// appeal was already decided.
+func (r *AppealRepository) Decide(ctx context.Context, appeal *model.Appeal) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Appeal{}).
+		Where("id = ? AND status = ?", appeal.ID, model.AppealStatusPending).
+		Updates(map[string]interface{}{
+			"status":          appeal.Status,
+			"decision_reason": appeal.DecisionReason,
+			"decided_by":      appeal.DecidedBy,
+			"decided_at":      appeal.DecidedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetStats counts pending, decided and overturned appeals
+func (r *AppealRepository) GetStats(ctx context.Context) (*AppealStats, error) {
+	var stats AppealStats
+	err := r.db.WithContext(ctx).
+		Model(&model.Appeal{}).
+		Select(`COUNT(*) FILTER (WHERE status = ?) AS pending,
+			COUNT(*) FILTER (WHERE status <> ?) AS decided,
+			COUNT(*) FILTER (WHERE status = ?) AS overturned`,
+			model.AppealStatusPending, model.AppealStatusPending, model.AppealStatusOverturned).
+		Scan(&stats).Error
+	return &stats, err
+}
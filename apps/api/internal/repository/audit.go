@@ -24,7 +24,8 @@ func (r *AuditRepository) Create(ctx context.Context, log *model.AuditLog) error
 	return r.db.WithContext(ctx).Create(log).Error
 }
 
-// List retrieves audit logs with pagination
+// List retrieves audit logs with pagination. When params.After is set the
+// list continues from that cursor and the total is not counted.
 func (r *AuditRepository) List(ctx context.Context, params ListAuditParams) ([]model.AuditLog, int64, error) {
 	var logs []model.AuditLog
 	var total int64
@@ -44,19 +45,19 @@ func (r *AuditRepository) List(ctx context.Context, params ListAuditParams) ([]m
 	if params.Action != "" {
 		query = query.Where("action = ?", params.Action)
 	}
+	if params.BatchID != "" {
+		query = query.Where("diff->>'batchId' = ?", params.BatchID)
+	}
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	// Get total count (skipped when following a cursor)
+	if params.After == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// Apply pagination
-	offset := (params.Page - 1) * params.PageSize
-	query = query.
-		Preload("Actor").
-		Order("ts DESC").
-		Offset(offset).
-		Limit(params.PageSize)
+	query = paginate(query.Preload("Actor"), "ts", true, params.Page, params.PageSize, params.After)
 
 	err := query.Find(&logs).Error
 	return logs, total, err
@@ -81,4 +82,6 @@ type ListAuditParams struct {
 	ObjectID   uuid.UUID
 	ActorID    uuid.UUID
 	Action     string
+	BatchID    string
+	After      *Cursor
 }
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// BrigadeRepository handles brigade cluster database operations
+type BrigadeRepository struct {
+	db *gorm.DB
+}
+
+// NewBrigadeRepository creates a new brigade repository
+func NewBrigadeRepository(db *DB) *BrigadeRepository {
+	return &BrigadeRepository{db: db.Gorm}
+}
+
+// ListBrigadeParams contains parameters for listing brigade clusters
+type ListBrigadeParams struct {
+	Page     int
+	PageSize int
+	Status   string
+}
+
+// Save creates or updates a cluster, attaches the given reports that are not
+// already in a cluster and refreshes the report and source counts
+func (r *BrigadeRepository) Save(ctx context.Context, cluster *model.BrigadeCluster, reportIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(cluster).Error; err != nil {
+			return err
+		}
+
+		if len(reportIDs) > 0 {
+			if err := tx.Model(&model.Report{}).
+				Where("id IN ? AND brigade_cluster_id IS NULL", reportIDs).
+				Update("brigade_cluster_id", cluster.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		var counts struct {
+			Reports int
+			Sources int
+		}
+		if err := tx.Model(&model.Report{}).
+			Select("COUNT(*) AS reports, COUNT(DISTINCT device_hash) AS sources").
+			Where("brigade_cluster_id = ?", cluster.ID).
+			Scan(&counts).Error; err != nil {
+			return err
+		}
+		cluster.ReportCount = counts.Reports
+		cluster.SourceCount = counts.Sources
+		return tx.Model(cluster).Updates(map[string]interface{}{
+			"report_count": counts.Reports,
+			"source_count": counts.Sources,
+		}).Error
+	})
+}
+
+// GetByID retrieves a cluster with its reports
+func (r *BrigadeRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.BrigadeCluster, error) {
+	var cluster model.BrigadeCluster
+	err := r.db.WithContext(ctx).
+		Preload("Reports", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Resolver").
+		First(&cluster, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &cluster, err
+}
+
+// FindOpen returns the first open cluster of targetType among ids, or nil
+func (r *BrigadeRepository) FindOpen(ctx context.Context, ids []uuid.UUID, targetType string) (*model.BrigadeCluster, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var cluster model.BrigadeCluster
+	err := r.db.WithContext(ctx).
+		Where("id IN ? AND target_type = ? AND status = ?", ids, targetType, model.BrigadeStatusOpen).
+		Order("detected_at ASC").
+		First(&cluster).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &cluster, err
+}
+
+// List retrieves clusters with pagination, newest first
+func (r *BrigadeRepository) List(ctx context.Context, params ListBrigadeParams) ([]model.BrigadeCluster, int64, error) {
+	var clusters []model.BrigadeCluster
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.BrigadeCluster{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Order("updated_at DESC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&clusters).Error
+	return clusters, total, err
+}
+
+// Tightened reports whether intake is currently tightened for an area or,
+// for confirmed category-wide clusters, for a category
+func (r *BrigadeRepository) Tightened(ctx context.Context, areaKey, category string, now time.Time) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.BrigadeCluster{}).
+		Where("status IN ? AND tightened_until > ?", []string{model.BrigadeStatusOpen, model.BrigadeStatusConfirmed}, now).
+		Where("(area_key <> '' AND area_key = ?) OR (target_type = ? AND status = ? AND category = ?)",
+			areaKey, model.BrigadeTargetCategory, model.BrigadeStatusConfirmed, category).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Resolve records the review outcome of a cluster
+func (r *BrigadeRepository) Resolve(ctx context.Context, cluster *model.BrigadeCluster) error {
+	return r.db.WithContext(ctx).
+		Model(cluster).
+		Updates(map[string]interface{}{
+			"status":          cluster.Status,
+			"tightened_until": cluster.TightenedUntil,
+			"resolved_by":     cluster.ResolvedBy,
+			"resolved_at":     cluster.ResolvedAt,
+			"updated_at":      time.Now().UTC(),
+		}).Error
+}
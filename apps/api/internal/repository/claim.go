@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// ClaimRepository handles misinformation claim database operations
+type ClaimRepository struct {
+	db *gorm.DB
+}
+
+// NewClaimRepository creates a new claim repository
+func NewClaimRepository(db *DB) *ClaimRepository {
+	return &ClaimRepository{db: db.Gorm}
+}
+
+// ListClaimParams contains parameters for listing claims
+type ListClaimParams struct {
+	Page       int
+	PageSize   int
+	Verdict    string
+	Query      string // substring of the claim text
+	ReportID   uuid.UUID
+	IncidentID uuid.UUID
+}
+
+// Create creates a new claim linked to the given reports and incidents
+func (r *ClaimRepository) Create(ctx context.Context, claim *model.Claim, reportIDs, incidentIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(claim).Error; err != nil {
+			return err
+		}
+		return linkClaim(tx, claim.ID, reportIDs, incidentIDs)
+	})
+}
+
+// GetByID retrieves a claim by ID
+func (r *ClaimRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Claim, error) {
+	var claim model.Claim
+	err := r.db.WithContext(ctx).
+		Preload("Reviewer").
+		Preload("Creator").
+		First(&claim, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &claim, err
+}
+
+// List retrieves claims with pagination, most recently updated first
+func (r *ClaimRepository) List(ctx context.Context, params ListClaimParams) ([]model.Claim, int64, error) {
+	var claims []model.Claim
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Claim{})
+
+	// Apply filters
+	if params.Verdict != "" {
+		query = query.Where("verdict = ?", params.Verdict)
+	}
+	if params.Query != "" {
+		query = query.Where("text ILIKE ?", "%"+likeEscaper.Replace(params.Query)+"%")
+	}
+	if params.ReportID != uuid.Nil {
+		query = query.Where("id IN (SELECT claim_id FROM claim_reports WHERE report_id = ?)", params.ReportID)
+	}
+	if params.IncidentID != uuid.Nil {
+		query = query.Where("id IN (SELECT claim_id FROM claim_incidents WHERE incident_id = ?)", params.IncidentID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Preload("Reviewer").
+		Order("updated_at DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&claims).Error
+	return claims, total, err
+}
+
+// ListPublished retrieves published claims with pagination, most recently
+// published first
+func (r *ClaimRepository) ListPublished(ctx context.Context, page, pageSize int) ([]model.Claim, int64, error) {
+	var claims []model.Claim
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Claim{}).Where("published_at IS NOT NULL")
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("published_at DESC").
+		Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&claims).Error
+	return claims, total, err
+}
+
+// Update updates a claim
+func (r *ClaimRepository) Update(ctx context.Context, claim *model.Claim) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(claim).Error
+}
+
+// Link links reports and incidents to a claim; existing links are kept
+func (r *ClaimRepository) Link(ctx context.Context, claimID uuid.UUID, reportIDs, incidentIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := linkClaim(tx, claimID, reportIDs, incidentIDs); err != nil {
+			return err
+		}
+		return tx.Model(&model.Claim{}).
+			Where("id = ?", claimID).
+			Update("updated_at", time.Now().UTC()).Error
+	})
+}
+
+// ListReports returns the reports linked to a claim, oldest first
+func (r *ClaimRepository) ListReports(ctx context.Context, claimID uuid.UUID) ([]model.Report, error) {
+	var reports []model.Report
+	err := r.db.WithContext(ctx).
+		Joins("JOIN claim_reports cr ON cr.report_id = reports.id").
+		Where("cr.claim_id = ?", claimID).
+		Order("reports.created_at ASC").
+		Find(&reports).Error
+	return reports, err
+}
+
+// ListIncidents returns the incidents linked to a claim, oldest first
+func (r *ClaimRepository) ListIncidents(ctx context.Context, claimID uuid.UUID) ([]model.Incident, error) {
+	var incidents []model.Incident
+	err := r.db.WithContext(ctx).
+		Joins("JOIN claim_incidents ci ON ci.incident_id = incidents.id").
+		Where("ci.claim_id = ?", claimID).
+		Order("incidents.created_at ASC").
+		Find(&incidents).Error
+	return incidents, err
+}
+
+// linkClaim creates the missing report and incident links of a claim within
+// a transaction
+func linkClaim(tx *gorm.DB, claimID uuid.UUID, reportIDs, incidentIDs []uuid.UUID) error {
+	for _, reportID := range reportIDs {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ClaimReport{
+			ClaimID:  claimID,
+			ReportID: reportID,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	for _, incidentID := range incidentIDs {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ClaimIncident{
+			ClaimID:    claimID,
+			IncidentID: incidentID,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
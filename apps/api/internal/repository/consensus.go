@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// ConsensusRepository handles consensus round and review database operations
+type ConsensusRepository struct {
+	db *gorm.DB
+}
+
+// NewConsensusRepository creates a new consensus repository
+func NewConsensusRepository(db *DB) *ConsensusRepository {
+	return &ConsensusRepository{db: db.Gorm}
+}
+
+// CreateRound opens a round for a report together with its first review
+func (r *ConsensusRepository) CreateRound(ctx context.Context, round *model.ConsensusRound, review *model.TriageReview) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Reviews").Create(round).Error; err != nil {
+			return err
+		}
+		review.RoundID = round.ID
+		return tx.Create(review).Error
+	})
+}
+
+// AddReview records the review that completes an open round and moves the
+// round to status. It reports false when the round is no longer open.
+func (r *ConsensusRepository) AddReview(ctx context.Context, roundID uuid.UUID, review *model.TriageReview, status string) (bool, error) {
+	added := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{"status": status}
+		if status == model.ConsensusStatusAgreed {
+			updates["closed_at"] = time.Now().UTC()
+		}
+		result := tx.Model(&model.ConsensusRound{}).
+			Where("id = ? AND status = ?", roundID, model.ConsensusStatusOpen).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		review.RoundID = roundID
+		if err := tx.Create(review).Error; err != nil {
+			return err
+		}
+		added = true
+		return nil
+	})
+	return added, err
+}
+
+// Resolve closes a disputed round with a lead's tie-break decision. In one
+// transaction it marks the round resolved, moves the report from status from
+// to to and records the decision. It reports false, changing nothing, when
+// the round is no longer disputed or the report has left status from.
+func (r *ConsensusRepository) Resolve(ctx context.Context, decision *model.TriageDecision, from, to string) (bool, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.ConsensusRound{}).
+			Where("id = ? AND status = ?", *decision.RoundID, model.ConsensusStatusDisputed).
+			Updates(map[string]interface{}{
+				"status":      model.ConsensusStatusResolved,
+				"resolved_by": decision.DecidedBy,
+				"closed_at":   decision.DecidedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errStale
+		}
+		return decide(tx, decision, from, to)
+	})
+	if errors.Is(err, errStale) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GetPendingRound retrieves the open or disputed round of a report, or nil
+func (r *ConsensusRepository) GetPendingRound(ctx context.Context, reportID uuid.UUID) (*model.ConsensusRound, error) {
+	var round model.ConsensusRound
+	err := r.db.WithContext(ctx).
+		Preload("Reviews", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Where("report_id = ? AND status IN ?", reportID, []string{model.ConsensusStatusOpen, model.ConsensusStatusDisputed}).
+		First(&round).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &round, nil
+}
+
+// GetByID retrieves a round with its reviews
+func (r *ConsensusRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.ConsensusRound, error) {
+	var round model.ConsensusRound
+	err := r.db.WithContext(ctx).
+		Preload("Reviews", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Reviews.Reviewer").
+		Preload("Resolver").
+		Where("id = ?", id).
+		First(&round).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &round, nil
+}
+
+// List retrieves rounds with pagination and filtering, newest first
+func (r *ConsensusRepository) List(ctx context.Context, params ListConsensusParams) ([]model.ConsensusRound, int64, error) {
+	var rounds []model.ConsensusRound
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.ConsensusRound{})
+
+	// Apply filters
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.ReportID != uuid.Nil {
+		query = query.Where("report_id = ?", params.ReportID)
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Preload("Reviews", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Reviews.Reviewer").
+		Preload("Resolver").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&rounds).Error
+	return rounds, total, err
+}
+
+// PendingReportIDs returns which of the given reports have an open or
+// disputed round
+func (r *ConsensusRepository) PendingReportIDs(ctx context.Context, reportIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if len(reportIDs) == 0 {
+		return ids, nil
+	}
+	err := r.db.WithContext(ctx).
+		Model(&model.ConsensusRound{}).
+		Where("report_id IN ? AND status IN ?", reportIDs, []string{model.ConsensusStatusOpen, model.ConsensusStatusDisputed}).
+		Pluck("report_id", &ids).Error
+	return ids, err
+}
+
+// ListPairs retrieves the two reviews of every round opened since the given
+// time, oldest first. Each pair lists the reviewer with the lower ID first.
+func (r *ConsensusRepository) ListPairs(ctx context.Context, since time.Time) ([]ReviewPair, error) {
+	var pairs []ReviewPair
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			a.reviewer_id AS reviewer_a,
+			b.reviewer_id AS reviewer_b,
+			a.decision AS decision_a,
+			b.decision AS decision_b,
+			a.severity_final AS severity_a,
+			b.severity_final AS severity_b,
+			r.created_at
+		FROM consensus_rounds r
+		JOIN triage_reviews a ON a.round_id = r.id
+		JOIN triage_reviews b ON b.round_id = r.id AND a.reviewer_id < b.reviewer_id
+		WHERE r.created_at >= ?
+		ORDER BY r.created_at ASC
+	`, since).Scan(&pairs).Error
+	return pairs, err
+}
+
+// ListConsensusParams represents parameters for listing consensus rounds
+type ListConsensusParams struct {
+	Page     int
+	PageSize int
+	Status   string
+	ReportID uuid.UUID
+}
+
+// ReviewPair is the two reviews of one consensus round
+type ReviewPair struct {
+	ReviewerA uuid.UUID
+	ReviewerB uuid.UUID
+	DecisionA string
+	DecisionB string
+	SeverityA string
+	SeverityB string
+	CreatedAt time.Time
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// ConversationStore holds chat bot conversations until they expire
+type ConversationStore interface {
+	// Get returns the conversation stored under key, or nil
+	Get(ctx context.Context, key string) (*model.ChatSession, error)
+	// Put stores a conversation for ttl, replacing any previous one
+	Put(ctx context.Context, key string, session *model.ChatSession, ttl time.Duration) error
+	// Delete drops a conversation
+	Delete(ctx context.Context, key string) error
+}
+
+// NewConversationStore returns a Redis-backed conversation store when Redis
+// is connected and an in-memory one otherwise. In-memory conversations are
+// lost on restart and not shared between instances.
+func NewConversationStore(db *DB) ConversationStore {
+	if db.Redis != nil {
+		return &redisConversationStore{redis: db.Redis}
+	}
+	return &memoryConversationStore{sessions: make(map[string]memoryConversation)}
+}
+
+// redisConversationStore keeps conversations as JSON values that expire
+type redisConversationStore struct {
+	redis *redis.Client
+}
+
+func conversationKey(key string) string {
+	return "chat:session:" + key
+}
+
+func (s *redisConversationStore) Get(ctx context.Context, key string) (*model.ChatSession, error) {
+	data, err := s.redis.Get(ctx, conversationKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var session model.ChatSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (s *redisConversationStore) Put(ctx context.Context, key string, session *model.ChatSession, ttl time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.redis.Set(ctx, conversationKey(key), data, ttl).Err()
+}
+
+func (s *redisConversationStore) Delete(ctx context.Context, key string) error {
+	return s.redis.Del(ctx, conversationKey(key)).Err()
+}
+
+// memoryConversationStore keeps conversations in process memory
+type memoryConversationStore struct {
+	mu       sync.Mutex
+	sessions map[string]memoryConversation
+}
+
+type memoryConversation struct {
+	session   model.ChatSession
+	expiresAt time.Time
+}
+
+func (s *memoryConversationStore) Get(ctx context.Context, key string) (*model.ChatSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.sessions[key]
+	if !ok {
+		return nil, nil
+	}
+	if time.Now().After(c.expiresAt) {
+		delete(s.sessions, key)
+		return nil, nil
+	}
+	session := c.session
+	return &session, nil
+}
+
+func (s *memoryConversationStore) Put(ctx context.Context, key string, session *model.ChatSession, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Expired conversations are only dropped here and on read
+	now := time.Now()
+	for k, c := range s.sessions {
+		if now.After(c.expiresAt) {
+			delete(s.sessions, k)
+		}
+	}
+	s.sessions[key] = memoryConversation{session: *session, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+func (s *memoryConversationStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, key)
+	return nil
+}
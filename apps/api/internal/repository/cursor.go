@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Cursor is a keyset position: the sort time and ID of the last row seen.
+// The ID breaks ties between rows with the same time so pages never overlap.
+type Cursor struct {
+	Time time.Time
+	ID   uuid.UUID
+}
+
+// paginate orders query by column and id, then either skips to the rows after
+// the cursor or applies the page offset. With a cursor one extra row is
+// fetched so callers can tell whether another page follows.
+func paginate(query *gorm.DB, column string, desc bool, page, pageSize int, after *Cursor) *gorm.DB {
+	dir, cmp := "ASC", ">"
+	if desc {
+		dir, cmp = "DESC", "<"
+	}
+	query = query.Order(column + " " + dir).Order("id " + dir)
+
+	if after != nil {
+		return query.
+			Where("("+column+", id) "+cmp+" (?, ?)", after.Time, after.ID).
+			Limit(pageSize + 1)
+	}
+	return query.Offset((page - 1) * pageSize).Limit(pageSize)
+}
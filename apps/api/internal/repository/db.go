@@ -2,7 +2,10 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -12,16 +15,31 @@ import (
 
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/config"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/envelope"
 )
 
 // DB wraps database connections
 type DB struct {
-	Gorm  *gorm.DB
-	Redis *redis.Client
+	Gorm    *gorm.DB
+	Redis   *redis.Client
+	Keyring *envelope.Keyring // nil when field encryption is off (dev only)
 }
 
 // NewDB creates a new database connection
 func NewDB(cfg *config.Config) (*DB, error) {
+	// Load the master keys that encrypted model fields are sealed with
+	keyring, err := loadKeyring(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load encryption keys: %w", err)
+	}
+	if keyring == nil {
+		if !cfg.IsDev() {
+			return nil, errors.New("no encryption key configured: set ENCRYPTION_KEY or ENCRYPTION_KEYRING_FILE")
+		}
+		log.Printf("no encryption key configured; sensitive fields are stored in plaintext")
+	}
+	model.SetKeyring(keyring)
+
 	// Configure GORM logger
 	logLevel := logger.Info
 	if cfg.IsProd() {
@@ -64,14 +82,50 @@ func NewDB(cfg *config.Config) (*DB, error) {
 	}
 
 	return &DB{
-		Gorm:  gormDB,
-		Redis: rdb,
+		Gorm:    gormDB,
+		Redis:   rdb,
+		Keyring: keyring,
 	}, nil
 }
 
+// loadKeyring builds the encryption keyring from a keyring file or from the
+// environment-provided keys. It returns nil when no key is configured.
+func loadKeyring(cfg *config.Config) (*envelope.Keyring, error) {
+	if cfg.EncryptionKeyringFile != "" {
+		if cfg.EncryptionKey != "" {
+			return nil, errors.New("ENCRYPTION_KEYRING_FILE and ENCRYPTION_KEY are both set")
+		}
+		return envelope.LoadKeyringFile(cfg.EncryptionKeyringFile)
+	}
+	if cfg.EncryptionKey == "" {
+		return nil, nil
+	}
+
+	key, err := envelope.ParseKey(cfg.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("ENCRYPTION_KEY: %w", err)
+	}
+	keys := map[string][]byte{cfg.EncryptionKeyID: key}
+	for _, retired := range cfg.EncryptionRetiredKeys {
+		id, encoded, ok := strings.Cut(retired, ":")
+		if !ok {
+			return nil, fmt.Errorf("ENCRYPTION_RETIRED_KEYS: entry is not id:key")
+		}
+		if _, dup := keys[id]; dup {
+			return nil, fmt.Errorf("ENCRYPTION_RETIRED_KEYS: key ID %q is used twice", id)
+		}
+		key, err := envelope.ParseKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("ENCRYPTION_RETIRED_KEYS %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return envelope.NewKeyring(cfg.EncryptionKeyID, keys)
+}
+
 // AutoMigrate runs auto migration for all models (dev only)
 func (db *DB) AutoMigrate() error {
-	return db.Gorm.AutoMigrate(
+	if err := db.Gorm.AutoMigrate(
 		&model.User{},
 		&model.Report{},
 		&model.TriageDecision{},
@@ -81,9 +135,38 @@ func (db *DB) AutoMigrate() error {
 		&model.QuizResult{},
 		&model.AuditLog{},
 		&model.APIKey{},
-	)
+		&model.Incident{},
+		&model.DuplicateCandidate{},
+		&model.ReportLease{},
+		&model.SLAPolicy{},
+		&model.SLABreach{},
+		&model.Notification{},
+		&model.IntakeNonce{},
+		&model.ReporterReputation{},
+		&model.ReputationOutcome{},
+		&model.BrigadeCluster{},
+		&model.Appeal{},
+		&model.Indicator{},
+		&model.IndicatorReport{},
+		&model.Claim{},
+		&model.ClaimReport{},
+		&model.ClaimIncident{},
+		&model.Evidence{},
+		&model.ReportSubscriber{},
+		&model.ImportBatch{},
+	); err != nil {
+		return err
+	}
+
+	// The search vector is a generated column, which GORM cannot declare
+	return db.Gorm.Exec(`ALTER TABLE reports ADD COLUMN IF NOT EXISTS search_vector tsvector
+		GENERATED ALWAYS AS (` + reportSearchVector + `) STORED`).Error
 }
 
+// reportSearchVector matches the generated column in migration 008
+const reportSearchVector = `setweight(to_tsvector('simple', coalesce(area_hint, '')), 'A') ||
+			setweight(to_tsvector('simple', coalesce(description, '')), 'B')`
+
 // Close closes all database connections
 func (db *DB) Close() error {
 	if db.Redis != nil {
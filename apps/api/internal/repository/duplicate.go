@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// DuplicateRepository handles duplicate candidate database operations
+type DuplicateRepository struct {
+	db *gorm.DB
+}
+
+// NewDuplicateRepository creates a new duplicate candidate repository
+func NewDuplicateRepository(db *DB) *DuplicateRepository {
+	return &DuplicateRepository{db: db.Gorm}
+}
+
+// CreateBatch stores duplicate candidates, ignoring pairs that already exist
+func (r *DuplicateRepository) CreateBatch(ctx context.Context, candidates []model.DuplicateCandidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&candidates).Error
+}
+
+// GetByID retrieves a duplicate candidate by ID
+func (r *DuplicateRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.DuplicateCandidate, error) {
+	var candidate model.DuplicateCandidate
+	err := r.db.WithContext(ctx).
+		Preload("Report").
+		Preload("Candidate").
+		First(&candidate, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &candidate, err
+}
+
+// ListByReport retrieves duplicate candidates for a report, best match first
+func (r *DuplicateRepository) ListByReport(ctx context.Context, reportID uuid.UUID, status string) ([]model.DuplicateCandidate, error) {
+	var candidates []model.DuplicateCandidate
+	query := r.db.WithContext(ctx).
+		Preload("Candidate").
+		Where("report_id = ?", reportID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("score DESC").Find(&candidates).Error
+	return candidates, err
+}
+
+// UpdateStatus records the review outcome of a duplicate candidate
+func (r *DuplicateRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status string, reviewerID *uuid.UUID) error {
+	now := time.Now().UTC()
+	return r.db.WithContext(ctx).
+		Model(&model.DuplicateCandidate{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewed_by": reviewerID,
+			"reviewed_at": &now,
+		}).Error
+}
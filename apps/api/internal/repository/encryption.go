@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// EncryptionRepository reads and writes encrypted columns as stored, without
+// decrypting them, for re-wrapping after a master key rotation
+type EncryptionRepository struct {
+	db *gorm.DB
+}
+
+// NewEncryptionRepository creates a new encryption repository
+func NewEncryptionRepository(db *DB) *EncryptionRepository {
+	return &EncryptionRepository{db: db.Gorm}
+}
+
+// StoredValue is an encrypted column's raw value in one row
+type StoredValue struct {
+	ID    uuid.UUID
+	Value []byte
+}
+
+// ListStored returns up to limit non-empty raw values of a column from rows
+// after the given ID, in ID order
+func (r *EncryptionRepository) ListStored(ctx context.Context, col model.EncryptedColumn, after uuid.UUID, limit int) ([]StoredValue, error) {
+	query := fmt.Sprintf(`SELECT id, %s FROM %s WHERE id > ? AND length(%s) > 0 ORDER BY id LIMIT ?`,
+		col.Column, col.Table, col.Column)
+	rows, err := r.db.WithContext(ctx).Raw(query, after, limit).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []StoredValue
+	for rows.Next() {
+		var v StoredValue
+		if err := rows.Scan(&v.ID, &v.Value); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
+
+// ReplaceStored swaps a raw value for another, unless the row changed since
+// it was read. It reports whether the row was updated.
+func (r *EncryptionRepository) ReplaceStored(ctx context.Context, col model.EncryptedColumn, id uuid.UUID, old, value []byte) (bool, error) {
+	query := fmt.Sprintf(`UPDATE %s SET %s = ? WHERE id = ? AND %s = ?`, col.Table, col.Column, col.Column)
+	var res *gorm.DB
+	if col.Binary {
+		res = r.db.WithContext(ctx).Exec(query, value, id, old)
+	} else {
+		res = r.db.WithContext(ctx).Exec(query, string(value), id, string(old))
+	}
+	return res.RowsAffected == 1, res.Error
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// EvidenceRepository handles evidence file database operations
+type EvidenceRepository struct {
+	db *gorm.DB
+}
+
+// NewEvidenceRepository creates a new evidence repository
+func NewEvidenceRepository(db *DB) *EvidenceRepository {
+	return &EvidenceRepository{db: db.Gorm}
+}
+
+// Attach stores an evidence file and appends its ref to the report's
+// evidence refs
+func (r *EvidenceRepository) Attach(ctx context.Context, evidence *model.Evidence) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(evidence).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`
+			UPDATE reports SET evidence_refs = COALESCE(evidence_refs, '[]'::jsonb) || to_jsonb(?::text), updated_at = now()
+			WHERE id = ?
+		`, evidence.Ref(), evidence.ReportID).Error
+	})
+}
+
+// GetByID retrieves an evidence file of a report, data included
+func (r *EvidenceRepository) GetByID(ctx context.Context, reportID, id uuid.UUID) (*model.Evidence, error) {
+	var evidence model.Evidence
+	err := r.db.WithContext(ctx).First(&evidence, "id = ? AND report_id = ?", id, reportID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &evidence, err
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// ImportRepository handles report import batch database operations
+type ImportRepository struct {
+	db *gorm.DB
+}
+
+// NewImportRepository creates a new import repository
+func NewImportRepository(db *DB) *ImportRepository {
+	return &ImportRepository{db: db.Gorm}
+}
+
+// ListImportParams contains parameters for listing import batches
+type ListImportParams struct {
+	Page     int
+	PageSize int
+	Status   string
+}
+
+// ImportRowError is the failure of one report in an atomic import
+type ImportRowError struct {
+	Index int // position in the reports passed in
+	Err   error
+}
+
+func (e *ImportRowError) Error() string {
+	return fmt.Sprintf("report %d: %v", e.Index, e.Err)
+}
+
+func (e *ImportRowError) Unwrap() error {
+	return e.Err
+}
+
+// Create creates a new import batch
+func (r *ImportRepository) Create(ctx context.Context, batch *model.ImportBatch) error {
+	return r.db.WithContext(ctx).Create(batch).Error
+}
+
+// CreateWithReports creates a batch and all its reports in one transaction.
+// If a report cannot be created nothing is kept and the error is an
+// *ImportRowError naming it.
+func (r *ImportRepository) CreateWithReports(ctx context.Context, batch *model.ImportBatch, reports []*model.Report) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(batch).Error; err != nil {
+			return err
+		}
+		for i, report := range reports {
+			report.ImportBatchID = &batch.ID
+			if err := tx.Create(report).Error; err != nil {
+				return &ImportRowError{Index: i, Err: err}
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateCounts stores a batch's status and row counts
+func (r *ImportRepository) UpdateCounts(ctx context.Context, batch *model.ImportBatch) error {
+	return r.db.WithContext(ctx).
+		Model(batch).
+		Updates(map[string]interface{}{
+			"status":   batch.Status,
+			"total":    batch.Total,
+			"imported": batch.Imported,
+			"failed":   batch.Failed,
+		}).Error
+}
+
+// GetByID retrieves an import batch with its creator
+func (r *ImportRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.ImportBatch, error) {
+	var batch model.ImportBatch
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		First(&batch, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &batch, err
+}
+
+// List retrieves import batches with pagination, newest first
+func (r *ImportRepository) List(ctx context.Context, params ListImportParams) ([]model.ImportBatch, int64, error) {
+	var batches []model.ImportBatch
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.ImportBatch{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Preload("Creator").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&batches).Error
+	return batches, total, err
+}
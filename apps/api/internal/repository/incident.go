@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// IncidentRepository handles incident database operations
+type IncidentRepository struct {
+	db *gorm.DB
+}
+
+// NewIncidentRepository creates a new incident repository
+func NewIncidentRepository(db *DB) *IncidentRepository {
+	return &IncidentRepository{db: db.Gorm}
+}
+
+// Create creates a new incident and attaches the given reports to it
+func (r *IncidentRepository) Create(ctx context.Context, incident *model.Incident, reportIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(incident).Error; err != nil {
+			return err
+		}
+		return attachReports(tx, incident.ID, reportIDs)
+	})
+}
+
+// GetByID retrieves an incident by ID with its reports and alerts
+func (r *IncidentRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Incident, error) {
+	var incident model.Incident
+	err := r.db.WithContext(ctx).
+		Preload("Reports", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Alerts").
+		Preload("Creator").
+		First(&incident, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &incident, err
+}
+
+// GetByIDs retrieves incidents by their IDs
+func (r *IncidentRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Incident, error) {
+	var incidents []model.Incident
+	if len(ids) == 0 {
+		return incidents, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Order("created_at ASC").
+		Find(&incidents).Error
+	return incidents, err
+}
+
+// List retrieves incidents with pagination and filtering
+func (r *IncidentRepository) List(ctx context.Context, params ListIncidentParams) ([]model.Incident, int64, error) {
+	var incidents []model.Incident
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Incident{})
+
+	// Apply filters
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	} else {
+		query = query.Where("status <> ?", model.IncidentStatusMerged)
+	}
+	if params.Category != "" {
+		query = query.Where("category = ?", params.Category)
+	}
+	if params.Severity != "" {
+		query = query.Where("severity = ?", params.Severity)
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (params.Page - 1) * params.PageSize
+	query = query.
+		Order("updated_at DESC").
+		Offset(offset).
+		Limit(params.PageSize)
+
+	err := query.Find(&incidents).Error
+	return incidents, total, err
+}
+
+// Update updates an incident
+func (r *IncidentRepository) Update(ctx context.Context, incident *model.Incident) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(incident).Error
+}
+
+// AttachReports links reports to an incident
+func (r *IncidentRepository) AttachReports(ctx context.Context, incidentID uuid.UUID, reportIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := attachReports(tx, incidentID, reportIDs); err != nil {
+			return err
+		}
+		return touchIncident(tx, incidentID)
+	})
+}
+
+// Merge moves all reports and alerts of the source incidents into the target
+// and marks the sources as merged
+func (r *IncidentRepository) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Report{}).
+			Where("incident_id IN ?", sourceIDs).
+			Update("incident_id", targetID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Alert{}).
+			Where("incident_id IN ?", sourceIDs).
+			Update("incident_id", targetID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Incident{}).
+			Where("id IN ?", sourceIDs).
+			Updates(map[string]interface{}{
+				"status":      model.IncidentStatusMerged,
+				"merged_into": targetID,
+				"updated_at":  time.Now().UTC(),
+			}).Error; err != nil {
+			return err
+		}
+		return touchIncident(tx, targetID)
+	})
+}
+
+// Split creates a new incident and moves the given reports from the source into it
+func (r *IncidentRepository) Split(ctx context.Context, sourceID uuid.UUID, incident *model.Incident, reportIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(incident).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Report{}).
+			Where("id IN ? AND incident_id = ?", reportIDs, sourceID).
+			Update("incident_id", incident.ID).Error; err != nil {
+			return err
+		}
+		return touchIncident(tx, sourceID)
+	})
+}
+
+// CountReports counts the reports attached to each of the given incidents
+func (r *IncidentRepository) CountReports(ctx context.Context, incidentIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64)
+	if len(incidentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		IncidentID uuid.UUID
+		Count      int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Select("incident_id, count(*) as count").
+		Where("incident_id IN ?", incidentIDs).
+		Group("incident_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.IncidentID] = row.Count
+	}
+
+	return counts, nil
+}
+
+// CountByStatus counts incidents by status
+func (r *IncidentRepository) CountByStatus(ctx context.Context, status string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Incident{}).
+		Where("status = ?", status).
+		Count(&count).Error
+	return count, err
+}
+
+// attachReports sets the incident of the given reports within a transaction
+func attachReports(tx *gorm.DB, incidentID uuid.UUID, reportIDs []uuid.UUID) error {
+	if len(reportIDs) == 0 {
+		return nil
+	}
+	return tx.Model(&model.Report{}).
+		Where("id IN ?", reportIDs).
+		Update("incident_id", incidentID).Error
+}
+
+// touchIncident bumps the updated_at timestamp of an incident
+func touchIncident(tx *gorm.DB, incidentID uuid.UUID) error {
+	return tx.Model(&model.Incident{}).
+		Where("id = ?", incidentID).
+		Update("updated_at", time.Now().UTC()).Error
+}
+
+// ListIncidentParams represents parameters for listing incidents
+type ListIncidentParams struct {
+	Page     int
+	PageSize int
+	Status   string
+	Category string
+	Severity string
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// likeEscaper escapes LIKE wildcards in user input
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// IndicatorRepository handles scam indicator database operations
+type IndicatorRepository struct {
+	db *gorm.DB
+}
+
+// NewIndicatorRepository creates a new indicator repository
+func NewIndicatorRepository(db *DB) *IndicatorRepository {
+	return &IndicatorRepository{db: db.Gorm}
+}
+
+// ListIndicatorParams contains parameters for listing indicators
+type ListIndicatorParams struct {
+	Page     int
+	PageSize int
+	Type     string
+	Status   string
+	Query    string // substring of the value
+	ReportID uuid.UUID
+}
+
+// Record stores the indicators found in a report seen at seenAt. New
+// indicators are created, known ones widen their first and last seen times,
+// and each is linked to the report once.
+func (r *IndicatorRepository) Record(ctx context.Context, reportID uuid.UUID, seenAt time.Time, indicators []model.Indicator) error {
+	if len(indicators) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, ind := range indicators {
+			var id uuid.UUID
+			if err := tx.Raw(`
+				INSERT INTO indicators (id, type, value, hash, status, report_count, first_seen_at, last_seen_at, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?, 0, ?, ?, now(), now())
+				ON CONFLICT (type, value) DO UPDATE SET
+					first_seen_at = LEAST(indicators.first_seen_at, excluded.first_seen_at),
+					last_seen_at = GREATEST(indicators.last_seen_at, excluded.last_seen_at),
+					updated_at = now()
+				RETURNING id
+			`, uuid.New(), ind.Type, ind.Value, ind.Hash, model.IndicatorStatusUnverified, seenAt, seenAt).Scan(&id).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.IndicatorReport{
+				IndicatorID: id,
+				ReportID:    reportID,
+				SeenAt:      seenAt,
+			}).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Exec(`
+				UPDATE indicators SET report_count = (
+					SELECT COUNT(*) FROM indicator_reports WHERE indicator_id = ?
+				) WHERE id = ?
+			`, id, id).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetByID retrieves an indicator by ID
+func (r *IndicatorRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Indicator, error) {
+	var indicator model.Indicator
+	err := r.db.WithContext(ctx).
+		Preload("Verifier").
+		First(&indicator, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &indicator, err
+}
+
+// List retrieves indicators with pagination, most recently seen first
+func (r *IndicatorRepository) List(ctx context.Context, params ListIndicatorParams) ([]model.Indicator, int64, error) {
+	var indicators []model.Indicator
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Indicator{})
+
+	// Apply filters
+	if params.Type != "" {
+		query = query.Where("type = ?", params.Type)
+	}
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Query != "" {
+		query = query.Where("value ILIKE ?", "%"+likeEscaper.Replace(params.Query)+"%")
+	}
+	if params.ReportID != uuid.Nil {
+		query = query.Where("id IN (SELECT indicator_id FROM indicator_reports WHERE report_id = ?)", params.ReportID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Preload("Verifier").
+		Order("last_seen_at DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&indicators).Error
+	return indicators, total, err
+}
+
+// LookupPrefix returns the indicators with one of the given statuses whose
+// hash starts with prefix
+func (r *IndicatorRepository) LookupPrefix(ctx context.Context, prefix string, statuses []string) ([]model.Indicator, error) {
+	var indicators []model.Indicator
+	err := r.db.WithContext(ctx).
+		Where("hash LIKE ? AND status IN ?", likeEscaper.Replace(prefix)+"%", statuses).
+		Order("hash ASC").
+		Find(&indicators).Error
+	return indicators, err
+}
+
+// ListForExport returns up to limit indicators with one of the given
+// statuses changed after the given time (all of them when it is zero),
+// oldest change first
+func (r *IndicatorRepository) ListForExport(ctx context.Context, statuses []string, after time.Time, limit int) ([]model.Indicator, error) {
+	var indicators []model.Indicator
+	query := r.db.WithContext(ctx).Where("status IN ?", statuses)
+	if !after.IsZero() {
+		query = query.Where("updated_at > ?", after)
+	}
+	err := query.
+		Order("updated_at ASC").
+		Order("id ASC").
+		Limit(limit).
+		Find(&indicators).Error
+	return indicators, err
+}
+
+// ListReports returns the newest reports an indicator was found in
+func (r *IndicatorRepository) ListReports(ctx context.Context, indicatorID uuid.UUID, limit int) ([]model.Report, error) {
+	var reports []model.Report
+	err := r.db.WithContext(ctx).
+		Joins("JOIN indicator_reports ir ON ir.report_id = reports.id").
+		Where("ir.indicator_id = ?", indicatorID).
+		Order("reports.created_at DESC").
+		Limit(limit).
+		Find(&reports).Error
+	return reports, err
+}
+
+// SetStatus records the verification status of an indicator
+func (r *IndicatorRepository) SetStatus(ctx context.Context, indicator *model.Indicator) error {
+	return r.db.WithContext(ctx).
+		Model(indicator).
+		Updates(map[string]interface{}{
+			"status":            indicator.Status,
+			"verified_by":       indicator.VerifiedBy,
+			"verified_at":       indicator.VerifiedAt,
+			"verification_note": indicator.VerificationNote,
+			"updated_at":        time.Now().UTC(),
+		}).Error
+}
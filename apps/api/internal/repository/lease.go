@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+var (
+	ErrLeaseHeld = errors.New("lease held by another user")
+)
+
+// LeaseStore holds time-limited claims on reports
+type LeaseStore interface {
+	// Acquire takes or renews a lease for holder. It fails with ErrLeaseHeld
+	// when another holder has an active lease.
+	Acquire(ctx context.Context, lease *model.ReportLease) error
+	// Assign takes a lease regardless of the current holder
+	Assign(ctx context.Context, lease *model.ReportLease) error
+	// Get returns the active lease on a report, or nil
+	Get(ctx context.Context, reportID uuid.UUID) (*model.ReportLease, error)
+	// Release drops a lease if it is held by holder
+	Release(ctx context.Context, reportID, holderID uuid.UUID) error
+	// Active returns all unexpired leases
+	Active(ctx context.Context) ([]model.ReportLease, error)
+}
+
+// NewLeaseStore returns a Redis-backed lease store when Redis is connected
+// and a database-backed one otherwise
+func NewLeaseStore(db *DB) LeaseStore {
+	if db.Redis != nil {
+		return &redisLeaseStore{redis: db.Redis}
+	}
+	return &dbLeaseStore{db: db.Gorm}
+}
+
+// dbLeaseStore keeps leases in the report_leases table
+type dbLeaseStore struct {
+	db *gorm.DB
+}
+
+func (s *dbLeaseStore) Acquire(ctx context.Context, lease *model.ReportLease) error {
+	// Insert, or take over the row only if it expired or is already ours
+	result := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "report_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"holder_id", "assigned_by", "acquired_at", "expires_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{
+					SQL:  "report_leases.expires_at <= ? OR report_leases.holder_id = ?",
+					Vars: []interface{}{time.Now().UTC(), lease.HolderID},
+				},
+			}},
+		}).
+		Create(lease)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrLeaseHeld
+	}
+	return nil
+}
+
+func (s *dbLeaseStore) Assign(ctx context.Context, lease *model.ReportLease) error {
+	return s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "report_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"holder_id", "assigned_by", "acquired_at", "expires_at"}),
+		}).
+		Create(lease).Error
+}
+
+func (s *dbLeaseStore) Get(ctx context.Context, reportID uuid.UUID) (*model.ReportLease, error) {
+	var lease model.ReportLease
+	err := s.db.WithContext(ctx).
+		First(&lease, "report_id = ? AND expires_at > ?", reportID, time.Now().UTC()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &lease, err
+}
+
+func (s *dbLeaseStore) Release(ctx context.Context, reportID, holderID uuid.UUID) error {
+	return s.db.WithContext(ctx).
+		Delete(&model.ReportLease{}, "report_id = ? AND holder_id = ?", reportID, holderID).Error
+}
+
+func (s *dbLeaseStore) Active(ctx context.Context) ([]model.ReportLease, error) {
+	var leases []model.ReportLease
+	err := s.db.WithContext(ctx).
+		Where("expires_at > ?", time.Now().UTC()).
+		Order("expires_at ASC").
+		Find(&leases).Error
+	return leases, err
+}
+
+// redisLeaseStore keeps one expiring key per lease plus a sorted set of
+// report IDs scored by expiry, so active leases can be listed cheaply
+type redisLeaseStore struct {
+	redis *redis.Client
+}
+
+const leaseIndexKey = "triage:leases"
+
+func leaseKey(reportID uuid.UUID) string {
+	return "triage:lease:" + reportID.String()
+}
+
+// releaseScript deletes a lease only when it belongs to the given holder
+var releaseScript = redis.NewScript(`
+local v = redis.call("GET", KEYS[1])
+if v and cjson.decode(v)["holderId"] == ARGV[1] then
+	redis.call("DEL", KEYS[1])
+	redis.call("ZREM", KEYS[2], ARGV[2])
+end
+return 1
+`)
+
+// acquireScript sets a lease when the report is free or already held by the
+// same holder, so a renewal cannot overwrite a lease taken in between
+var acquireScript = redis.NewScript(`
+local v = redis.call("GET", KEYS[1])
+if v and cjson.decode(v)["holderId"] ~= ARGV[2] then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[3])
+return 1
+`)
+
+func (s *redisLeaseStore) Acquire(ctx context.Context, lease *model.ReportLease) error {
+	data, err := encodeLease(lease)
+	if err != nil {
+		return err
+	}
+
+	// Renewing our own lease is allowed
+	ok, err := acquireScript.Run(ctx, s.redis,
+		[]string{leaseKey(lease.ReportID)},
+		data, lease.HolderID.String(), time.Until(lease.ExpiresAt).Milliseconds(),
+	).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		return ErrLeaseHeld
+	}
+
+	return s.index(ctx, lease)
+}
+
+func (s *redisLeaseStore) Assign(ctx context.Context, lease *model.ReportLease) error {
+	data, err := encodeLease(lease)
+	if err != nil {
+		return err
+	}
+	if err := s.redis.Set(ctx, leaseKey(lease.ReportID), data, time.Until(lease.ExpiresAt)).Err(); err != nil {
+		return err
+	}
+	return s.index(ctx, lease)
+}
+
+func (s *redisLeaseStore) Get(ctx context.Context, reportID uuid.UUID) (*model.ReportLease, error) {
+	data, err := s.redis.Get(ctx, leaseKey(reportID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var payload leasePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return &model.ReportLease{
+		ReportID:   payload.ReportID,
+		HolderID:   payload.HolderID,
+		AssignedBy: payload.AssignedBy,
+		AcquiredAt: payload.AcquiredAt,
+		ExpiresAt:  payload.ExpiresAt,
+	}, nil
+}
+
+func (s *redisLeaseStore) Release(ctx context.Context, reportID, holderID uuid.UUID) error {
+	return releaseScript.Run(ctx, s.redis,
+		[]string{leaseKey(reportID), leaseIndexKey},
+		holderID.String(), reportID.String(),
+	).Err()
+}
+
+func (s *redisLeaseStore) Active(ctx context.Context) ([]model.ReportLease, error) {
+	now := time.Now().UTC()
+
+	// Drop index entries whose keys have already expired
+	if err := s.redis.ZRemRangeByScore(ctx, leaseIndexKey, "-inf", toScore(now)).Err(); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.redis.ZRange(ctx, leaseIndexKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	leases := make([]model.ReportLease, 0, len(ids))
+	for _, id := range ids {
+		reportID, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		lease, err := s.Get(ctx, reportID)
+		if err != nil {
+			return nil, err
+		}
+		if lease != nil {
+			leases = append(leases, *lease)
+		}
+	}
+	return leases, nil
+}
+
+func (s *redisLeaseStore) index(ctx context.Context, lease *model.ReportLease) error {
+	return s.redis.ZAdd(ctx, leaseIndexKey, redis.Z{
+		Score:  float64(lease.ExpiresAt.Unix()),
+		Member: lease.ReportID.String(),
+	}).Err()
+}
+
+func toScore(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+// leasePayload is the JSON stored under a lease key
+type leasePayload struct {
+	ReportID   uuid.UUID  `json:"reportId"`
+	HolderID   uuid.UUID  `json:"holderId"`
+	AssignedBy *uuid.UUID `json:"assignedBy,omitempty"`
+	AcquiredAt time.Time  `json:"acquiredAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+}
+
+func encodeLease(lease *model.ReportLease) ([]byte, error) {
+	return json.Marshal(leasePayload{
+		ReportID:   lease.ReportID,
+		HolderID:   lease.HolderID,
+		AssignedBy: lease.AssignedBy,
+		AcquiredAt: lease.AcquiredAt,
+		ExpiresAt:  lease.ExpiresAt,
+	})
+}
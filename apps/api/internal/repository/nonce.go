@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// NonceStore remembers single-use values until they expire
+type NonceStore interface {
+	// Use marks nonce as spent until expiresAt. It reports false if the
+	// nonce was already spent.
+	Use(ctx context.Context, nonce string, expiresAt time.Time) (bool, error)
+}
+
+// NewNonceStore returns a Redis-backed nonce store when Redis is connected
+// and a database-backed one otherwise
+func NewNonceStore(db *DB) NonceStore {
+	if db.Redis != nil {
+		return &redisNonceStore{redis: db.Redis}
+	}
+	return &dbNonceStore{db: db.Gorm}
+}
+
+// dbNonceStore keeps spent nonces in the intake_nonces table
+type dbNonceStore struct {
+	db *gorm.DB
+}
+
+func (s *dbNonceStore) Use(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	// Expired nonces can no longer be replayed, so drop them as we go
+	if err := s.db.WithContext(ctx).
+		Where("expires_at < ?", time.Now().UTC()).
+		Delete(&model.IntakeNonce{}).Error; err != nil {
+		return false, err
+	}
+
+	result := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.IntakeNonce{Nonce: nonce, ExpiresAt: expiresAt})
+	return result.RowsAffected > 0, result.Error
+}
+
+// redisNonceStore keeps spent nonces as keys that expire with the challenge
+type redisNonceStore struct {
+	redis *redis.Client
+}
+
+func (s *redisNonceStore) Use(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		ttl = time.Second
+	}
+	return s.redis.SetNX(ctx, "intake:nonce:"+nonce, 1, ttl).Result()
+}
@@ -3,11 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/search"
 )
 
 // ReportRepository handles report database operations
@@ -37,7 +39,8 @@ func (r *ReportRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Re
 	return &report, err
 }
 
-// List retrieves reports with pagination and filtering
+// List retrieves reports with pagination and filtering. When params.After is
+// set the list continues from that cursor and the total is not counted.
 func (r *ReportRepository) List(ctx context.Context, params ListReportParams) ([]model.Report, int64, error) {
 	var reports []model.Report
 	var total int64
@@ -51,31 +54,228 @@ func (r *ReportRepository) List(ctx context.Context, params ListReportParams) ([
 	if params.Category != "" {
 		query = query.Where("category = ?", params.Category)
 	}
+	if params.IncidentID != uuid.Nil {
+		query = query.Where("incident_id = ?", params.IncidentID)
+	}
+	if params.ZoneID != "" {
+		query = query.Where("zone_id = ?", params.ZoneID)
+	}
+	if params.ImportBatchID != uuid.Nil {
+		query = query.Where("import_batch_id = ?", params.ImportBatchID)
+	}
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
+	// Get total count (skipped when following a cursor)
+	if params.After == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// Apply sorting and pagination
+	sortBy := params.SortBy
+	if sortBy == "" {
+		sortBy = "created_at"
+	}
+	query = paginate(query, sortBy, params.SortDir != "asc", params.Page, params.PageSize, params.After)
+
+	err := query.Find(&reports).Error
+	return reports, total, err
+}
+
+// Search retrieves reports matching free text and filters. Word matches are
+// ranked by relevance; otherwise newest reports come first.
+func (r *ReportRepository) Search(ctx context.Context, params SearchReportParams) ([]model.Report, int64, error) {
+	var reports []model.Report
+	var total int64
+
+	if err := r.searchQuery(ctx, params).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// Apply sorting
-	orderBy := "created_at DESC"
-	if params.SortBy != "" {
-		dir := "DESC"
-		if params.SortDir == "asc" {
-			dir = "ASC"
-		}
-		orderBy = params.SortBy + " " + dir
+	query := r.searchQuery(ctx, params).Select("reports.*")
+	if len(params.Query.Words) > 0 {
+		query = query.Order(gorm.Expr("ts_rank(reports.search_vector, to_tsquery('simple', ?)) DESC", params.Query.TSQuery()))
 	}
-	query = query.Order(orderBy)
 
-	// Apply pagination
 	offset := (params.Page - 1) * params.PageSize
-	query = query.Offset(offset).Limit(params.PageSize)
+	err := query.
+		Order("reports.created_at DESC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&reports).Error
+	return reports, total, err
+}
 
-	err := query.Find(&reports).Error
+// SearchFacets counts the reports matching a search by status, category,
+// severity, evidence level and zone
+func (r *ReportRepository) SearchFacets(ctx context.Context, params SearchReportParams) (*SearchFacets, error) {
+	facets := SearchFacets{}
+	fields := []struct {
+		expr string
+		dest *map[string]int64
+	}{
+		{"reports.status", &facets.Status},
+		{"reports.category", &facets.Category},
+		{"reports.severity_suggested", &facets.SeveritySuggested},
+		{"latest.severity_final", &facets.SeverityFinal},
+		{"latest.evidence_level", &facets.EvidenceLevel},
+		{"reports.zone_id", &facets.Zone},
+	}
+
+	for _, f := range fields {
+		var counts []struct {
+			Value string
+			Count int64
+		}
+		if err := r.searchQuery(ctx, params).
+			Select("COALESCE(" + f.expr + ", '') AS value, count(*) AS count").
+			Group("value").
+			Scan(&counts).Error; err != nil {
+			return nil, err
+		}
+		*f.dest = make(map[string]int64)
+		for _, c := range counts {
+			if c.Value != "" {
+				(*f.dest)[c.Value] = c.Count
+			}
+		}
+	}
+
+	return &facets, nil
+}
+
+// searchQuery builds the filtered report query shared by Search and
+// SearchFacets. The latest triage decision is joined as "latest".
+func (r *ReportRepository) searchQuery(ctx context.Context, params SearchReportParams) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Joins(`LEFT JOIN LATERAL (
+			SELECT td.severity_final, td.evidence_level
+			FROM triage_decisions td
+			WHERE td.report_id = reports.id
+			ORDER BY td.decided_at DESC
+			LIMIT 1
+		) latest ON true`)
+
+	if len(params.Query.Words) > 0 {
+		query = query.Where("reports.search_vector @@ to_tsquery('simple', ?)", params.Query.TSQuery())
+	}
+	for _, b := range params.Query.Bigrams {
+		query = query.Where("(reports.area_hint || ' ' || reports.description) LIKE ?", "%"+b+"%")
+	}
+
+	if !params.From.IsZero() {
+		query = query.Where("reports.created_at >= ?", params.From)
+	}
+	if !params.To.IsZero() {
+		query = query.Where("reports.created_at < ?", params.To)
+	}
+	if params.Status != "" {
+		query = query.Where("reports.status = ?", params.Status)
+	}
+	if params.Category != "" {
+		query = query.Where("reports.category = ?", params.Category)
+	}
+	if params.SeveritySuggested != "" {
+		query = query.Where("reports.severity_suggested = ?", params.SeveritySuggested)
+	}
+	if params.SeverityFinal != "" {
+		query = query.Where("latest.severity_final = ?", params.SeverityFinal)
+	}
+	if params.EvidenceLevel != "" {
+		query = query.Where("latest.evidence_level = ?", params.EvidenceLevel)
+	}
+	if params.ZoneID != "" {
+		query = query.Where("reports.zone_id = ?", params.ZoneID)
+	}
+	if params.IncidentID != uuid.Nil {
+		query = query.Where("reports.incident_id = ?", params.IncidentID)
+	}
+
+	return query
+}
+
+// ListQueue retrieves reports awaiting triage: submitted, or under review
+// without an active lease. Higher suggested severity comes first, then age.
+func (r *ReportRepository) ListQueue(ctx context.Context, params ListQueueParams) ([]model.Report, int64, error) {
+	var reports []model.Report
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Where("status IN ?", []string{model.StatusSubmitted, model.StatusUnderReview}).
+		// Disputed reports wait for a lead, not for another triager
+		Where("NOT EXISTS (SELECT 1 FROM consensus_rounds WHERE consensus_rounds.report_id = reports.id AND consensus_rounds.status = ?)", model.ConsensusStatusDisputed)
+
+	if len(params.ExcludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", params.ExcludeIDs)
+	}
+	if params.Category != "" {
+		query = query.Where("category = ?", params.Category)
+	}
+	if params.ZoneID != "" {
+		query = query.Where("zone_id = ?", params.ZoneID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Order("COALESCE(severity_suggested, '') = '' ASC").
+		Order("severity_suggested DESC").
+		Order("created_at ASC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&reports).Error
 	return reports, total, err
 }
 
+// ListPending retrieves reports still awaiting triage that were created before the given time
+func (r *ReportRepository) ListPending(ctx context.Context, createdBefore time.Time) ([]model.Report, error) {
+	var reports []model.Report
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND created_at <= ?", []string{model.StatusSubmitted, model.StatusUnderReview}, createdBefore).
+		Order("created_at ASC").
+		Find(&reports).Error
+	return reports, err
+}
+
+// GetByIDs retrieves the reports with the given IDs
+func (r *ReportRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Report, error) {
+	var reports []model.Report
+	if len(ids) == 0 {
+		return reports, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Order("created_at ASC").
+		Find(&reports).Error
+	return reports, err
+}
+
+// ListRecent retrieves non-spam reports created since the given time, newest first
+func (r *ReportRepository) ListRecent(ctx context.Context, since time.Time, excludeID uuid.UUID, limit int) ([]model.Report, error) {
+	var reports []model.Report
+	err := r.db.WithContext(ctx).
+		Where("created_at >= ? AND id <> ? AND status <> ?", since, excludeID, model.StatusSpam).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&reports).Error
+	return reports, err
+}
+
+// GetByFollowUpHash retrieves a report by the hash of its follow-up token
+func (r *ReportRepository) GetByFollowUpHash(ctx context.Context, hash string) (*model.Report, error) {
+	var report model.Report
+	err := r.db.WithContext(ctx).First(&report, "follow_up_hash = ?", hash).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &report, err
+}
+
 // Update updates a report
 func (r *ReportRepository) Update(ctx context.Context, report *model.Report) error {
 	return r.db.WithContext(ctx).Save(report).Error
@@ -89,11 +289,44 @@ func (r *ReportRepository) UpdateStatus(ctx context.Context, id uuid.UUID, statu
 		Update("status", status).Error
 }
 
+// TransitionStatus moves a report from one status to another. It reports
+// false when the report is no longer in the expected status.
+func (r *ReportRepository) TransitionStatus(ctx context.Context, id uuid.UUID, from, to string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": time.Now().UTC(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
 // Delete deletes a report
 func (r *ReportRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.db.WithContext(ctx).Delete(&model.Report{}, "id = ?", id).Error
 }
 
+// CountCreatedSince counts non-spam, non-quarantined reports created since the given time
+func (r *ReportRepository) CountCreatedSince(ctx context.Context, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Where("created_at >= ? AND status NOT IN ?", since, []string{model.StatusSpam, model.StatusQuarantined}).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByDeviceSince counts reports submitted from a device since the given time
+func (r *ReportRepository) CountByDeviceSince(ctx context.Context, deviceHash string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Where("device_hash = ? AND created_at >= ?", deviceHash, since).
+		Count(&count).Error
+	return count, err
+}
+
 // GetStats retrieves report statistics
 func (r *ReportRepository) GetStats(ctx context.Context) (*ReportStats, error) {
 	var stats ReportStats
@@ -137,17 +370,75 @@ func (r *ReportRepository) GetStats(ctx context.Context) (*ReportStats, error) {
 		stats.ByCategory[cc.Category] = cc.Count
 	}
 
+	// Reports by pilot zone
+	var zoneCounts []struct {
+		ZoneID string
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&model.Report{}).
+		Select("zone_id, count(*) as count").
+		Where("zone_id <> ''").
+		Group("zone_id").
+		Scan(&zoneCounts).Error; err != nil {
+		return nil, err
+	}
+	stats.ByZone = make(map[string]int64)
+	for _, zc := range zoneCounts {
+		stats.ByZone[zc.ZoneID] = zc.Count
+	}
+
 	return &stats, nil
 }
 
 // ListReportParams represents parameters for listing reports
 type ListReportParams struct {
-	Page     int
-	PageSize int
-	Status   string
-	Category string
-	SortBy   string
-	SortDir  string
+	Page       int
+	PageSize   int
+	Status     string
+	Category   string
+	IncidentID uuid.UUID
+	ZoneID     string
+	SortBy     string
+	SortDir    string
+	After      *Cursor // keyset position; only valid when sorting by created_at
+
+	ImportBatchID uuid.UUID
+}
+
+// SearchReportParams represents parameters for searching reports
+type SearchReportParams struct {
+	Page              int
+	PageSize          int
+	Query             search.Query
+	From              time.Time
+	To                time.Time // exclusive
+	Status            string
+	Category          string
+	SeveritySuggested string
+	SeverityFinal     string
+	EvidenceLevel     string
+	ZoneID            string
+	IncidentID        uuid.UUID
+}
+
+// SearchFacets holds per-value report counts for a search
+type SearchFacets struct {
+	Status            map[string]int64
+	Category          map[string]int64
+	SeveritySuggested map[string]int64
+	SeverityFinal     map[string]int64
+	EvidenceLevel     map[string]int64
+	Zone              map[string]int64
+}
+
+// ListQueueParams represents parameters for listing the triage queue
+type ListQueueParams struct {
+	Page       int
+	PageSize   int
+	Category   string
+	ZoneID     string
+	ExcludeIDs []uuid.UUID
 }
 
 // ReportStats represents report statistics
@@ -155,4 +446,5 @@ type ReportStats struct {
 	Total      int64
 	ByStatus   map[string]int64
 	ByCategory map[string]int64
+	ByZone     map[string]int64
 }
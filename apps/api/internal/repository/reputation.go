@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// ReputationRepository handles reporter reputation database operations
+type ReputationRepository struct {
+	db *gorm.DB
+}
+
+// NewReputationRepository creates a new reputation repository
+func NewReputationRepository(db *DB) *ReputationRepository {
+	return &ReputationRepository{db: db.Gorm}
+}
+
+// outcomeColumns maps an outcome to the counter it increments
+var outcomeColumns = map[string]string{
+	model.OutcomeAccepted: "accepted",
+	model.OutcomeRejected: "rejected",
+	model.OutcomeSpam:     "spam",
+}
+
+// Get retrieves the reputation for a key
+func (r *ReputationRepository) Get(ctx context.Context, key string) (*model.ReporterReputation, error) {
+	var rep model.ReporterReputation
+	err := r.db.WithContext(ctx).First(&rep, "key = ?", key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &rep, err
+}
+
+// GetMany retrieves the reputations for the given keys
+func (r *ReputationRepository) GetMany(ctx context.Context, keys []string) ([]model.ReporterReputation, error) {
+	var reps []model.ReporterReputation
+	if len(keys) == 0 {
+		return reps, nil
+	}
+	err := r.db.WithContext(ctx).Where("key IN ?", keys).Find(&reps).Error
+	return reps, err
+}
+
+// Rotate moves the counts and outcomes held under oldKey to newKey and
+// deletes the old row
+func (r *ReputationRepository) Rotate(ctx context.Context, oldKey, newKey string, expiresAt time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var old model.ReporterReputation
+		err := tx.First(&old, "key = ?", oldKey).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		rotated := old
+		rotated.Key = newKey
+		rotated.ExpiresAt = expiresAt
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "key"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"accepted":      gorm.Expr("reporter_reputations.accepted + ?", old.Accepted),
+				"rejected":      gorm.Expr("reporter_reputations.rejected + ?", old.Rejected),
+				"spam":          gorm.Expr("reporter_reputations.spam + ?", old.Spam),
+				"first_seen_at": gorm.Expr("LEAST(reporter_reputations.first_seen_at, ?)", old.FirstSeenAt),
+			}),
+		}).Create(&rotated).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&model.ReputationOutcome{}).
+			Where("reporter_key = ?", oldKey).
+			Update("reporter_key", newKey).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.ReporterReputation{}, "key = ?", oldKey).Error
+	})
+}
+
+// GetOutcome retrieves the outcome a report last contributed
+func (r *ReputationRepository) GetOutcome(ctx context.Context, reportID uuid.UUID) (*model.ReputationOutcome, error) {
+	var outcome model.ReputationOutcome
+	err := r.db.WithContext(ctx).First(&outcome, "report_id = ?", reportID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &outcome, err
+}
+
+// ApplyOutcome credits outcome to its reporter key, first withdrawing the
+// previous outcome for the same report if there was one
+func (r *ReputationRepository) ApplyOutcome(ctx context.Context, outcome, previous *model.ReputationOutcome) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if previous != nil {
+			column := outcomeColumns[previous.Outcome]
+			if err := tx.Model(&model.ReporterReputation{}).
+				Where("key = ? AND "+column+" > 0", previous.ReporterKey).
+				Update(column, gorm.Expr(column+" - 1")).Error; err != nil {
+				return err
+			}
+		}
+
+		column := outcomeColumns[outcome.Outcome]
+		rep := model.ReporterReputation{
+			Key:         outcome.ReporterKey,
+			FirstSeenAt: outcome.RecordedAt,
+			LastSeenAt:  outcome.RecordedAt,
+			ExpiresAt:   outcome.ExpiresAt,
+		}
+		switch outcome.Outcome {
+		case model.OutcomeAccepted:
+			rep.Accepted = 1
+		case model.OutcomeRejected:
+			rep.Rejected = 1
+		case model.OutcomeSpam:
+			rep.Spam = 1
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "key"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				column:         gorm.Expr("reporter_reputations." + column + " + 1"),
+				"last_seen_at": outcome.RecordedAt,
+				"expires_at":   outcome.ExpiresAt,
+			}),
+		}).Create(&rep).Error; err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "report_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"reporter_key", "outcome", "recorded_at", "expires_at"}),
+		}).Create(outcome).Error
+	})
+}
+
+// RemoveOutcome withdraws a report's outcome from its reporter key
+func (r *ReputationRepository) RemoveOutcome(ctx context.Context, outcome *model.ReputationOutcome) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		column := outcomeColumns[outcome.Outcome]
+		if err := tx.Model(&model.ReporterReputation{}).
+			Where("key = ? AND "+column+" > 0", outcome.ReporterKey).
+			Update(column, gorm.Expr(column+" - 1")).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.ReputationOutcome{}, "report_id = ?", outcome.ReportID).Error
+	})
+}
+
+// DeleteExpired removes reputations and outcomes past their expiry
+func (r *ReputationRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	if err := r.db.WithContext(ctx).
+		Where("expires_at <= ?", now).
+		Delete(&model.ReputationOutcome{}).Error; err != nil {
+		return 0, err
+	}
+	result := r.db.WithContext(ctx).
+		Where("expires_at <= ?", now).
+		Delete(&model.ReporterReputation{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// RetentionRepository finds and purges data past its retention window. Purge
+// methods work on at most limit records per call so that long backlogs are
+// cleared in short transactions; callers repeat until fewer than limit are
+// purged.
+type RetentionRepository struct {
+	db *gorm.DB
+}
+
+// NewRetentionRepository creates a new retention repository
+func NewRetentionRepository(db *DB) *RetentionRepository {
+	return &RetentionRepository{db: db.Gorm}
+}
+
+// expiredReports selects decided reports (triaged, escalated, closed or spam)
+// created before the cutoff whose content has not been purged yet. Reports
+// still waiting for a decision are kept until they get one.
+func expiredReports(tx *gorm.DB, before time.Time) *gorm.DB {
+	return tx.Model(&model.Report{}).
+		Where("status IN ? AND created_at < ? AND purged_at IS NULL",
+			[]string{model.StatusTriaged, model.StatusEscalated, model.StatusClosed, model.StatusSpam}, before)
+}
+
+// expiredContacts selects reports created before the cutoff that still hold
+// a contact ref or pseudonymous hash
+func expiredContacts(tx *gorm.DB, before time.Time) *gorm.DB {
+	return tx.Model(&model.Report{}).
+		Where("created_at < ?", before).
+		Where("reporter_contact_ref <> '' OR device_hash <> '' OR network_hash <> '' OR follow_up_hash <> ''")
+}
+
+// expiredEvidence selects evidence files stored before the cutoff
+func expiredEvidence(tx *gorm.DB, before time.Time) *gorm.DB {
+	return tx.Model(&model.Evidence{}).Where("created_at < ?", before)
+}
+
+// expiredAuditIPs selects audit entries before the cutoff that still hold an IP
+func expiredAuditIPs(tx *gorm.DB, before time.Time) *gorm.DB {
+	return tx.Model(&model.AuditLog{}).Where("ts < ? AND actor_ip <> ''", before)
+}
+
+// expiredAuditLogs selects audit entries before the cutoff, except purge
+// summaries, which are the record of what retention removed
+func expiredAuditLogs(tx *gorm.DB, before time.Time) *gorm.DB {
+	return tx.Model(&model.AuditLog{}).Where("ts < ? AND object_type <> ?", before, model.ObjectTypeRetention)
+}
+
+// CountReports counts reports whose content is due to be purged and the
+// evidence files stored with them
+func (r *RetentionRepository) CountReports(ctx context.Context, before time.Time) (int64, int64, error) {
+	db := r.db.WithContext(ctx)
+	var reports, files int64
+	if err := expiredReports(db, before).Count(&reports).Error; err != nil {
+		return 0, 0, err
+	}
+	err := db.Model(&model.Evidence{}).
+		Where("report_id IN (?)", expiredReports(db, before).Select("id")).
+		Count(&files).Error
+	return reports, files, err
+}
+
+// PurgeReports anonymizes up to limit expired reports: their text, evidence
+// refs, contact ref and pseudonymous hashes are cleared and their evidence
+// files deleted. Category, status, zone, channel, timestamps and triage
+// decisions are kept for the KPI figures. It returns the reports and files
+// purged.
+func (r *RetentionRepository) PurgeReports(ctx context.Context, before, now time.Time, limit int) (int64, int64, error) {
+	var reports, files int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		if err := expiredReports(tx, before).Limit(limit).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		var err error
+		reports, files, err = anonymizeReports(tx, ids, now)
+		return err
+	})
+	return reports, files, err
+}
+
+// anonymizeReports clears the content, contact ref and pseudonymous hashes of
+// reports and deletes their evidence files, keeping what the KPI figures are
+// computed from. It returns the reports and files purged.
+func anonymizeReports(tx *gorm.DB, ids []uuid.UUID, now time.Time) (int64, int64, error) {
+	res := tx.Where("report_id IN ?", ids).Delete(&model.Evidence{})
+	if res.Error != nil {
+		return 0, 0, res.Error
+	}
+	files := res.RowsAffected
+
+	res = tx.Model(&model.Report{}).
+		Where("id IN ?", ids).
+		UpdateColumns(map[string]interface{}{
+			"area_hint":            "",
+			"time_window":          "",
+			"description":          "",
+			"evidence_refs":        model.StringArray{},
+			"reporter_contact_ref": "",
+			"risk_signals":         model.StringArray{},
+			"simhash":              0,
+			"device_hash":          "",
+			"network_hash":         "",
+			"follow_up_hash":       nil, // NULL, as follow-up hashes are unique
+			"purged_at":            now,
+		})
+	return res.RowsAffected, files, res.Error
+}
+
+// CountContacts counts reports whose contact ref or hashes are due to be cleared
+func (r *RetentionRepository) CountContacts(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+	err := expiredContacts(r.db.WithContext(ctx), before).Count(&n).Error
+	return n, err
+}
+
+// PurgeContacts clears the contact ref and pseudonymous hashes of up to limit
+// expired reports
+func (r *RetentionRepository) PurgeContacts(ctx context.Context, before time.Time, limit int) (int64, error) {
+	db := r.db.WithContext(ctx)
+	res := db.Model(&model.Report{}).
+		Where("id IN (?)", expiredContacts(db, before).Select("id").Limit(limit)).
+		UpdateColumns(map[string]interface{}{
+			"reporter_contact_ref": "",
+			"device_hash":          "",
+			"network_hash":         "",
+			"follow_up_hash":       nil,
+		})
+	return res.RowsAffected, res.Error
+}
+
+// CountEvidence counts evidence files due to be deleted
+func (r *RetentionRepository) CountEvidence(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+	err := expiredEvidence(r.db.WithContext(ctx), before).Count(&n).Error
+	return n, err
+}
+
+// PurgeEvidence deletes up to limit expired evidence files and removes their
+// refs from the reports that listed them
+func (r *RetentionRepository) PurgeEvidence(ctx context.Context, before time.Time, limit int) (int64, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var files []model.Evidence
+		if err := expiredEvidence(tx, before).Select("id", "report_id").Limit(limit).Find(&files).Error; err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(files))
+		for i, f := range files {
+			ids[i] = f.ID
+			err := tx.Model(&model.Report{}).
+				Where("id = ?", f.ReportID).
+				UpdateColumn("evidence_refs", gorm.Expr("evidence_refs - ?::text", f.Ref())).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		res := tx.Where("id IN ?", ids).Delete(&model.Evidence{})
+		n = res.RowsAffected
+		return res.Error
+	})
+	return n, err
+}
+
+// CountAuditIPs counts audit entries whose actor IP is due to be cleared
+func (r *RetentionRepository) CountAuditIPs(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+	err := expiredAuditIPs(r.db.WithContext(ctx), before).Count(&n).Error
+	return n, err
+}
+
+// PurgeAuditIPs clears the actor IP of up to limit expired audit entries
+func (r *RetentionRepository) PurgeAuditIPs(ctx context.Context, before time.Time, limit int) (int64, error) {
+	db := r.db.WithContext(ctx)
+	res := db.Model(&model.AuditLog{}).
+		Where("id IN (?)", expiredAuditIPs(db, before).Select("id").Limit(limit)).
+		UpdateColumn("actor_ip", "")
+	return res.RowsAffected, res.Error
+}
+
+// CountAuditLogs counts audit entries due to be deleted
+func (r *RetentionRepository) CountAuditLogs(ctx context.Context, before time.Time) (int64, error) {
+	var n int64
+	err := expiredAuditLogs(r.db.WithContext(ctx), before).Count(&n).Error
+	return n, err
+}
+
+// PurgeAuditLogs deletes up to limit expired audit entries
+func (r *RetentionRepository) PurgeAuditLogs(ctx context.Context, before time.Time, limit int) (int64, error) {
+	db := r.db.WithContext(ctx)
+	res := db.Where("id IN (?)", expiredAuditLogs(db, before).Select("id").Limit(limit)).
+		Delete(&model.AuditLog{})
+	return res.RowsAffected, res.Error
+}
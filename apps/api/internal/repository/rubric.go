@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// RubricRepository handles triage rubric database operations
+type RubricRepository struct {
+	db *gorm.DB
+}
+
+// NewRubricRepository creates a new rubric repository
+func NewRubricRepository(db *DB) *RubricRepository {
+	return &RubricRepository{db: db.Gorm}
+}
+
+// GetActive retrieves the active rubric, or nil when there is none
+func (r *RubricRepository) GetActive(ctx context.Context) (*model.TriageRubric, error) {
+	var rubric model.TriageRubric
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		Where("active = ?", true).
+		First(&rubric).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rubric, nil
+}
+
+// GetByVersion retrieves a rubric version
+func (r *RubricRepository) GetByVersion(ctx context.Context, version int) (*model.TriageRubric, error) {
+	var rubric model.TriageRubric
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		Where("version = ?", version).
+		First(&rubric).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rubric, nil
+}
+
+// List retrieves all rubric versions, newest first
+func (r *RubricRepository) List(ctx context.Context) ([]model.TriageRubric, error) {
+	var rubrics []model.TriageRubric
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		Order("version DESC").
+		Find(&rubrics).Error
+	return rubrics, err
+}
+
+// Create stores a rubric as the next version and makes it the active one
+func (r *RubricRepository) Create(ctx context.Context, rubric *model.TriageRubric) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Two concurrent saves would take the same number; the primary
+		// key makes the later one fail instead
+		var latest int
+		if err := tx.Model(&model.TriageRubric{}).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&latest).Error; err != nil {
+			return err
+		}
+		if err := deactivateRubrics(tx); err != nil {
+			return err
+		}
+		rubric.Version = latest + 1
+		rubric.Active = true
+		return tx.Create(rubric).Error
+	})
+}
+
+// Activate makes an existing version the active one. It reports false when
+// the version does not exist.
+func (r *RubricRepository) Activate(ctx context.Context, version int) (bool, error) {
+	found := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := deactivateRubrics(tx); err != nil {
+			return err
+		}
+		result := tx.Model(&model.TriageRubric{}).
+			Where("version = ?", version).
+			Update("active", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// Roll back so the current version stays active
+			return gorm.ErrRecordNotFound
+		}
+		found = true
+		return nil
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return found, err
+}
+
+// deactivateRubrics clears the active flag ahead of activating a version
+func deactivateRubrics(tx *gorm.DB) error {
+	return tx.Model(&model.TriageRubric{}).
+		Where("active = ?", true).
+		Update("active", false).Error
+}
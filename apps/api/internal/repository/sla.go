@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// SLARepository handles SLA policy and breach database operations
+type SLARepository struct {
+	db *gorm.DB
+}
+
+// NewSLARepository creates a new SLA repository
+func NewSLARepository(db *DB) *SLARepository {
+	return &SLARepository{db: db.Gorm}
+}
+
+// ListPolicies retrieves all SLA policies
+func (r *SLARepository) ListPolicies(ctx context.Context) ([]model.SLAPolicy, error) {
+	var policies []model.SLAPolicy
+	err := r.db.WithContext(ctx).Order("severity DESC").Find(&policies).Error
+	return policies, err
+}
+
+// SavePolicy creates or replaces the policy for a severity
+func (r *SLARepository) SavePolicy(ctx context.Context, policy *model.SLAPolicy) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(policy).Error
+}
+
+// CreateBreach records a breach. It reports false when the report already
+// has a breach at this level.
+func (r *SLARepository) CreateBreach(ctx context.Context, breach *model.SLABreach) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(breach)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetBreach retrieves a report's breach at a level, or nil
+func (r *SLARepository) GetBreach(ctx context.Context, reportID uuid.UUID, level string) (*model.SLABreach, error) {
+	var breach model.SLABreach
+	err := r.db.WithContext(ctx).
+		First(&breach, "report_id = ? AND level = ?", reportID, level).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &breach, err
+}
+
+// SetNotified records who was notified about a breach, and when
+func (r *SLARepository) SetNotified(ctx context.Context, id uuid.UUID, userIDs model.StringArray, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.SLABreach{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"notified":    userIDs,
+			"notified_at": at,
+		}).Error
+}
+
+// ResolveBreaches closes open breaches for reports that have left the queue
+func (r *SLARepository) ResolveBreaches(ctx context.Context, now time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.SLABreach{}).
+		Where("resolved_at IS NULL").
+		Where("report_id IN (?)", r.db.Model(&model.Report{}).
+			Select("id").
+			Where("status NOT IN ?", []string{model.StatusSubmitted, model.StatusUnderReview})).
+		Update("resolved_at", now).Error
+}
+
+// ListBreaches retrieves breaches with pagination and filtering
+func (r *SLARepository) ListBreaches(ctx context.Context, params ListBreachParams) ([]model.SLABreach, int64, error) {
+	var breaches []model.SLABreach
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.SLABreach{})
+
+	// Apply filters
+	if params.Level != "" {
+		query = query.Where("level = ?", params.Level)
+	}
+	if params.Severity != "" {
+		query = query.Where("severity = ?", params.Severity)
+	}
+	if params.OpenOnly {
+		query = query.Where("resolved_at IS NULL")
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Order("detected_at DESC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&breaches).Error
+	return breaches, total, err
+}
+
+// GetStats summarizes breaches per severity and level
+func (r *SLARepository) GetStats(ctx context.Context, since time.Time) ([]SLAStat, error) {
+	var stats []SLAStat
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			severity,
+			level,
+			COUNT(*) AS count,
+			COUNT(*) FILTER (WHERE resolved_at IS NULL) AS open,
+			COALESCE(AVG(EXTRACT(EPOCH FROM (COALESCE(resolved_at, NOW()) - due_at)) / 60)
+				FILTER (WHERE level = 'breach'), 0) AS avg_overrun_minutes
+		FROM sla_breaches
+		WHERE detected_at >= ?
+		GROUP BY severity, level
+		ORDER BY severity DESC, level
+	`, since).Scan(&stats).Error
+	return stats, err
+}
+
+// ListBreachParams represents parameters for listing SLA breaches
+type ListBreachParams struct {
+	Page     int
+	PageSize int
+	Level    string
+	Severity string
+	OpenOnly bool
+}
+
+// SLAStat represents breach counts for a severity and level
+type SLAStat struct {
+	Severity          string
+	Level             string
+	Count             int64
+	Open              int64
+	AvgOverrunMinutes float64
+}
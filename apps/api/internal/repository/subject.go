@@ -0,0 +1,296 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// subjectScanBatch is how many contact refs are decrypted per query when
+// looking a subject up by contact
+const subjectScanBatch = 1000
+
+// SubjectRepository handles data subject requests and finds, exports and
+// erases the data held about a subject
+type SubjectRepository struct {
+	db *gorm.DB
+}
+
+// NewSubjectRepository creates a new subject request repository
+func NewSubjectRepository(db *DB) *SubjectRepository {
+	return &SubjectRepository{db: db.Gorm}
+}
+
+// ListSubjectParams contains parameters for listing subject requests
+type ListSubjectParams struct {
+	Page     int
+	PageSize int
+	Status   string
+	Kind     string
+	Overdue  bool // open requests past their deadline only
+	Now      time.Time
+}
+
+// SubjectData is everything held about a data subject
+type SubjectData struct {
+	Reports       []model.Report
+	Evidence      []model.Evidence
+	Appeals       []model.Appeal
+	Subscriptions []model.ReportSubscriber
+	SubmissionIPs []model.AuditLog // report creation entries that recorded the submitter's IP
+	QuizResults   []model.QuizResult
+	Participants  []model.TrainingParticipant
+}
+
+// SubjectErasure counts what an erasure changed, by class
+type SubjectErasure struct {
+	Reports       int64
+	Evidence      int64
+	Appeals       int64
+	Subscriptions int64
+	Reputation    int64
+	SubmissionIPs int64
+	QuizResults   int64
+	Participants  int64
+}
+
+// Create creates a new subject request
+func (r *SubjectRepository) Create(ctx context.Context, req *model.SubjectRequest) error {
+	return r.db.WithContext(ctx).Create(req).Error
+}
+
+// GetByID retrieves a subject request with the staff who handled it
+func (r *SubjectRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.SubjectRequest, error) {
+	var req model.SubjectRequest
+	err := r.db.WithContext(ctx).
+		Preload("Creator").
+		Preload("Verifier").
+		Preload("Closer").
+		First(&req, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &req, err
+}
+
+// List retrieves subject requests with pagination, soonest deadline first
+func (r *SubjectRepository) List(ctx context.Context, params ListSubjectParams) ([]model.SubjectRequest, int64, error) {
+	var reqs []model.SubjectRequest
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.SubjectRequest{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Kind != "" {
+		query = query.Where("kind = ?", params.Kind)
+	}
+	if params.Overdue {
+		query = query.Where("status IN ? AND due_at < ?", openSubjectStatuses, params.Now)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	err := query.
+		Order("due_at ASC").
+		Offset(offset).
+		Limit(params.PageSize).
+		Find(&reqs).Error
+	return reqs, total, err
+}
+
+// openSubjectStatuses are the statuses of requests still awaiting fulfilment
+var openSubjectStatuses = []string{model.SubjectStatusReceived, model.SubjectStatusVerified}
+
+// Transition applies updates to a request if it is in one of the given
+// statuses. It returns false if it was not.
+func (r *SubjectRepository) Transition(ctx context.Context, id uuid.UUID, from []string, updates map[string]interface{}) (bool, error) {
+	updates["updated_at"] = time.Now().UTC()
+	result := r.db.WithContext(ctx).
+		Model(&model.SubjectRequest{}).
+		Where("id = ? AND status IN ?", id, from).
+		Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListDue returns open requests due before the given time that have not had
+// the notification recorded in column yet
+func (r *SubjectRepository) ListDue(ctx context.Context, before time.Time, column string) ([]model.SubjectRequest, error) {
+	var reqs []model.SubjectRequest
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND due_at < ?", openSubjectStatuses, before).
+		Where(column + " IS NULL").
+		Order("due_at ASC").
+		Find(&reqs).Error
+	return reqs, err
+}
+
+// MarkNotified records when a deadline notification was sent
+func (r *SubjectRepository) MarkNotified(ctx context.Context, id uuid.UUID, column string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.SubjectRequest{}).
+		Where("id = ?", id).
+		UpdateColumn(column, at).Error
+}
+
+// FindReports returns the IDs of the reports filed with a follow-up token
+// hash or with a contact ref accepted by matchContact. Contact refs are
+// encrypted, so every report holding one is read and decrypted in batches.
+func (r *SubjectRepository) FindReports(ctx context.Context, followUpHash string, matchContact func(string) bool) ([]uuid.UUID, error) {
+	db := r.db.WithContext(ctx)
+	var ids []uuid.UUID
+	if followUpHash != "" {
+		if err := db.Model(&model.Report{}).Where("follow_up_hash = ?", followUpHash).Pluck("id", &ids).Error; err != nil {
+			return nil, err
+		}
+	}
+	if matchContact == nil {
+		return ids, nil
+	}
+
+	after := uuid.Nil
+	for {
+		var batch []model.Report
+		err := db.Select("id", "reporter_contact_ref").
+			Where("id > ? AND reporter_contact_ref <> ''", after).
+			Order("id ASC").
+			Limit(subjectScanBatch).
+			Find(&batch).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, report := range batch {
+			after = report.ID
+			if matchContact(string(report.ReporterContactRef)) && !containsID(ids, report.ID) {
+				ids = append(ids, report.ID)
+			}
+		}
+		if len(batch) < subjectScanBatch {
+			return ids, nil
+		}
+	}
+}
+
+// LoadData loads everything held about a subject: the given reports with
+// their evidence, appeals, chat subscriptions and submitter IPs, and the
+// training records under the participant hash
+func (r *SubjectRepository) LoadData(ctx context.Context, reportIDs []uuid.UUID, participantHash string) (*SubjectData, error) {
+	db := r.db.WithContext(ctx)
+	data := &SubjectData{}
+
+	if len(reportIDs) > 0 {
+		if err := db.Where("id IN ?", reportIDs).Order("created_at ASC").Find(&data.Reports).Error; err != nil {
+			return nil, err
+		}
+		if err := db.Where("report_id IN ?", reportIDs).Order("created_at ASC").Find(&data.Evidence).Error; err != nil {
+			return nil, err
+		}
+		if err := db.Where("report_id IN ?", reportIDs).Order("created_at ASC").Find(&data.Appeals).Error; err != nil {
+			return nil, err
+		}
+		if err := db.Where("report_id IN ?", reportIDs).Order("created_at ASC").Find(&data.Subscriptions).Error; err != nil {
+			return nil, err
+		}
+		if err := submissionIPs(db, reportIDs).Order("ts ASC").Find(&data.SubmissionIPs).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if participantHash != "" {
+		if err := db.Where("participant_hash = ?", participantHash).Order("created_at ASC").Find(&data.QuizResults).Error; err != nil {
+			return nil, err
+		}
+		if err := db.Where("participant_hash = ?", participantHash).Order("created_at ASC").Find(&data.Participants).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// Erase erases a subject's data in one transaction. Reports are anonymized
+// as retention does and their evidence files deleted; appeal statements,
+// chat subscriptions, reputation outcomes and submitter IPs of the reports
+// are removed. Quiz results lose their participant hash and answers but keep
+// their scores; training participant records are deleted.
+func (r *SubjectRepository) Erase(ctx context.Context, reportIDs []uuid.UUID, participantHash string, now time.Time) (*SubjectErasure, error) {
+	erased := &SubjectErasure{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(reportIDs) > 0 {
+			var err error
+			erased.Reports, erased.Evidence, err = anonymizeReports(tx, reportIDs, now)
+			if err != nil {
+				return err
+			}
+
+			res := tx.Model(&model.Appeal{}).
+				Where("report_id IN ? AND message <> ''", reportIDs).
+				UpdateColumn("message", "")
+			if res.Error != nil {
+				return res.Error
+			}
+			erased.Appeals = res.RowsAffected
+
+			res = tx.Where("report_id IN ?", reportIDs).Delete(&model.ReportSubscriber{})
+			if res.Error != nil {
+				return res.Error
+			}
+			erased.Subscriptions = res.RowsAffected
+
+			res = tx.Where("report_id IN ?", reportIDs).Delete(&model.ReputationOutcome{})
+			if res.Error != nil {
+				return res.Error
+			}
+			erased.Reputation = res.RowsAffected
+
+			res = submissionIPs(tx, reportIDs).UpdateColumn("actor_ip", "")
+			if res.Error != nil {
+				return res.Error
+			}
+			erased.SubmissionIPs = res.RowsAffected
+		}
+
+		if participantHash != "" {
+			res := tx.Model(&model.QuizResult{}).
+				Where("participant_hash = ?", participantHash).
+				UpdateColumns(map[string]interface{}{"participant_hash": "", "answers": nil})
+			if res.Error != nil {
+				return res.Error
+			}
+			erased.QuizResults = res.RowsAffected
+
+			res = tx.Where("participant_hash = ?", participantHash).Delete(&model.TrainingParticipant{})
+			if res.Error != nil {
+				return res.Error
+			}
+			erased.Participants = res.RowsAffected
+		}
+		return nil
+	})
+	return erased, err
+}
+
+// submissionIPs selects the audit entries of the reports' creation that hold
+// the submitter's IP
+func submissionIPs(tx *gorm.DB, reportIDs []uuid.UUID) *gorm.DB {
+	return tx.Model(&model.AuditLog{}).
+		Where("object_type = ? AND action = ? AND object_id IN ? AND actor_ip <> ''",
+			model.ObjectTypeReport, model.ActionCreate, reportIDs)
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
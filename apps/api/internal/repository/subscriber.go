@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+)
+
+// SubscriberRepository handles report subscriber database operations
+type SubscriberRepository struct {
+	db *gorm.DB
+}
+
+// NewSubscriberRepository creates a new subscriber repository
+func NewSubscriberRepository(db *DB) *SubscriberRepository {
+	return &SubscriberRepository{db: db.Gorm}
+}
+
+// SubscriberStatus is a subscription with its report's current status
+type SubscriberStatus struct {
+	model.ReportSubscriber
+	Status string
+}
+
+// Create creates a new subscription
+func (r *SubscriberRepository) Create(ctx context.Context, subscriber *model.ReportSubscriber) error {
+	return r.db.WithContext(ctx).Create(subscriber).Error
+}
+
+// ListActive returns the unexpired subscriptions with the current status of
+// their reports
+func (r *SubscriberRepository) ListActive(ctx context.Context, now time.Time) ([]SubscriberStatus, error) {
+	var rows []SubscriberStatus
+	err := r.db.WithContext(ctx).
+		Table("report_subscribers").
+		Select("report_subscribers.*, reports.status AS status").
+		Joins("JOIN reports ON reports.id = report_subscribers.report_id").
+		Where("report_subscribers.expires_at > ?", now).
+		Order("report_subscribers.created_at ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// UpdateLastStatus records the status last sent to a subscriber
+func (r *SubscriberRepository) UpdateLastStatus(ctx context.Context, id uuid.UUID, status string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.ReportSubscriber{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"last_status": status, "updated_at": time.Now().UTC()}).Error
+}
+
+// Delete deletes a subscription
+func (r *SubscriberRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&model.ReportSubscriber{}, "id = ?", id).Error
+}
+
+// DeleteExpired deletes the subscriptions that expired before now
+func (r *SubscriberRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&model.ReportSubscriber{})
+	return result.RowsAffected, result.Error
+}
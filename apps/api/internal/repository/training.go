@@ -38,7 +38,8 @@ func (r *TrainingRepository) GetEventByID(ctx context.Context, id uuid.UUID) (*m
 	return &event, err
 }
 
-// ListEvents retrieves training events with pagination
+// ListEvents retrieves training events with pagination. When params.After is
+// set the list continues from that cursor and the total is not counted.
 func (r *TrainingRepository) ListEvents(ctx context.Context, params ListTrainingParams) ([]model.TrainingEvent, int64, error) {
 	var events []model.TrainingEvent
 	var total int64
@@ -53,18 +54,15 @@ func (r *TrainingRepository) ListEvents(ctx context.Context, params ListTraining
 		query = query.Where("event_date <= ?", params.To)
 	}
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	// Get total count (skipped when following a cursor)
+	if params.After == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// Apply pagination
-	offset := (params.Page - 1) * params.PageSize
-	query = query.
-		Preload("Creator").
-		Order("event_date DESC").
-		Offset(offset).
-		Limit(params.PageSize)
+	query = paginate(query.Preload("Creator"), "event_date", true, params.Page, params.PageSize, params.After)
 
 	err := query.Find(&events).Error
 	return events, total, err
@@ -143,6 +141,7 @@ type ListTrainingParams struct {
 	PageSize int
 	From     time.Time
 	To       time.Time
+	After    *Cursor
 }
 
 // TrainingStats represents training statistics
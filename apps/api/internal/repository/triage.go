@@ -11,6 +11,13 @@ import (
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
 )
 
+// ErrBatchConflict is returned when a report in a batch no longer has the
+// status the batch was planned against
+var ErrBatchConflict = errors.New("report status changed during batch")
+
+// errStale rolls back a transaction whose row changed since it was loaded
+var errStale = errors.New("row changed")
+
 // TriageRepository handles triage decision database operations
 type TriageRepository struct {
 	db *gorm.DB
@@ -21,9 +28,93 @@ func NewTriageRepository(db *DB) *TriageRepository {
 	return &TriageRepository{db: db.Gorm}
 }
 
-// Create creates a new triage decision
-func (r *TriageRepository) Create(ctx context.Context, decision *model.TriageDecision) error {
-	return r.db.WithContext(ctx).Create(decision).Error
+// Decide records a decision and moves its report from status from to to in
+// one transaction. It reports false, recording nothing, when the report has
+// left status from.
+func (r *TriageRepository) Decide(ctx context.Context, decision *model.TriageDecision, from, to string) (bool, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return decide(tx, decision, from, to)
+	})
+	if errors.Is(err, errStale) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// decide moves a report from status from to to and records the decision,
+// linking it to its consensus round if it closes one. It returns errStale
+// when the report has left status from.
+func decide(tx *gorm.DB, decision *model.TriageDecision, from, to string) error {
+	updates := map[string]interface{}{"updated_at": time.Now().UTC()}
+	if to != from {
+		updates["status"] = to
+	}
+	result := tx.Model(&model.Report{}).
+		Where("id = ? AND status = ?", decision.ReportID, from).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errStale
+	}
+
+	if err := tx.Create(decision).Error; err != nil {
+		return err
+	}
+	if decision.RoundID == nil {
+		return nil
+	}
+	return tx.Model(&model.ConsensusRound{}).
+		Where("id = ?", *decision.RoundID).
+		Update("decision_id", decision.ID).Error
+}
+
+// ApplyBatch applies status changes, triage decisions and their audit entries
+// to many reports in one transaction. Nothing is applied when any report has
+// left its expected status (ErrBatchConflict).
+func (r *TriageRepository) ApplyBatch(ctx context.Context, items []BatchItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		incidents := make(map[uuid.UUID]bool)
+
+		for _, item := range items {
+			if item.Decision != nil {
+				if err := tx.Create(item.Decision).Error; err != nil {
+					return err
+				}
+			}
+
+			updates := map[string]interface{}{"updated_at": now}
+			if item.To != item.From {
+				updates["status"] = item.To
+			}
+			if item.IncidentID != nil {
+				updates["incident_id"] = *item.IncidentID
+				incidents[*item.IncidentID] = true
+			}
+			result := tx.Model(&model.Report{}).
+				Where("id = ? AND status = ?", item.ReportID, item.From).
+				Updates(updates)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrBatchConflict
+			}
+
+			if err := tx.Create(item.Audit).Error; err != nil {
+				return err
+			}
+		}
+
+		for incidentID := range incidents {
+			if err := touchIncident(tx, incidentID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // GetByID retrieves a triage decision by ID
@@ -50,7 +141,8 @@ func (r *TriageRepository) GetByReportID(ctx context.Context, reportID uuid.UUID
 	return decisions, err
 }
 
-// List retrieves triage decisions with pagination
+// List retrieves triage decisions with pagination. When params.After is set
+// the list continues from that cursor and the total is not counted.
 func (r *TriageRepository) List(ctx context.Context, params ListTriageParams) ([]model.TriageDecision, int64, error) {
 	var decisions []model.TriageDecision
 	var total int64
@@ -65,19 +157,15 @@ func (r *TriageRepository) List(ctx context.Context, params ListTriageParams) ([
 		query = query.Where("decision = ?", params.Decision)
 	}
 
-	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	// Get total count (skipped when following a cursor)
+	if params.After == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// Apply pagination
-	offset := (params.Page - 1) * params.PageSize
-	query = query.
-		Preload("Decider").
-		Preload("Report").
-		Order("decided_at DESC").
-		Offset(offset).
-		Limit(params.PageSize)
+	query = paginate(query.Preload("Decider").Preload("Report"), "decided_at", true, params.Page, params.PageSize, params.After)
 
 	err := query.Find(&decisions).Error
 	return decisions, total, err
@@ -168,10 +256,42 @@ func (r *TriageRepository) GetAbuseRate(ctx context.Context) (float64, error) {
 	return result.Rate, err
 }
 
+// CountTriaged counts triaged reports and the distinct incidents they represent.
+// Reports that are not part of an incident count as their own incident.
+func (r *TriageRepository) CountTriaged(ctx context.Context) (reports, incidents int64, err error) {
+	var result struct {
+		Reports   int64
+		Incidents int64
+	}
+
+	err = r.db.WithContext(ctx).Raw(`
+		SELECT 
+			COUNT(DISTINCT r.id) as reports,
+			COUNT(DISTINCT COALESCE(r.incident_id, r.id)) as incidents
+		FROM reports r
+		WHERE EXISTS (
+			SELECT 1 FROM triage_decisions td WHERE td.report_id = r.id
+		)
+	`).Scan(&result).Error
+
+	return result.Reports, result.Incidents, err
+}
+
+// BatchItem is one report change within a batch
+type BatchItem struct {
+	ReportID   uuid.UUID
+	From       string
+	To         string
+	IncidentID *uuid.UUID
+	Decision   *model.TriageDecision
+	Audit      *model.AuditLog
+}
+
 // ListTriageParams represents parameters for listing triage decisions
 type ListTriageParams struct {
 	Page     int
 	PageSize int
 	ReportID uuid.UUID
 	Decision string
+	After    *Cursor
 }
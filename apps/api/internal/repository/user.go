@@ -87,6 +87,32 @@ func (r *UserRepository) CountByRole(ctx context.Context, role string) (int64, e
 	return count, err
 }
 
+// ListActiveByRole retrieves active users with any of the given roles
+func (r *UserRepository) ListActiveByRole(ctx context.Context, roles ...string) ([]model.User, error) {
+	var users []model.User
+	err := r.db.WithContext(ctx).
+		Where("role IN ? AND is_active = true", roles).
+		Find(&users).Error
+	return users, err
+}
+
+// ListOnCall retrieves active users currently on call
+func (r *UserRepository) ListOnCall(ctx context.Context) ([]model.User, error) {
+	var users []model.User
+	err := r.db.WithContext(ctx).
+		Where("on_call = true AND is_active = true").
+		Find(&users).Error
+	return users, err
+}
+
+// SetOnCall updates a user's on-call flag
+func (r *UserRepository) SetOnCall(ctx context.Context, id uuid.UUID, onCall bool) error {
+	return r.db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ?", id).
+		Update("on_call", onCall).Error
+}
+
 // CreateAPIKey creates a new API key for a user
 func (r *UserRepository) CreateAPIKey(ctx context.Context, apiKey *model.APIKey) error {
 	return r.db.WithContext(ctx).Create(apiKey).Error
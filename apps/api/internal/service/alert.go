@@ -21,17 +21,21 @@ var (
 
 // AlertService handles alert business logic
 type AlertService struct {
-	alertRepo *repository.AlertRepository
-	auditRepo *repository.AuditRepository
-	capSender string
+	alertRepo    *repository.AlertRepository
+	incidentRepo *repository.IncidentRepository
+	auditRepo    *repository.AuditRepository
+	locationSvc  *LocationService
+	capSender    string
 }
 
 // NewAlertService creates a new alert service
-func NewAlertService(alertRepo *repository.AlertRepository, auditRepo *repository.AuditRepository, capSender string) *AlertService {
+func NewAlertService(alertRepo *repository.AlertRepository, incidentRepo *repository.IncidentRepository, auditRepo *repository.AuditRepository, locationSvc *LocationService, capSender string) *AlertService {
 	return &AlertService{
-		alertRepo: alertRepo,
-		auditRepo: auditRepo,
-		capSender: capSender,
+		alertRepo:    alertRepo,
+		incidentRepo: incidentRepo,
+		auditRepo:    auditRepo,
+		locationSvc:  locationSvc,
+		capSender:    capSender,
 	}
 }
 
@@ -46,6 +50,27 @@ func (s *AlertService) Create(ctx context.Context, req dto.CreateAlertRequest, u
 		reportID = &uid
 	}
 
+	var incidentID *uuid.UUID
+	if req.IncidentID != "" {
+		uid, err := uuid.Parse(req.IncidentID)
+		if err != nil {
+			return nil, ErrIncidentNotFound
+		}
+		incident, err := s.incidentRepo.GetByID(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+		if incident == nil {
+			return nil, ErrIncidentNotFound
+		}
+		incidentID = &incident.ID
+	}
+
+	location, err := s.locationSvc.Resolve(req.ZoneID, req.Area, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build CAP XML
 	capXML := cap.BuildCAPXML(cap.CAPParams{
 		Sender:      s.capSender,
@@ -59,12 +84,14 @@ func (s *AlertService) Create(ctx context.Context, req dto.CreateAlertRequest, u
 
 	alert := &model.Alert{
 		ReportID:      reportID,
+		IncidentID:    incidentID,
 		Status:        model.AlertStatusDraft,
 		Event:         req.Event,
 		Urgency:       req.Urgency,
 		Severity:      req.Severity,
 		Certainty:     req.Certainty,
 		Area:          req.Area,
+		Location:      location,
 		Instruction:   req.Instruction,
 		PublicMessage: req.PublicMessage,
 		CAPXML:        capXML,
@@ -89,7 +116,7 @@ func (s *AlertService) Create(ctx context.Context, req dto.CreateAlertRequest, u
 		},
 	})
 
-	return s.toAlertVO(alert), nil
+	return toAlertVO(alert), nil
 }
 
 // GetByID retrieves an alert by ID
@@ -107,15 +134,32 @@ func (s *AlertService) GetByID(ctx context.Context, id string) (*vo.AlertVO, err
 		return nil, ErrAlertNotFound
 	}
 
-	return s.toAlertVO(alert), nil
+	return toAlertVO(alert), nil
 }
 
 // List retrieves alerts with pagination
 func (s *AlertService) List(ctx context.Context, query dto.ListAlertsQuery) (*vo.AlertListVO, error) {
+	var incidentID uuid.UUID
+	if query.IncidentID != "" {
+		var err error
+		incidentID, err = uuid.Parse(query.IncidentID)
+		if err != nil {
+			return nil, errors.New("invalid incident ID")
+		}
+	}
+
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repository.ListAlertParams{
-		Page:     query.Page,
-		PageSize: query.PageSize,
-		Status:   query.Status,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		Status:     query.Status,
+		IncidentID: incidentID,
+		ZoneID:     query.ZoneID,
+		After:      after,
 	}
 
 	alerts, total, err := s.alertRepo.List(ctx, params)
@@ -123,20 +167,26 @@ func (s *AlertService) List(ctx context.Context, query dto.ListAlertsQuery) (*vo
 		return nil, err
 	}
 
+	hasMore := hasNextPage(after, len(alerts), query.Page, query.PageSize, total)
+	if len(alerts) > query.PageSize {
+		alerts = alerts[:query.PageSize]
+	}
+
 	alertVOs := make([]vo.AlertVO, len(alerts))
+	var last repository.Cursor
 	for i, a := range alerts {
-		alertVOs[i] = *s.toAlertVO(&a)
+		alertVOs[i] = *toAlertVO(&a)
+		last = repository.Cursor{Time: a.CreatedAt, ID: a.ID}
 	}
 
-	return &vo.AlertListVO{
-		Data: alertVOs,
-		Pagination: vo.PaginationVO{
-			Page:       query.Page,
-			PageSize:   query.PageSize,
-			Total:      total,
-			TotalPages: int((total + int64(query.PageSize) - 1) / int64(query.PageSize)),
-		},
-	}, nil
+	result := &vo.AlertListVO{
+		Data:   alertVOs,
+		Cursor: newCursorPagination(query.PageSize, hasMore, last),
+	}
+	if after == nil {
+		result.Pagination = newPagination(query.Page, query.PageSize, total)
+	}
+	return result, nil
 }
 
 // Update updates an alert
@@ -186,6 +236,17 @@ func (s *AlertService) Update(ctx context.Context, id string, req dto.UpdateAler
 	if req.Area != "" {
 		alert.Area = req.Area
 	}
+	if req.ZoneID != "" || req.Area != "" {
+		// An explicit zone wins; otherwise re-resolve from the new area text
+		location, err := s.locationSvc.Resolve(req.ZoneID, alert.Area, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if location.ZoneID != alert.Location.ZoneID {
+			changes["zoneId"] = map[string]string{"from": alert.Location.ZoneID, "to": location.ZoneID}
+		}
+		alert.Location.ZoneID = location.ZoneID
+	}
 	if req.Instruction != "" {
 		alert.Instruction = req.Instruction
 	}
@@ -233,7 +294,7 @@ func (s *AlertService) Update(ctx context.Context, id string, req dto.UpdateAler
 		Diff:       changes,
 	})
 
-	return s.toAlertVO(alert), nil
+	return toAlertVO(alert), nil
 }
 
 // GetActiveAlerts retrieves currently active alerts
@@ -250,6 +311,7 @@ func (s *AlertService) GetActiveAlerts(ctx context.Context, limit int) ([]vo.Ale
 			Event:     a.Event,
 			Severity:  a.Severity,
 			Area:      a.Area,
+			ZoneID:    a.Location.ZoneID,
 			CreatedAt: a.CreatedAt.Format(time.RFC3339),
 		}
 	}
@@ -258,7 +320,7 @@ func (s *AlertService) GetActiveAlerts(ctx context.Context, limit int) ([]vo.Ale
 }
 
 // toAlertVO converts an alert model to VO
-func (s *AlertService) toAlertVO(alert *model.Alert) *vo.AlertVO {
+func toAlertVO(alert *model.Alert) *vo.AlertVO {
 	result := &vo.AlertVO{
 		ID:            alert.ID.String(),
 		Status:        alert.Status,
@@ -267,6 +329,7 @@ func (s *AlertService) toAlertVO(alert *model.Alert) *vo.AlertVO {
 		Severity:      alert.Severity,
 		Certainty:     alert.Certainty,
 		Area:          alert.Area,
+		ZoneID:        alert.Location.ZoneID,
 		Instruction:   alert.Instruction,
 		PublicMessage: alert.PublicMessage,
 		Channels:      alert.Channels,
@@ -280,6 +343,10 @@ func (s *AlertService) toAlertVO(alert *model.Alert) *vo.AlertVO {
 		result.ReportID = alert.ReportID.String()
 	}
 
+	if alert.IncidentID != nil {
+		result.IncidentID = alert.IncidentID.String()
+	}
+
 	if alert.Approver != nil {
 		result.ApprovedBy = &vo.UserSummaryVO{
 			ID:          alert.Approver.ID.String(),
@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/blockref"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrAppealNotFound   = errors.New("appeal not found")
+	ErrAppealDecided    = errors.New("appeal already decided")
+	ErrAppealExists     = errors.New("an appeal is already pending or decided")
+	ErrNotAppealable    = errors.New("report is not marked as spam")
+	ErrInvalidAppealRef = errors.New("follow-up token or blocked reference is invalid or expired")
+)
+
+// appealPassTTL is how long the pass from an overturned intake block can be
+// redeemed after the decision
+const appealPassTTL = 7 * 24 * time.Hour
+
+// AppealService lets reporters contest spam decisions and intake blocks and
+// lets reviewers decide on them
+type AppealService struct {
+	appealRepo    *repository.AppealRepository
+	reportRepo    *repository.ReportRepository
+	auditRepo     *repository.AuditRepository
+	reputationSvc *ReputationService
+	nonces        repository.NonceStore
+	secret        []byte
+	window        time.Duration
+}
+
+// NewAppealService creates a new appeal service. Blocked-intake references
+// and passes are signed with secret; references can be appealed for window
+// after they are issued, and nonces records which passes were spent.
+func NewAppealService(
+	appealRepo *repository.AppealRepository,
+	reportRepo *repository.ReportRepository,
+	auditRepo *repository.AuditRepository,
+	reputationSvc *ReputationService,
+	nonces repository.NonceStore,
+	secret string,
+	window time.Duration,
+) *AppealService {
+	return &AppealService{
+		appealRepo:    appealRepo,
+		reportRepo:    reportRepo,
+		auditRepo:     auditRepo,
+		reputationSvc: reputationSvc,
+		nonces:        nonces,
+		secret:        []byte(secret),
+		window:        window,
+	}
+}
+
+// IssueReference hands out a reference for a blocked submission. It is used
+// by the rate limiter and returns "" if no reference could be issued.
+func (s *AppealService) IssueReference() string {
+	ref, err := blockref.Issue(s.secret, time.Now().UTC())
+	if err != nil {
+		log.Printf("blocked reference not issued: %v", err)
+		return ""
+	}
+	return ref
+}
+
+// RedeemPass spends the pass from an overturned intake block. It is used by
+// the intake rate limiter, which lets the request through when it returns
+// true; each pass works once.
+func (s *AppealService) RedeemPass(ctx context.Context, pass string) bool {
+	appealID, err := blockref.VerifyPass(s.secret, pass, appealPassTTL, time.Now().UTC())
+	if err != nil {
+		return false
+	}
+	fresh, err := s.nonces.Use(ctx, "appealpass:"+uuid.UUID(appealID).String(), time.Now().UTC().Add(appealPassTTL))
+	if err != nil {
+		log.Printf("appeal pass not redeemed: %v", err)
+		return false
+	}
+	return fresh
+}
+
+// Create files a reporter's appeal, either against a spam decision (with the
+// report's follow-up token) or against an intake block (with its reference)
+func (s *AppealService) Create(ctx context.Context, req dto.CreateAppealRequest, actorIP string) (*vo.AppealStatusVO, error) {
+	appeal := &model.Appeal{
+		Message: req.Message,
+		Status:  model.AppealStatusPending,
+	}
+
+	if req.FollowUpToken != "" {
+		report, err := s.reportRepo.GetByFollowUpHash(ctx, hashFollowUpToken(req.FollowUpToken))
+		if err != nil {
+			return nil, err
+		}
+		if report == nil {
+			return nil, ErrInvalidAppealRef
+		}
+		if report.Status != model.StatusSpam {
+			return nil, ErrNotAppealable
+		}
+		// One appeal per report; an upheld decision is final
+		appealed, err := s.appealRepo.HasAppeal(ctx, report.ID)
+		if err != nil {
+			return nil, err
+		}
+		if appealed {
+			return nil, ErrAppealExists
+		}
+		appeal.Kind = model.AppealKindReport
+		appeal.ReportID = &report.ID
+	} else {
+		blockedAt, err := blockref.Verify(s.secret, req.BlockedRef, s.window, time.Now().UTC())
+		if err != nil {
+			return nil, ErrInvalidAppealRef
+		}
+		existing, err := s.appealRepo.GetByBlockedRef(ctx, req.BlockedRef)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, ErrAppealExists
+		}
+		appeal.Kind = model.AppealKindBlocked
+		appeal.BlockedRef = &req.BlockedRef
+		appeal.BlockedAt = &blockedAt
+	}
+
+	if err := s.appealRepo.Create(ctx, appeal); err != nil {
+		return nil, err
+	}
+
+	diff := model.JSONMap{"kind": appeal.Kind}
+	if appeal.ReportID != nil {
+		diff["reportId"] = appeal.ReportID.String()
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorIP:    actorIP,
+		Action:     model.ActionCreate,
+		ObjectType: model.ObjectTypeAppeal,
+		ObjectID:   &appeal.ID,
+		Diff:       diff,
+	})
+
+	return toAppealStatusVO(appeal), nil
+}
+
+// Status returns the reporter's view of an appeal
+func (s *AppealService) Status(ctx context.Context, id string) (*vo.AppealStatusVO, error) {
+	appeal, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	status := toAppealStatusVO(appeal)
+	status.Pass = s.pass(appeal)
+	return status, nil
+}
+
+// List retrieves appeals for review
+func (s *AppealService) List(ctx context.Context, query dto.ListAppealsQuery) (*vo.AppealListVO, error) {
+	appeals, total, err := s.appealRepo.List(ctx, repository.ListAppealParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Status:   query.Status,
+		Kind:     query.Kind,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appealVOs := make([]vo.AppealVO, len(appeals))
+	for i, a := range appeals {
+		appealVOs[i] = *toAppealVO(&a)
+	}
+
+	return &vo.AppealListVO{
+		Data:       appealVOs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// GetByID retrieves an appeal for review
+func (s *AppealService) GetByID(ctx context.Context, id string) (*vo.AppealVO, error) {
+	appeal, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toAppealVO(appeal), nil
+}
+
+// Decide upholds or overturns an appeal. Overturning a spam decision returns
+// the report to review and withdraws the spam mark from the reporter's
+// reputation; overturning an intake block gives the reporter a pass, shown
+// with the appeal's status, that lets one blocked submission through.
+func (s *AppealService) Decide(ctx context.Context, id string, req dto.DecideAppealRequest, userID *uuid.UUID, actorIP string) (*vo.AppealVO, error) {
+	appeal, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != model.AppealStatusPending {
+		return nil, ErrAppealDecided
+	}
+
+	now := time.Now().UTC()
+	appeal.Status = req.Decision
+	appeal.DecisionReason = req.Reason
+	appeal.DecidedBy = userID
+	appeal.DecidedAt = &now
+
+	ok, err := s.appealRepo.Decide(ctx, appeal)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrAppealDecided
+	}
+
+	diff := model.JSONMap{
+		"decision": req.Decision,
+		"reason":   req.Reason,
+	}
+	if appeal.Status == model.AppealStatusOverturned && appeal.Report != nil {
+		reopened, err := s.reopen(ctx, appeal, userID, actorIP)
+		if err != nil {
+			return nil, err
+		}
+		diff["reportReopened"] = reopened
+	}
+	if s.pass(appeal) != "" {
+		diff["passIssued"] = true
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionResolve,
+		ObjectType: model.ObjectTypeAppeal,
+		ObjectID:   &appeal.ID,
+		Diff:       diff,
+	})
+
+	return s.GetByID(ctx, id)
+}
+
+// reopen returns an appealed spam report to review
+func (s *AppealService) reopen(ctx context.Context, appeal *model.Appeal, userID *uuid.UUID, actorIP string) (bool, error) {
+	report := appeal.Report
+	if report.Status != model.StatusSpam {
+		return false, nil
+	}
+
+	ok, err := s.reportRepo.TransitionStatus(ctx, report.ID, model.StatusSpam, model.StatusUnderReview)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionReopen,
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &report.ID,
+		Diff: model.JSONMap{
+			"status":   map[string]string{"from": model.StatusSpam, "to": model.StatusUnderReview},
+			"reason":   appeal.DecisionReason,
+			"appealId": appeal.ID.String(),
+		},
+	})
+
+	if err := s.reputationSvc.WithdrawOutcome(ctx, report); err != nil {
+		log.Printf("reputation update failed for report %s: %v", report.ID, err)
+	}
+	return true, nil
+}
+
+// pass returns the redeemable pass for an overturned intake block, or "" if
+// the appeal has none or it has expired. The pass is derived from the
+// decision, so it is the same every time the reporter checks.
+func (s *AppealService) pass(appeal *model.Appeal) string {
+	if appeal.Kind != model.AppealKindBlocked || appeal.Status != model.AppealStatusOverturned || appeal.DecidedAt == nil {
+		return ""
+	}
+	if time.Since(*appeal.DecidedAt) > appealPassTTL {
+		return ""
+	}
+	return blockref.IssuePass(s.secret, appeal.ID, *appeal.DecidedAt)
+}
+
+// get loads an appeal by its string ID
+func (s *AppealService) get(ctx context.Context, id string) (*model.Appeal, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrAppealNotFound
+	}
+	appeal, err := s.appealRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if appeal == nil {
+		return nil, ErrAppealNotFound
+	}
+	return appeal, nil
+}
+
+// newFollowUpToken creates a token a reporter can later use to appeal, and
+// the hash stored in its place
+func newFollowUpToken() (token, hash string, err error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashFollowUpToken(token), nil
+}
+
+// hashFollowUpToken returns the stored form of a follow-up token
+func hashFollowUpToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// toAppealStatusVO converts an appeal to the reporter's view
+func toAppealStatusVO(appeal *model.Appeal) *vo.AppealStatusVO {
+	return &vo.AppealStatusVO{
+		ID:             appeal.ID.String(),
+		Kind:           appeal.Kind,
+		Status:         appeal.Status,
+		DecisionReason: appeal.DecisionReason,
+		CreatedAt:      appeal.CreatedAt,
+		DecidedAt:      appeal.DecidedAt,
+	}
+}
+
+// toAppealVO converts an appeal model to VO
+func toAppealVO(appeal *model.Appeal) *vo.AppealVO {
+	result := &vo.AppealVO{
+		ID:             appeal.ID.String(),
+		Kind:           appeal.Kind,
+		BlockedAt:      appeal.BlockedAt,
+		Message:        appeal.Message,
+		Status:         appeal.Status,
+		DecisionReason: appeal.DecisionReason,
+		DecidedAt:      appeal.DecidedAt,
+		CreatedAt:      appeal.CreatedAt,
+	}
+
+	if appeal.ReportID != nil {
+		result.ReportID = appeal.ReportID.String()
+	}
+	if appeal.Report != nil {
+		result.Report = toReportVO(appeal.Report)
+	}
+	if appeal.Decider != nil {
+		result.DecidedBy = &vo.UserSummaryVO{
+			ID:          appeal.Decider.ID.String(),
+			DisplayName: appeal.Decider.DisplayName,
+			Role:        appeal.Decider.Role,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// AuditService handles audit log queries
+type AuditService struct {
+	auditRepo *repository.AuditRepository
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(auditRepo *repository.AuditRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+// List retrieves audit log entries, newest first
+func (s *AuditService) List(ctx context.Context, query dto.ListAuditLogsQuery) (*vo.AuditLogListVO, error) {
+	params := repository.ListAuditParams{
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		ObjectType: query.ObjectType,
+		Action:     query.Action,
+		BatchID:    query.BatchID,
+	}
+
+	if query.ObjectID != "" {
+		objectID, err := uuid.Parse(query.ObjectID)
+		if err != nil {
+			return nil, errors.New("invalid object ID")
+		}
+		params.ObjectID = objectID
+	}
+	if query.ActorID != "" {
+		actorID, err := uuid.Parse(query.ActorID)
+		if err != nil {
+			return nil, errors.New("invalid actor ID")
+		}
+		params.ActorID = actorID
+	}
+
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	params.After = after
+
+	logs, total, err := s.auditRepo.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := hasNextPage(after, len(logs), query.Page, query.PageSize, total)
+	if len(logs) > query.PageSize {
+		logs = logs[:query.PageSize]
+	}
+
+	logVOs := make([]vo.AuditLogVO, len(logs))
+	var last repository.Cursor
+	for i, l := range logs {
+		logVOs[i] = *toAuditLogVO(&l)
+		last = repository.Cursor{Time: l.Timestamp, ID: l.ID}
+	}
+
+	result := &vo.AuditLogListVO{
+		Data:   logVOs,
+		Cursor: newCursorPagination(query.PageSize, hasMore, last),
+	}
+	if after == nil {
+		result.Pagination = newPagination(query.Page, query.PageSize, total)
+	}
+	return result, nil
+}
+
+// toAuditLogVO converts an audit log model to VO
+func toAuditLogVO(log *model.AuditLog) *vo.AuditLogVO {
+	result := &vo.AuditLogVO{
+		ID:         log.ID.String(),
+		ActorIP:    log.ActorIP,
+		Action:     log.Action,
+		ObjectType: log.ObjectType,
+		Diff:       log.Diff,
+		Timestamp:  log.Timestamp,
+	}
+
+	if log.ObjectID != nil {
+		result.ObjectID = log.ObjectID.String()
+	}
+
+	if log.Actor != nil {
+		result.Actor = &vo.UserSummaryVO{
+			ID:          log.Actor.ID.String(),
+			DisplayName: log.Actor.DisplayName,
+			Role:        log.Actor.Role,
+		}
+	}
+
+	return result
+}
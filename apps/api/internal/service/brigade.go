@@ -0,0 +1,515 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrBrigadeNotFound = errors.New("brigade cluster not found")
+	ErrBrigadeResolved = errors.New("brigade cluster already resolved")
+)
+
+// Brigade detection limits
+const (
+	brigadePoolSize = 500
+	// Text score (see scoreDuplicate) at which two descriptions count as the
+	// same phrasing
+	brigadePhrasingScore = 0.8
+	// Geohash characters used for the area key when no pilot zone is known
+	brigadeGeohashPrefix = 5
+)
+
+// BrigadeService detects coordinated reporting: bursts of reports against
+// one area, category or description that come from correlated sources
+type BrigadeService struct {
+	brigadeRepo     *repository.BrigadeRepository
+	reportRepo      *repository.ReportRepository
+	userRepo        *repository.UserRepository
+	auditRepo       *repository.AuditRepository
+	notificationSvc *NotificationService
+	reputationSvc   *ReputationService
+	window          time.Duration
+	minReports      int
+	sourceMin       int
+	tightenFor      time.Duration
+}
+
+// NewBrigadeService creates a new brigade detection service. A burst of at
+// least minReports within window is flagged when sourceMin or more of them
+// share a network or phrasing, or come from few devices. Intake for the
+// targeted area is tightened for tightenFor; a category-wide cluster spans
+// every area, so its category is only tightened once a lead confirms it.
+func NewBrigadeService(
+	brigadeRepo *repository.BrigadeRepository,
+	reportRepo *repository.ReportRepository,
+	userRepo *repository.UserRepository,
+	auditRepo *repository.AuditRepository,
+	notificationSvc *NotificationService,
+	reputationSvc *ReputationService,
+	window time.Duration,
+	minReports, sourceMin int,
+	tightenFor time.Duration,
+) *BrigadeService {
+	if minReports < 2 {
+		minReports = 2
+	}
+	if sourceMin < 2 {
+		sourceMin = 2
+	}
+	return &BrigadeService{
+		brigadeRepo:     brigadeRepo,
+		reportRepo:      reportRepo,
+		userRepo:        userRepo,
+		auditRepo:       auditRepo,
+		notificationSvc: notificationSvc,
+		reputationSvc:   reputationSvc,
+		window:          window,
+		minReports:      minReports,
+		sourceMin:       sourceMin,
+		tightenFor:      tightenFor,
+	}
+}
+
+// brigadeGroup is a set of recent reports sharing one target
+type brigadeGroup struct {
+	targetType string
+	targetKey  string
+	areaKey    string
+	members    []model.Report
+}
+
+// Detect checks whether a new report is part of a coordinated burst. The
+// report's recent neighbours are grouped by phrasing, area and category; the
+// first group that is both large enough and correlated is flagged as a
+// cluster, or added to the open cluster its members already belong to.
+func (s *BrigadeService) Detect(ctx context.Context, report *model.Report) (*model.BrigadeCluster, error) {
+	since := report.CreatedAt.Add(-s.window)
+	pool, err := s.reportRepo.ListRecent(ctx, since, report.ID, brigadePoolSize)
+	if err != nil {
+		return nil, err
+	}
+	pool = append(pool, *report)
+
+	areaKey := brigadeAreaKey(report)
+	groups := []brigadeGroup{
+		{targetType: model.BrigadeTargetPhrasing, targetKey: fmt.Sprintf("%016x", uint64(report.SimHash)), areaKey: areaKey},
+		{targetType: model.BrigadeTargetArea, targetKey: areaKey, areaKey: areaKey},
+		{targetType: model.BrigadeTargetCategory, targetKey: report.Category},
+	}
+	for i := range pool {
+		other := &pool[i]
+		if samePhrasing(report, other) {
+			groups[0].members = append(groups[0].members, *other)
+		}
+		if areaKey != "" && brigadeAreaKey(other) == areaKey {
+			groups[1].members = append(groups[1].members, *other)
+		}
+		if other.Category == report.Category {
+			groups[2].members = append(groups[2].members, *other)
+		}
+	}
+
+	for _, group := range groups {
+		if group.targetKey == "" || len(group.members) < s.minReports {
+			continue
+		}
+		signals := s.correlate(report, &group)
+		if len(signals) == 0 {
+			continue
+		}
+		return s.flag(ctx, report, &group, signals)
+	}
+	return nil, nil
+}
+
+// correlate lists the signs that a group's reports share a source. Volume
+// alone is not enough: a real incident draws many reports too.
+func (s *BrigadeService) correlate(report *model.Report, group *brigadeGroup) model.StringArray {
+	var signals model.StringArray
+
+	networks := make(map[string]int)
+	devices := make(map[string]bool)
+	withDevice := 0
+	phrasing := 0
+	for i := range group.members {
+		m := &group.members[i]
+		if m.NetworkHash != "" {
+			networks[m.NetworkHash]++
+		}
+		if m.DeviceHash != "" {
+			devices[m.DeviceHash] = true
+			withDevice++
+		}
+		if samePhrasing(report, m) {
+			phrasing++
+		}
+	}
+
+	maxNetwork := 0
+	for _, n := range networks {
+		if n > maxNetwork {
+			maxNetwork = n
+		}
+	}
+	if maxNetwork >= s.sourceMin {
+		signals = append(signals, "network")
+	}
+	if withDevice >= s.minReports && len(devices)*2 <= withDevice {
+		signals = append(signals, "devices")
+	}
+	if phrasing >= s.sourceMin {
+		signals = append(signals, "phrasing")
+	}
+	return signals
+}
+
+// flag records a detected cluster, tightens intake for its area and
+// notifies admins of new clusters
+func (s *BrigadeService) flag(ctx context.Context, report *model.Report, group *brigadeGroup, signals model.StringArray) (*model.BrigadeCluster, error) {
+	now := time.Now().UTC()
+
+	memberIDs := make([]uuid.UUID, len(group.members))
+	var clusterIDs []uuid.UUID
+	for i, m := range group.members {
+		memberIDs[i] = m.ID
+		if m.BrigadeClusterID != nil {
+			clusterIDs = append(clusterIDs, *m.BrigadeClusterID)
+		}
+	}
+
+	cluster, err := s.brigadeRepo.FindOpen(ctx, clusterIDs, group.targetType)
+	if err != nil {
+		return nil, err
+	}
+	extended := cluster != nil
+	if !extended {
+		cluster = &model.BrigadeCluster{
+			TargetType: group.targetType,
+			TargetKey:  truncate(group.targetKey, 100),
+			AreaKey:    truncate(group.areaKey, 100),
+			Category:   report.Category,
+			Status:     model.BrigadeStatusOpen,
+			DetectedAt: now,
+		}
+	}
+	cluster.Signals = signals
+	if group.targetType != model.BrigadeTargetCategory {
+		until := now.Add(s.tightenFor)
+		cluster.TightenedUntil = &until
+	}
+
+	if err := s.brigadeRepo.Save(ctx, cluster, memberIDs); err != nil {
+		return nil, err
+	}
+	if report.BrigadeClusterID == nil {
+		report.BrigadeClusterID = &cluster.ID
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		Action:     model.ActionDetect,
+		ObjectType: model.ObjectTypeBrigade,
+		ObjectID:   &cluster.ID,
+		Diff: model.JSONMap{
+			"reportId":       report.ID.String(),
+			"targetType":     cluster.TargetType,
+			"targetKey":      cluster.TargetKey,
+			"signals":        signals,
+			"reports":        cluster.ReportCount,
+			"sources":        cluster.SourceCount,
+			"tightenedUntil": cluster.TightenedUntil,
+			"extended":       extended,
+		},
+	})
+
+	if !extended {
+		if err := s.notify(ctx, cluster); err != nil {
+			log.Printf("brigade notification failed for cluster %s: %v", cluster.ID, err)
+		}
+	}
+
+	return cluster, nil
+}
+
+// notify alerts admins to a new cluster
+func (s *BrigadeService) notify(ctx context.Context, cluster *model.BrigadeCluster) error {
+	admins, err := s.userRepo.ListActiveByRole(ctx, model.RoleAdmin)
+	if err != nil || len(admins) == 0 {
+		return err
+	}
+	recipients := make([]uuid.UUID, len(admins))
+	for i, u := range admins {
+		recipients[i] = u.ID
+	}
+
+	tightening := "Intake for the area is tightened until reviewed."
+	if cluster.TargetType == model.BrigadeTargetCategory {
+		tightening = "Confirm the cluster to tighten intake for the category."
+	}
+
+	return s.notificationSvc.Notify(ctx, recipients, model.Notification{
+		Kind:       model.NotificationBrigade,
+		Title:      fmt.Sprintf("Possible coordinated reporting: %d %s reports", cluster.ReportCount, strings.ReplaceAll(cluster.Category, "_", " ")),
+		Body:       fmt.Sprintf("Reports targeting one %s from correlated sources (%s). %s", cluster.TargetType, strings.Join(cluster.Signals, ", "), tightening),
+		ObjectType: model.ObjectTypeBrigade,
+		ObjectID:   &cluster.ID,
+	})
+}
+
+// List retrieves brigade clusters with pagination
+func (s *BrigadeService) List(ctx context.Context, query dto.ListBrigadesQuery) (*vo.BrigadeClusterListVO, error) {
+	clusters, total, err := s.brigadeRepo.List(ctx, repository.ListBrigadeParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Status:   query.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusterVOs := make([]vo.BrigadeClusterVO, len(clusters))
+	for i, c := range clusters {
+		clusterVOs[i] = *toBrigadeClusterVO(&c)
+	}
+
+	return &vo.BrigadeClusterListVO{
+		Data:       clusterVOs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// GetByID retrieves a brigade cluster with its reports
+func (s *BrigadeService) GetByID(ctx context.Context, id string) (*vo.BrigadeClusterDetailVO, error) {
+	cluster, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toBrigadeClusterDetailVO(cluster), nil
+}
+
+// Resolve records the review of a cluster. Confirming marks its reports that
+// are still awaiting triage as spam, and tightens intake for a category-wide
+// cluster's category; dismissing lifts the intake tightening.
+func (s *BrigadeService) Resolve(ctx context.Context, id string, req dto.ResolveBrigadeRequest, userID *uuid.UUID, actorIP string) (*vo.BrigadeClusterDetailVO, error) {
+	cluster, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Status != model.BrigadeStatusOpen {
+		return nil, ErrBrigadeResolved
+	}
+
+	now := time.Now().UTC()
+	cluster.Status = req.Outcome
+	cluster.ResolvedBy = userID
+	cluster.ResolvedAt = &now
+	switch {
+	case req.Outcome == model.BrigadeStatusDismissed:
+		cluster.TightenedUntil = &now
+	case cluster.TargetType == model.BrigadeTargetCategory:
+		until := now.Add(s.tightenFor)
+		cluster.TightenedUntil = &until
+	}
+	if err := s.brigadeRepo.Resolve(ctx, cluster); err != nil {
+		return nil, err
+	}
+
+	var spammed []string
+	if req.Outcome == model.BrigadeStatusConfirmed {
+		spammed = s.markSpam(ctx, cluster, req.Reason, userID, actorIP)
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionResolve,
+		ObjectType: model.ObjectTypeBrigade,
+		ObjectID:   &cluster.ID,
+		Diff: model.JSONMap{
+			"outcome":        req.Outcome,
+			"reason":         req.Reason,
+			"spam":           spammed,
+			"tightenedUntil": cluster.TightenedUntil,
+		},
+	})
+
+	return s.GetByID(ctx, id)
+}
+
+// markSpam moves the cluster's untriaged reports to spam. Reports a triager
+// has already decided on are left alone.
+func (s *BrigadeService) markSpam(ctx context.Context, cluster *model.BrigadeCluster, reason string, userID *uuid.UUID, actorIP string) []string {
+	var spammed []string
+	for i := range cluster.Reports {
+		report := &cluster.Reports[i]
+		switch report.Status {
+		case model.StatusSubmitted, model.StatusUnderReview, model.StatusQuarantined:
+		default:
+			continue
+		}
+
+		ok, err := s.reportRepo.TransitionStatus(ctx, report.ID, report.Status, model.StatusSpam)
+		if err != nil {
+			log.Printf("brigade spam transition failed for report %s: %v", report.ID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		// Create audit log
+		s.auditRepo.Create(ctx, &model.AuditLog{
+			ActorID:    userID,
+			ActorIP:    actorIP,
+			Action:     model.ActionSpam,
+			ObjectType: model.ObjectTypeReport,
+			ObjectID:   &report.ID,
+			Diff: model.JSONMap{
+				"status":           map[string]string{"from": report.Status, "to": model.StatusSpam},
+				"reason":           reason,
+				"brigadeClusterId": cluster.ID.String(),
+			},
+		})
+		report.Status = model.StatusSpam
+		spammed = append(spammed, report.ID.String())
+
+		if err := s.reputationSvc.RecordOutcome(ctx, report, model.OutcomeSpam); err != nil {
+			log.Printf("reputation update failed for report %s: %v", report.ID, err)
+		}
+	}
+	return spammed
+}
+
+// Tightened reports whether intake is tightened for an area or category
+func (s *BrigadeService) Tightened(ctx context.Context, areaKey, category string) (bool, error) {
+	return s.brigadeRepo.Tightened(ctx, areaKey, category, time.Now().UTC())
+}
+
+// get loads a cluster by its string ID
+func (s *BrigadeService) get(ctx context.Context, id string) (*model.BrigadeCluster, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrBrigadeNotFound
+	}
+	cluster, err := s.brigadeRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, ErrBrigadeNotFound
+	}
+	return cluster, nil
+}
+
+// brigadeAreaKey names the area a report targets: its pilot zone, else a
+// coarse geohash cell, else its normalised area hint
+func brigadeAreaKey(report *model.Report) string {
+	switch {
+	case report.Location.ZoneID != "":
+		return "zone:" + report.Location.ZoneID
+	case len(report.Location.Geohash) >= brigadeGeohashPrefix:
+		return "geohash:" + report.Location.Geohash[:brigadeGeohashPrefix]
+	case strings.TrimSpace(report.AreaHint) != "":
+		return truncate("hint:"+normalizeArea(report.AreaHint), 100)
+	default:
+		return ""
+	}
+}
+
+// samePhrasing reports whether two descriptions are near-identical
+func samePhrasing(a, b *model.Report) bool {
+	text, _, _ := scoreDuplicate(a, b)
+	return text >= brigadePhrasingScore
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+// toBrigadeClusterVO converts a brigade cluster model to VO
+func toBrigadeClusterVO(cluster *model.BrigadeCluster) *vo.BrigadeClusterVO {
+	result := &vo.BrigadeClusterVO{
+		ID:             cluster.ID.String(),
+		TargetType:     cluster.TargetType,
+		TargetKey:      cluster.TargetKey,
+		AreaKey:        cluster.AreaKey,
+		Category:       cluster.Category,
+		Signals:        cluster.Signals,
+		ReportCount:    cluster.ReportCount,
+		SourceCount:    cluster.SourceCount,
+		Status:         cluster.Status,
+		TightenedUntil: cluster.TightenedUntil,
+		DetectedAt:     cluster.DetectedAt,
+		UpdatedAt:      cluster.UpdatedAt,
+		ResolvedAt:     cluster.ResolvedAt,
+	}
+
+	if cluster.Resolver != nil {
+		result.ResolvedBy = &vo.UserSummaryVO{
+			ID:          cluster.Resolver.ID.String(),
+			DisplayName: cluster.Resolver.DisplayName,
+			Role:        cluster.Resolver.Role,
+		}
+	}
+
+	return result
+}
+
+// toBrigadeClusterDetailVO converts a brigade cluster with its reports to VO
+func toBrigadeClusterDetailVO(cluster *model.BrigadeCluster) *vo.BrigadeClusterDetailVO {
+	result := &vo.BrigadeClusterDetailVO{
+		BrigadeClusterVO: *toBrigadeClusterVO(cluster),
+		Reports:          make([]vo.ReportVO, len(cluster.Reports)),
+	}
+	for i := range cluster.Reports {
+		result.Reports[i] = *toReportVO(&cluster.Reports[i])
+	}
+	return result
+}
+
+// BrigadeGate raises risk for submissions targeting an area or category
+// where coordinated reporting was recently detected
+type BrigadeGate struct {
+	brigadeSvc *BrigadeService
+	risk       float64
+}
+
+// NewBrigadeGate creates a brigade gate that adds risk while intake is tightened
+func NewBrigadeGate(brigadeSvc *BrigadeService, risk float64) *BrigadeGate {
+	return &BrigadeGate{brigadeSvc: brigadeSvc, risk: risk}
+}
+
+// Name returns the gate name
+func (g *BrigadeGate) Name() string {
+	return "brigade"
+}
+
+// Check adds the configured risk while an open or confirmed cluster keeps
+// intake tightened for the submission's area or category
+func (g *BrigadeGate) Check(ctx context.Context, signals *IntakeSignals) (GateResult, error) {
+	tightened, err := g.brigadeSvc.Tightened(ctx, signals.AreaKey, signals.Category)
+	if err != nil {
+		return GateResult{}, err
+	}
+	if tightened {
+		return GateResult{Risk: g.risk, Signal: "brigade:tightened"}, nil
+	}
+	return GateResult{Risk: 0, Signal: "brigade:ok"}, nil
+}
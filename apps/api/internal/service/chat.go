@@ -0,0 +1,686 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/line"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/whatsapp"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+)
+
+var (
+	ErrChatSignature = errors.New("chat webhook signature is invalid")
+	ErrChatPayload   = errors.New("chat webhook payload could not be read")
+	ErrChatDisabled  = errors.New("chat platform is not configured")
+)
+
+// Chat intake limits
+const (
+	// Longest answers accepted, in runes; they match the report form
+	chatAreaLimit        = 500
+	chatTimeWindowLimit  = 100
+	chatDescriptionLimit = 4000
+	// How long a platform event ID is remembered to drop redeliveries
+	chatEventDedupTTL = 24 * time.Hour
+)
+
+// ChatMessage is an incoming chat message, whatever the platform
+type ChatMessage struct {
+	Channel    string // model.ChannelLine or model.ChannelWhatsApp
+	EventID    string // platform ID of the event, for dropping redeliveries
+	UserID     string // platform ID of the sender
+	Text       string
+	IsText     bool   // false for stickers, images and other non-text messages
+	ReplyToken string // LINE only
+}
+
+// ChatMessenger sends text messages on one chat platform
+type ChatMessenger interface {
+	// Reply answers an incoming message
+	Reply(ctx context.Context, msg ChatMessage, texts ...string) error
+	// Push sends messages to a user who has not just written
+	Push(ctx context.Context, to string, texts ...string) error
+}
+
+// ChatPlatform connects the chat intake to one platform
+type ChatPlatform struct {
+	Secret        string // verifies webhook signatures
+	VerifyToken   string // WhatsApp only: answers the subscription handshake
+	PhoneNumberID string // WhatsApp only: business number whose messages are taken
+	Messenger     ChatMessenger
+}
+
+// ChatIntakeService runs guided reporting conversations with LINE and
+// WhatsApp bot users and files the finished ones as reports. Reporters are
+// known by a pseudonymous contact ref; their platform ID is kept only to
+// push status updates and is deleted once the report is closed.
+type ChatIntakeService struct {
+	reportSvc       *ReportService
+	subscriberRepo  *repository.SubscriberRepository
+	conversations   repository.ConversationStore
+	nonces          repository.NonceStore
+	platforms       map[string]*ChatPlatform
+	secret          []byte
+	zoneID          string
+	sessionTTL      time.Duration
+	subscriptionTTL time.Duration
+}
+
+// NewChatIntakeService creates a new chat intake service. A nil platform is
+// disabled. Reports are placed in zoneID (may be empty); conversations
+// expire after sessionTTL without an answer and status updates stop after
+// subscriptionTTL.
+func NewChatIntakeService(reportSvc *ReportService, subscriberRepo *repository.SubscriberRepository, conversations repository.ConversationStore, nonces repository.NonceStore, linePlatform, whatsappPlatform *ChatPlatform, secret, zoneID string, sessionTTL, subscriptionTTL time.Duration) *ChatIntakeService {
+	platforms := make(map[string]*ChatPlatform)
+	if linePlatform != nil {
+		platforms[model.ChannelLine] = linePlatform
+	}
+	if whatsappPlatform != nil {
+		platforms[model.ChannelWhatsApp] = whatsappPlatform
+	}
+	return &ChatIntakeService{
+		reportSvc:       reportSvc,
+		subscriberRepo:  subscriberRepo,
+		conversations:   conversations,
+		nonces:          nonces,
+		platforms:       platforms,
+		secret:          []byte(secret),
+		zoneID:          zoneID,
+		sessionTTL:      sessionTTL,
+		subscriptionTTL: subscriptionTTL,
+	}
+}
+
+// HandleLINE processes a LINE webhook body. Events are handled one by one
+// and their failures only logged, so the platform is not asked to redeliver
+// a batch that was partly handled. Group and room events are ignored:
+// reports are only taken in one-to-one chats.
+func (s *ChatIntakeService) HandleLINE(ctx context.Context, body []byte, signature string) error {
+	platform := s.platforms[model.ChannelLine]
+	if platform == nil {
+		return ErrChatDisabled
+	}
+	if !line.VerifySignature(platform.Secret, body, signature) {
+		return ErrChatSignature
+	}
+	webhook, err := line.ParseWebhook(body)
+	if err != nil {
+		return ErrChatPayload
+	}
+
+	for _, event := range webhook.Events {
+		if event.Source.Type != "user" || event.Source.UserID == "" {
+			continue
+		}
+		msg := ChatMessage{
+			Channel:    model.ChannelLine,
+			EventID:    event.WebhookEventID,
+			UserID:     event.Source.UserID,
+			ReplyToken: event.ReplyToken,
+		}
+		switch event.Type {
+		case "follow":
+			// Greet new friends in both languages; the conversation
+			// starts with their first message
+			err = s.reply(ctx, msg, chatPhrasesFor("en").Follow, chatPhrasesFor(chatLangZH).Follow)
+		case "unfollow":
+			err = s.conversations.Delete(ctx, s.contactRef(msg.Channel, msg.UserID))
+		case "message":
+			if event.Message == nil {
+				continue
+			}
+			msg.IsText = event.Message.Type == "text"
+			msg.Text = event.Message.Text
+			err = s.Handle(ctx, msg)
+		default:
+			continue
+		}
+		if err != nil {
+			log.Printf("line event %s failed: %v", event.WebhookEventID, err)
+		}
+	}
+	return nil
+}
+
+// HandleWhatsApp processes a WhatsApp webhook body. As with LINE, failures
+// of single messages are only logged.
+func (s *ChatIntakeService) HandleWhatsApp(ctx context.Context, body []byte, signature string) error {
+	platform := s.platforms[model.ChannelWhatsApp]
+	if platform == nil {
+		return ErrChatDisabled
+	}
+	if !whatsapp.VerifySignature(platform.Secret, body, signature) {
+		return ErrChatSignature
+	}
+	webhook, err := whatsapp.ParseWebhook(body)
+	if err != nil {
+		return ErrChatPayload
+	}
+
+	for _, m := range webhook.Messages(platform.PhoneNumberID) {
+		if m.From == "" {
+			continue
+		}
+		msg := ChatMessage{
+			Channel: model.ChannelWhatsApp,
+			EventID: m.ID,
+			UserID:  m.From,
+			IsText:  m.Type == "text" && m.Text != nil,
+		}
+		if msg.IsText {
+			msg.Text = m.Text.Body
+		}
+		if err := s.Handle(ctx, msg); err != nil {
+			log.Printf("whatsapp message %s failed: %v", m.ID, err)
+		}
+	}
+	return nil
+}
+
+// VerifyWhatsAppSubscription checks a WhatsApp subscription handshake
+func (s *ChatIntakeService) VerifyWhatsAppSubscription(mode, token string) bool {
+	platform := s.platforms[model.ChannelWhatsApp]
+	return platform != nil && whatsapp.VerifySubscription(platform.VerifyToken, mode, token)
+}
+
+// Handle advances the sender's conversation by one message and answers it.
+// A message that arrives with no conversation in progress starts one.
+func (s *ChatIntakeService) Handle(ctx context.Context, msg ChatMessage) error {
+	// Platforms redeliver events they think were lost
+	if msg.EventID != "" {
+		fresh, err := s.nonces.Use(ctx, "chat:"+msg.Channel+":"+msg.EventID, time.Now().Add(chatEventDedupTTL))
+		if err != nil {
+			return err
+		}
+		if !fresh {
+			return nil
+		}
+	}
+
+	key := s.contactRef(msg.Channel, msg.UserID)
+	session, err := s.conversations.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	text := strings.TrimSpace(msg.Text)
+
+	if session == nil {
+		session = &model.ChatSession{Step: model.ChatStepCategory, Lang: chatLang(text)}
+		p := chatPhrasesFor(session.Lang)
+		if err := s.save(ctx, key, session); err != nil {
+			return err
+		}
+		return s.reply(ctx, msg, p.Welcome, p.categoryPrompt())
+	}
+
+	p := chatPhrasesFor(session.Lang)
+	if !msg.IsText {
+		return s.reply(ctx, msg, p.TextOnly, p.prompt(session))
+	}
+	if chatWord(text, chatCancelWords) {
+		if err := s.conversations.Delete(ctx, key); err != nil {
+			return err
+		}
+		return s.reply(ctx, msg, p.Cancelled)
+	}
+
+	switch session.Step {
+	case model.ChatStepCategory:
+		category := p.parseCategory(text)
+		if category == "" {
+			return s.reply(ctx, msg, p.Invalid, p.prompt(session))
+		}
+		session.Category = category
+		session.Step = model.ChatStepArea
+
+	case model.ChatStepArea:
+		if text == "" || utf8.RuneCountInString(text) > chatAreaLimit {
+			return s.reply(ctx, msg, fmt.Sprintf(p.TooLong, chatAreaLimit), p.prompt(session))
+		}
+		session.AreaHint = text
+		session.Step = model.ChatStepTimeWindow
+
+	case model.ChatStepTimeWindow:
+		if utf8.RuneCountInString(text) > chatTimeWindowLimit {
+			return s.reply(ctx, msg, fmt.Sprintf(p.TooLong, chatTimeWindowLimit), p.prompt(session))
+		}
+		session.TimeWindow = text
+		if chatWord(text, chatSkipWords) {
+			session.TimeWindow = ""
+		}
+		session.Step = model.ChatStepDescription
+
+	case model.ChatStepDescription:
+		if text == "" || utf8.RuneCountInString(text) > chatDescriptionLimit {
+			return s.reply(ctx, msg, fmt.Sprintf(p.TooLong, chatDescriptionLimit), p.prompt(session))
+		}
+		session.Description = text
+		session.Step = model.ChatStepConfirm
+
+	case model.ChatStepConfirm:
+		if !chatWord(text, chatYesWords) {
+			return s.reply(ctx, msg, p.prompt(session))
+		}
+		return s.submit(ctx, msg, key, session)
+
+	default:
+		// A session from an older version of the conversation; start over
+		if err := s.conversations.Delete(ctx, key); err != nil {
+			return err
+		}
+		return s.reply(ctx, msg, p.Cancelled)
+	}
+
+	if err := s.save(ctx, key, session); err != nil {
+		return err
+	}
+	return s.reply(ctx, msg, p.prompt(session))
+}
+
+// submit files a confirmed conversation as a report, subscribes the reporter
+// to status updates and sends them their follow-up token. If filing fails
+// the conversation is kept, so confirming again retries.
+func (s *ChatIntakeService) submit(ctx context.Context, msg ChatMessage, key string, session *model.ChatSession) error {
+	p := chatPhrasesFor(session.Lang)
+
+	// The contact ref doubles as the device ID, so the device and reputation
+	// gates track each chat user. Chat carries no client network.
+	report, err := s.reportSvc.Create(ctx, dto.CreateReportRequest{
+		Category:        session.Category,
+		AreaHint:        session.AreaHint,
+		ZoneID:          s.zoneID,
+		TimeWindow:      session.TimeWindow,
+		Description:     session.Description,
+		ReporterContact: key,
+	}, &IntakeSignals{Channel: msg.Channel, DeviceID: key}, "")
+	if err != nil {
+		if replyErr := s.reply(ctx, msg, p.Failed); replyErr != nil {
+			log.Printf("chat reply failed: %v", replyErr)
+		}
+		return err
+	}
+
+	if err := s.conversations.Delete(ctx, key); err != nil {
+		log.Printf("chat session cleanup failed for report %s: %v", report.ID, err)
+	}
+
+	// Status updates are best effort: the report is filed either way
+	now := time.Now().UTC()
+	if err := s.subscriberRepo.Create(ctx, &model.ReportSubscriber{
+		ReportID:   uuid.MustParse(report.ID),
+		Channel:    msg.Channel,
+		Recipient:  model.EncryptedString(msg.UserID),
+		Lang:       session.Lang,
+		LastStatus: model.StatusSubmitted,
+		ExpiresAt:  now.Add(s.subscriptionTTL),
+	}); err != nil {
+		log.Printf("chat subscription failed for report %s: %v", report.ID, err)
+	}
+
+	return s.reply(ctx, msg, fmt.Sprintf(p.Received, report.ID), report.FollowUpToken, p.TokenNote)
+}
+
+// RunStatusUpdates pushes report status changes to chat reporters every
+// interval until ctx is cancelled
+func (s *ChatIntakeService) RunStatusUpdates(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.pushStatusUpdates(ctx); err != nil {
+			log.Printf("chat status updates failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pushStatusUpdates sends each subscriber the status of their report when
+// it changed, as the reporter sees it, and ends subscriptions that expired
+// or whose report was closed. A failed push is retried next round.
+func (s *ChatIntakeService) pushStatusUpdates(ctx context.Context) error {
+	now := time.Now().UTC()
+	if _, err := s.subscriberRepo.DeleteExpired(ctx, now); err != nil {
+		return err
+	}
+	subscribers, err := s.subscriberRepo.ListActive(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subscribers {
+		if ctx.Err() != nil {
+			return nil
+		}
+		status := publicReportStatus(sub.Status)
+		if status == sub.LastStatus {
+			continue
+		}
+		platform := s.platforms[sub.Channel]
+		if platform == nil {
+			continue
+		}
+
+		p := chatPhrasesFor(sub.Lang)
+		text := fmt.Sprintf(p.StatusUpdate, sub.ReportID, p.Statuses[status])
+		if err := platform.Messenger.Push(ctx, string(sub.Recipient), text); err != nil {
+			log.Printf("chat status update failed for report %s: %v", sub.ReportID, err)
+			continue
+		}
+
+		if status == model.StatusClosed {
+			err = s.subscriberRepo.Delete(ctx, sub.ID)
+		} else {
+			err = s.subscriberRepo.UpdateLastStatus(ctx, sub.ID, status)
+		}
+		if err != nil {
+			log.Printf("chat subscription update failed for report %s: %v", sub.ReportID, err)
+		}
+	}
+	return nil
+}
+
+// publicReportStatus is the status a reporter is shown. Quarantine and spam
+// decisions are not disclosed.
+func publicReportStatus(status string) string {
+	switch status {
+	case model.StatusQuarantined:
+		return model.StatusSubmitted
+	case model.StatusSpam:
+		return model.StatusClosed
+	}
+	return status
+}
+
+// save stores a conversation, restarting its expiry
+func (s *ChatIntakeService) save(ctx context.Context, key string, session *model.ChatSession) error {
+	session.UpdatedAt = time.Now().UTC()
+	return s.conversations.Put(ctx, key, session, s.sessionTTL)
+}
+
+// reply answers a message on its platform
+func (s *ChatIntakeService) reply(ctx context.Context, msg ChatMessage, texts ...string) error {
+	platform := s.platforms[msg.Channel]
+	if platform == nil {
+		return ErrChatDisabled
+	}
+	return platform.Messenger.Reply(ctx, msg, texts...)
+}
+
+// contactRef derives the pseudonymous contact ref of a chat user. It also
+// keys their conversation, so raw platform IDs never reach the store.
+func (s *ChatIntakeService) contactRef(channel, userID string) string {
+	return chatContactRef(s.secret, channel, userID)
+}
+
+// chatContactRef derives the contact ref of a chat platform user
+func chatContactRef(secret []byte, channel, userID string) string {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(channel + ":" + userID))
+	return channel + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+// lineMessenger answers with reply tokens where it can, since replies do not
+// count against the channel's push quota
+type lineMessenger struct {
+	client *line.Client
+}
+
+// NewLINEMessenger sends through the LINE Messaging API
+func NewLINEMessenger(client *line.Client) ChatMessenger {
+	return &lineMessenger{client: client}
+}
+
+func (m *lineMessenger) Reply(ctx context.Context, msg ChatMessage, texts ...string) error {
+	if msg.ReplyToken == "" {
+		return m.client.Push(ctx, msg.UserID, texts...)
+	}
+	return m.client.Reply(ctx, msg.ReplyToken, texts...)
+}
+
+func (m *lineMessenger) Push(ctx context.Context, to string, texts ...string) error {
+	return m.client.Push(ctx, to, texts...)
+}
+
+// whatsappMessenger sends each text as its own message
+type whatsappMessenger struct {
+	client *whatsapp.Client
+}
+
+// NewWhatsAppMessenger sends through the WhatsApp Cloud API
+func NewWhatsAppMessenger(client *whatsapp.Client) ChatMessenger {
+	return &whatsappMessenger{client: client}
+}
+
+func (m *whatsappMessenger) Reply(ctx context.Context, msg ChatMessage, texts ...string) error {
+	return m.Push(ctx, msg.UserID, texts...)
+}
+
+func (m *whatsappMessenger) Push(ctx context.Context, to string, texts ...string) error {
+	for _, text := range texts {
+		if err := m.client.Send(ctx, to, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logMessenger logs instead of sending, for platforms without an access
+// token in development
+type logMessenger struct {
+	channel string
+}
+
+// NewLogMessenger creates a messenger that only logs
+func NewLogMessenger(channel string) ChatMessenger {
+	return &logMessenger{channel: channel}
+}
+
+func (m *logMessenger) Reply(ctx context.Context, msg ChatMessage, texts ...string) error {
+	return m.Push(ctx, msg.UserID, texts...)
+}
+
+// Push logs the message count; recipients and content are not logged
+func (m *logMessenger) Push(ctx context.Context, to string, texts ...string) error {
+	log.Printf("%s message not sent, no access token configured: %d message(s)", m.channel, len(texts))
+	return nil
+}
+
+// chatLangZH is the language of conversations started in Chinese
+const chatLangZH = "zh-TW"
+
+// chatLang picks the conversation language from the first message
+func chatLang(text string) string {
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) {
+			return chatLangZH
+		}
+	}
+	return "en"
+}
+
+// Answers understood in either language
+var (
+	chatCancelWords = []string{"cancel", "stop", "取消", "停止"}
+	chatSkipWords   = []string{"skip", "unknown", "略過", "跳過", "不知道", "不確定"}
+	chatYesWords    = []string{"yes", "y", "ok", "send", "submit", "是", "好", "確認", "送出"}
+)
+
+// chatWord reports whether text is one of words, ignoring case and
+// trailing punctuation
+func chatWord(text string, words []string) bool {
+	text = strings.ToLower(strings.TrimRight(strings.TrimSpace(text), ".!。！"))
+	for _, w := range words {
+		if text == w {
+			return true
+		}
+	}
+	return false
+}
+
+// chatPhrases is the bot's text in one language
+type chatPhrases struct {
+	Follow       string
+	Welcome      string
+	Category     string
+	Area         string
+	TimeWindow   string
+	Description  string
+	Confirm      string // summary: category, area, time window, description
+	Invalid      string
+	TextOnly     string
+	TooLong      string // limit in characters
+	Cancelled    string
+	Received     string // report ID
+	TokenNote    string
+	Failed       string
+	StatusUpdate string // report ID, status
+	NotGiven     string
+	Categories   map[string]string
+	Statuses     map[string]string
+}
+
+func chatPhrasesFor(lang string) *chatPhrases {
+	if lang == chatLangZH {
+		return &chatPhrasesZH
+	}
+	return &chatPhrasesEN
+}
+
+// categoryPrompt lists the categories as numbered options
+func (p *chatPhrases) categoryPrompt() string {
+	var b strings.Builder
+	b.WriteString(p.Category)
+	for i, category := range model.ValidCategories() {
+		fmt.Fprintf(&b, "\n%d. %s", i+1, p.Categories[category])
+	}
+	return b.String()
+}
+
+// parseCategory reads a category answer: an option number, a category
+// label in either language, or a category name
+func (p *chatPhrases) parseCategory(text string) string {
+	text = strings.ToLower(strings.TrimRight(strings.TrimSpace(text), ".。"))
+	categories := model.ValidCategories()
+	if n, err := strconv.Atoi(text); err == nil && n >= 1 && n <= len(categories) {
+		return categories[n-1]
+	}
+	for _, category := range categories {
+		if text == category ||
+			text == strings.ToLower(chatPhrasesEN.Categories[category]) ||
+			text == chatPhrasesZH.Categories[category] {
+			return category
+		}
+	}
+	return ""
+}
+
+// prompt is the question for a conversation's current step
+func (p *chatPhrases) prompt(session *model.ChatSession) string {
+	switch session.Step {
+	case model.ChatStepArea:
+		return p.Area
+	case model.ChatStepTimeWindow:
+		return p.TimeWindow
+	case model.ChatStepDescription:
+		return p.Description
+	case model.ChatStepConfirm:
+		timeWindow := session.TimeWindow
+		if timeWindow == "" {
+			timeWindow = p.NotGiven
+		}
+		return fmt.Sprintf(p.Confirm, p.Categories[session.Category], session.AreaHint, timeWindow, session.Description)
+	}
+	return p.categoryPrompt()
+}
+
+var chatPhrasesEN = chatPhrases{
+	Follow:       "Thanks for adding us. Send any message to report a safety concern or a scam.",
+	Welcome:      "Hello. I will ask a few questions to file a safety report. Do not include your name, phone number or home address. If anyone is in immediate danger, call 110 (police) or 119 (fire and ambulance) now. Send CANCEL at any time to stop.",
+	Category:     "What would you like to report? Reply with a number:",
+	Area:         "Where did it happen? Describe the area, such as a landmark, station exit or intersection. Please do not send a home address.",
+	TimeWindow:   "When did it happen? For example \"today around 3pm\". Send SKIP if you are not sure.",
+	Description:  "Please describe what happened. Leave out names and contact details of anyone involved.",
+	Confirm:      "Please check your report:\n\nCategory: %s\nArea: %s\nTime: %s\n\n%s\n\nSend YES to submit or CANCEL to discard it.",
+	Invalid:      "Sorry, I did not understand that.",
+	TextOnly:     "Sorry, I can only read text messages.",
+	TooLong:      "Please answer in at most %d characters.",
+	Cancelled:    "Your report has been discarded. Send any message to start again.",
+	Received:     "Thank you. Your report has been recorded as %s and will be reviewed by our triage team. I will let you know when its status changes. Your follow-up token is below.",
+	TokenNote:    "Keep this token private. You can use it to appeal a decision on your report. We will never ask you for passwords, bank details or payments.",
+	Failed:       "Sorry, your report could not be submitted right now. Send YES again in a few minutes to retry.",
+	StatusUpdate: "Update on report %s: %s",
+	NotGiven:     "not given",
+	Categories: map[string]string{
+		model.CategorySuspiciousItem:       "Suspicious item",
+		model.CategorySuspiciousPerson:     "Suspicious person",
+		model.CategoryHarassmentStalking:   "Harassment or stalking",
+		model.CategoryScamPhishing:         "Scam or phishing",
+		model.CategoryMisinformationPanic:  "Misinformation or panic",
+		model.CategoryCrowdDisorder:        "Crowd disorder",
+		model.CategoryInfrastructureHazard: "Infrastructure hazard",
+		model.CategoryOther:                "Other",
+	},
+	Statuses: map[string]string{
+		model.StatusSubmitted:   "received and waiting for review.",
+		model.StatusUnderReview: "being reviewed by our triage team.",
+		model.StatusTriaged:     "reviewed by our triage team.",
+		model.StatusEscalated:   "passed on to the responsible partners.",
+		model.StatusClosed:      "closed. Thank you for reporting.",
+	},
+}
+
+var chatPhrasesZH = chatPhrases{
+	Follow:       "感謝您加入好友。傳送任何訊息即可通報安全疑慮或詐騙。",
+	Welcome:      "您好，我會問幾個問題來建立安全通報。請勿提供您的姓名、電話或住家地址。若有人身處立即危險，請立刻撥打 110（警察）或 119（消防及救護）。隨時傳送「取消」即可停止。",
+	Category:     "您要通報什麼？請回覆選項號碼：",
+	Area:         "事情發生在哪裡？請描述大致地點，例如地標、車站出口或路口。請勿提供住家地址。",
+	TimeWindow:   "事情大約何時發生？例如「今天下午三點左右」。不確定請傳送「略過」。",
+	Description:  "請描述發生了什麼事。請勿包含相關人士的姓名或聯絡方式。",
+	Confirm:      "請確認您的通報內容：\n\n類別：%s\n地點：%s\n時間：%s\n\n%s\n\n傳送「確認」即可送出，傳送「取消」則捨棄。",
+	Invalid:      "抱歉，我無法理解您的回覆。",
+	TextOnly:     "抱歉，我只能讀取文字訊息。",
+	TooLong:      "請將回覆限制在 %d 個字以內。",
+	Cancelled:    "您的通報已捨棄。傳送任何訊息即可重新開始。",
+	Received:     "謝謝您。您的通報已登錄為 %s，將由我們的審核團隊處理，狀態變更時我會通知您。以下是您的追蹤碼。",
+	TokenNote:    "請妥善保管此追蹤碼，可用於對通報的處理結果提出申訴。我們絕不會向您索取密碼、銀行資料或要求付款。",
+	Failed:       "抱歉，目前無法送出您的通報。請稍候幾分鐘再傳送「確認」重試。",
+	StatusUpdate: "通報 %s 更新：%s",
+	NotGiven:     "未提供",
+	Categories: map[string]string{
+		model.CategorySuspiciousItem:       "可疑物品",
+		model.CategorySuspiciousPerson:     "可疑人士",
+		model.CategoryHarassmentStalking:   "騷擾或跟蹤",
+		model.CategoryScamPhishing:         "詐騙或釣魚訊息",
+		model.CategoryMisinformationPanic:  "不實訊息或恐慌",
+		model.CategoryCrowdDisorder:        "群眾失序",
+		model.CategoryInfrastructureHazard: "設施危險",
+		model.CategoryOther:                "其他",
+	},
+	Statuses: map[string]string{
+		model.StatusSubmitted:   "已收到，等待審核。",
+		model.StatusUnderReview: "審核團隊正在處理中。",
+		model.StatusTriaged:     "審核團隊已完成審核。",
+		model.StatusEscalated:   "已轉交相關合作單位處理。",
+		model.StatusClosed:      "已結案，感謝您的通報。",
+	},
+}
@@ -0,0 +1,469 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/claimreview"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrClaimNotFound = errors.New("claim not found")
+)
+
+// ClaimService keeps the misinformation claim registry and publishes rated
+// claims as schema.org ClaimReview
+type ClaimService struct {
+	claimRepo    *repository.ClaimRepository
+	reportRepo   *repository.ReportRepository
+	incidentRepo *repository.IncidentRepository
+	auditRepo    *repository.AuditRepository
+	publisher    claimreview.Organization
+	siteURL      string
+}
+
+// NewClaimService creates a new claim service. Reviews are authored by the
+// named publisher and live at <siteURL>/claims/<id>.
+func NewClaimService(
+	claimRepo *repository.ClaimRepository,
+	reportRepo *repository.ReportRepository,
+	incidentRepo *repository.IncidentRepository,
+	auditRepo *repository.AuditRepository,
+	publisherName string,
+	siteURL string,
+) *ClaimService {
+	siteURL = strings.TrimRight(siteURL, "/")
+	return &ClaimService{
+		claimRepo:    claimRepo,
+		reportRepo:   reportRepo,
+		incidentRepo: incidentRepo,
+		auditRepo:    auditRepo,
+		publisher:    claimreview.NewOrganization(publisherName, siteURL),
+		siteURL:      siteURL,
+	}
+}
+
+// Create records a new, unverified claim linked to reports and incidents
+func (s *ClaimService) Create(ctx context.Context, req dto.CreateClaimRequest, userID *uuid.UUID, actorIP string) (*vo.ClaimDetailVO, error) {
+	reportIDs, incidentIDs, err := s.resolveLinks(ctx, req.ReportIDs, req.IncidentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	claim := &model.Claim{
+		Text:        strings.TrimSpace(req.Text),
+		Language:    req.Language,
+		Appearances: model.StringArray(req.Appearances),
+		Verdict:     model.ClaimVerdictUnverified,
+		Evidence:    model.StringArray{},
+		CreatedBy:   userID,
+	}
+	if err := s.claimRepo.Create(ctx, claim, reportIDs, incidentIDs); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionCreate,
+		ObjectType: model.ObjectTypeClaim,
+		ObjectID:   &claim.ID,
+		Diff: model.JSONMap{
+			"text":        claim.Text,
+			"reportIds":   req.ReportIDs,
+			"incidentIds": req.IncidentIDs,
+		},
+	})
+
+	return s.getDetail(ctx, claim.ID)
+}
+
+// GetByID retrieves a claim with its linked reports and incidents
+func (s *ClaimService) GetByID(ctx context.Context, id string) (*vo.ClaimDetailVO, error) {
+	claim, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.getDetail(ctx, claim.ID)
+}
+
+// List retrieves claims with pagination
+func (s *ClaimService) List(ctx context.Context, query dto.ListClaimsQuery) (*vo.ClaimListVO, error) {
+	params := repository.ListClaimParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Verdict:  query.Verdict,
+		Query:    strings.TrimSpace(query.Q),
+	}
+	if query.ReportID != "" {
+		reportID, err := uuid.Parse(query.ReportID)
+		if err != nil {
+			return nil, errors.New("invalid report ID")
+		}
+		params.ReportID = reportID
+	}
+	if query.IncidentID != "" {
+		incidentID, err := uuid.Parse(query.IncidentID)
+		if err != nil {
+			return nil, errors.New("invalid incident ID")
+		}
+		params.IncidentID = incidentID
+	}
+
+	claims, total, err := s.claimRepo.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	claimVOs := make([]vo.ClaimVO, len(claims))
+	for i, claim := range claims {
+		claimVOs[i] = *toClaimVO(&claim)
+	}
+
+	return &vo.ClaimListVO{
+		Data:       claimVOs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// Update edits a claim's text, appearances, explanation or evidence
+func (s *ClaimService) Update(ctx context.Context, id string, req dto.UpdateClaimRequest, userID *uuid.UUID, actorIP string) (*vo.ClaimDetailVO, error) {
+	claim, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := model.JSONMap{}
+	if text := strings.TrimSpace(req.Text); text != "" && text != claim.Text {
+		diff["text"] = map[string]string{"from": claim.Text, "to": text}
+		claim.Text = text
+	}
+	if req.Language != "" && req.Language != claim.Language {
+		diff["language"] = map[string]string{"from": claim.Language, "to": req.Language}
+		claim.Language = req.Language
+	}
+	if req.Appearances != nil {
+		diff["appearances"] = req.Appearances
+		claim.Appearances = model.StringArray(req.Appearances)
+	}
+	if req.Explanation != "" && req.Explanation != claim.Explanation {
+		diff["explanation"] = req.Explanation
+		claim.Explanation = req.Explanation
+	}
+	if req.Evidence != nil {
+		diff["evidence"] = req.Evidence
+		claim.Evidence = model.StringArray(req.Evidence)
+	}
+	if len(diff) == 0 {
+		return s.getDetail(ctx, claim.ID)
+	}
+
+	claim.UpdatedAt = time.Now().UTC()
+	if err := s.claimRepo.Update(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionUpdate,
+		ObjectType: model.ObjectTypeClaim,
+		ObjectID:   &claim.ID,
+		Diff:       diff,
+	})
+
+	return s.getDetail(ctx, claim.ID)
+}
+
+// SetVerdict rates a claim. A rated verdict (false, misleading or true)
+// publishes the claim; it keeps its first publication date when re-rated.
+// Setting it back to unverified withdraws the review.
+func (s *ClaimService) SetVerdict(ctx context.Context, id string, req dto.ClaimVerdictRequest, userID *uuid.UUID, actorIP string) (*vo.ClaimDetailVO, error) {
+	claim, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := claim.Verdict
+	now := time.Now().UTC()
+	claim.Verdict = req.Verdict
+	if req.Explanation != "" {
+		claim.Explanation = req.Explanation
+	}
+	if req.Evidence != nil {
+		claim.Evidence = model.StringArray(req.Evidence)
+	}
+	claim.ReviewedBy = userID
+	claim.ReviewedAt = &now
+	claim.UpdatedAt = now
+	if claimreview.Publishable(req.Verdict) {
+		if claim.PublishedAt == nil {
+			claim.PublishedAt = &now
+		}
+	} else {
+		claim.PublishedAt = nil
+	}
+	if err := s.claimRepo.Update(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionReview,
+		ObjectType: model.ObjectTypeClaim,
+		ObjectID:   &claim.ID,
+		Diff: model.JSONMap{
+			"verdict":     map[string]string{"from": from, "to": req.Verdict},
+			"explanation": claim.Explanation,
+			"evidence":    claim.Evidence,
+			"published":   claim.PublishedAt != nil,
+		},
+	})
+
+	return s.getDetail(ctx, claim.ID)
+}
+
+// Link links further reports and incidents to a claim
+func (s *ClaimService) Link(ctx context.Context, id string, req dto.LinkClaimRequest, userID *uuid.UUID, actorIP string) (*vo.ClaimDetailVO, error) {
+	claim, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reportIDs, incidentIDs, err := s.resolveLinks(ctx, req.ReportIDs, req.IncidentIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.claimRepo.Link(ctx, claim.ID, reportIDs, incidentIDs); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionLink,
+		ObjectType: model.ObjectTypeClaim,
+		ObjectID:   &claim.ID,
+		Diff: model.JSONMap{
+			"reportIds":   req.ReportIDs,
+			"incidentIds": req.IncidentIDs,
+		},
+	})
+
+	return s.getDetail(ctx, claim.ID)
+}
+
+// Review returns the published ClaimReview of a claim. Unpublished claims
+// are reported as not found.
+func (s *ClaimService) Review(ctx context.Context, id string) (*claimreview.ClaimReview, error) {
+	claim, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	review, ok := s.toClaimReview(claim)
+	if !ok {
+		return nil, ErrClaimNotFound
+	}
+	return review, nil
+}
+
+// ReviewFeed returns a page of published reviews, most recently published
+// first, as a schema.org DataFeed, and the number of published reviews
+func (s *ClaimService) ReviewFeed(ctx context.Context, query dto.ListClaimReviewsQuery) (*claimreview.DataFeed, int64, error) {
+	claims, total, err := s.claimRepo.ListPublished(ctx, query.Page, query.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var modified time.Time
+	reviews := make([]claimreview.ClaimReview, 0, len(claims))
+	for i := range claims {
+		review, ok := s.toClaimReview(&claims[i])
+		if !ok {
+			continue
+		}
+		reviews = append(reviews, *review)
+		if claims[i].UpdatedAt.After(modified) {
+			modified = claims[i].UpdatedAt
+		}
+	}
+
+	return claimreview.Feed(s.publisher, reviews, modified), total, nil
+}
+
+// get loads a claim by its string ID
+func (s *ClaimService) get(ctx context.Context, id string) (*model.Claim, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrClaimNotFound
+	}
+	claim, err := s.claimRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, ErrClaimNotFound
+	}
+	return claim, nil
+}
+
+// getDetail loads a claim with its linked reports and incidents
+func (s *ClaimService) getDetail(ctx context.Context, id uuid.UUID) (*vo.ClaimDetailVO, error) {
+	claim, err := s.claimRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, ErrClaimNotFound
+	}
+
+	reports, err := s.claimRepo.ListReports(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	incidents, err := s.claimRepo.ListIncidents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	incidentIDs := make([]uuid.UUID, len(incidents))
+	for i, inc := range incidents {
+		incidentIDs[i] = inc.ID
+	}
+	counts, err := s.incidentRepo.CountReports(ctx, incidentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &vo.ClaimDetailVO{
+		ClaimVO:   *toClaimVO(claim),
+		Reports:   make([]vo.ReportVO, len(reports)),
+		Incidents: make([]vo.IncidentVO, len(incidents)),
+	}
+	for i := range reports {
+		detail.Reports[i] = *toReportVO(&reports[i])
+	}
+	for i := range incidents {
+		detail.Incidents[i] = *toIncidentVO(&incidents[i], counts[incidents[i].ID])
+	}
+	return detail, nil
+}
+
+// resolveLinks parses report and incident IDs and checks that they exist
+func (s *ClaimService) resolveLinks(ctx context.Context, rawReports, rawIncidents []string) ([]uuid.UUID, []uuid.UUID, error) {
+	reportIDs, err := parseUUIDs(rawReports)
+	if err != nil {
+		return nil, nil, ErrReportNotFound
+	}
+	reports, err := s.reportRepo.GetByIDs(ctx, reportIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(reports) != len(reportIDs) {
+		return nil, nil, ErrReportNotFound
+	}
+
+	incidentIDs, err := parseUUIDs(rawIncidents)
+	if err != nil {
+		return nil, nil, ErrIncidentNotFound
+	}
+	incidents, err := s.incidentRepo.GetByIDs(ctx, incidentIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(incidents) != len(incidentIDs) {
+		return nil, nil, ErrIncidentNotFound
+	}
+
+	return reportIDs, incidentIDs, nil
+}
+
+// toClaimReview renders a published claim as a ClaimReview
+func (s *ClaimService) toClaimReview(claim *model.Claim) (*claimreview.ClaimReview, bool) {
+	if claim.PublishedAt == nil {
+		return nil, false
+	}
+	review := claimreview.Review{
+		URL:         s.siteURL + "/claims/" + claim.ID.String(),
+		Claim:       claim.Text,
+		Language:    claim.Language,
+		Appearances: claim.Appearances,
+		FirstSeen:   claim.CreatedAt,
+		Verdict:     claim.Verdict,
+		Explanation: claim.Explanation,
+		Evidence:    claim.Evidence,
+		Published:   *claim.PublishedAt,
+		Modified:    claim.UpdatedAt,
+	}
+	return claimreview.Build(s.publisher, review)
+}
+
+// parseUUIDs parses a list of UUIDs, dropping duplicates
+func parseUUIDs(raw []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(raw))
+	seen := make(map[uuid.UUID]bool, len(raw))
+	for _, r := range raw {
+		uid, err := uuid.Parse(r)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[uid] {
+			seen[uid] = true
+			ids = append(ids, uid)
+		}
+	}
+	return ids, nil
+}
+
+// toClaimVO converts a claim model to VO
+func toClaimVO(claim *model.Claim) *vo.ClaimVO {
+	result := &vo.ClaimVO{
+		ID:          claim.ID.String(),
+		Text:        claim.Text,
+		Language:    claim.Language,
+		Appearances: claim.Appearances,
+		Verdict:     claim.Verdict,
+		Explanation: claim.Explanation,
+		Evidence:    claim.Evidence,
+		PublishedAt: claim.PublishedAt,
+		ReviewedAt:  claim.ReviewedAt,
+		CreatedAt:   claim.CreatedAt,
+		UpdatedAt:   claim.UpdatedAt,
+	}
+	if result.Appearances == nil {
+		result.Appearances = []string{}
+	}
+	if result.Evidence == nil {
+		result.Evidence = []string{}
+	}
+
+	if claim.Reviewer != nil {
+		result.ReviewedBy = &vo.UserSummaryVO{
+			ID:          claim.Reviewer.ID.String(),
+			DisplayName: claim.Reviewer.DisplayName,
+			Role:        claim.Reviewer.Role,
+		}
+	}
+	if claim.Creator != nil {
+		result.CreatedBy = &vo.UserSummaryVO{
+			ID:          claim.Creator.ID.String(),
+			DisplayName: claim.Creator.DisplayName,
+			Role:        claim.Creator.Role,
+		}
+	}
+
+	return result
+}
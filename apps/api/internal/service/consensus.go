@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrRoundNotFound     = errors.New("consensus round not found")
+	ErrRoundDisputed     = errors.New("consensus round is waiting for a lead")
+	ErrRoundNotDisputed  = errors.New("consensus round is not disputed")
+	ErrRoundChanged      = errors.New("consensus round changed while the review was recorded")
+	ErrAlreadyReviewed   = errors.New("reviewer has already reviewed this report")
+	ErrLeadReviewed      = errors.New("a lead cannot break a tie on their own review")
+	ErrReviewerRequired  = errors.New("high-severity reviews need a signed-in reviewer")
+	ErrConsensusRequired = errors.New("high-severity decisions need independent reviews")
+	ErrInConsensus       = errors.New("report is in a consensus round")
+)
+
+// needsConsensus reports whether a decision at severity needs a second review
+func (s *TriageService) needsConsensus(severity string) bool {
+	return s.consensusSeverity != "" && model.SeverityRank(severity) >= model.SeverityRank(s.consensusSeverity)
+}
+
+// review records a blind review of a report. The first review opens a round
+// and leaves the report under review for someone else. The second closes it:
+// when both reviews reach the same decision and severity the decision is
+// final, otherwise the round is disputed and leads are asked to break the tie.
+func (s *TriageService) review(ctx context.Context, report *model.Report, round *model.ConsensusRound, review *model.TriageReview, lease *model.ReportLease, userID *uuid.UUID, actorIP string) (*vo.TriageDecisionVO, *vo.ConsensusRoundVO, error) {
+	if userID == nil {
+		return nil, nil, ErrReviewerRequired
+	}
+	review.ReviewerID = *userID
+
+	if round == nil {
+		round = &model.ConsensusRound{ReportID: report.ID}
+		if err := s.consensusRepo.CreateRound(ctx, round, review); err != nil {
+			return nil, nil, err
+		}
+		round.Reviews = []model.TriageReview{*review}
+
+		if report.Status == model.StatusSubmitted {
+			if _, err := s.reportRepo.TransitionStatus(ctx, report.ID, report.Status, model.StatusUnderReview); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		// Let the next reviewer claim the report
+		if lease != nil {
+			s.leases.Release(ctx, report.ID, lease.HolderID)
+		}
+
+		s.auditReview(ctx, round, review, actorIP)
+		return nil, toConsensusRoundVO(round), nil
+	}
+
+	if round.Status == model.ConsensusStatusDisputed {
+		return nil, nil, ErrRoundDisputed
+	}
+	for _, r := range round.Reviews {
+		if r.ReviewerID == *userID {
+			return nil, nil, ErrAlreadyReviewed
+		}
+	}
+
+	status := model.ConsensusStatusDisputed
+	if review.Agrees(&round.Reviews[0]) {
+		status = model.ConsensusStatusAgreed
+	}
+	added, err := s.consensusRepo.AddReview(ctx, round.ID, review, status)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !added {
+		return nil, nil, ErrRoundChanged
+	}
+	round.Status = status
+	round.Reviews = append(round.Reviews, *review)
+	s.auditReview(ctx, round, review, actorIP)
+
+	if status == model.ConsensusStatusDisputed {
+		if lease != nil {
+			s.leases.Release(ctx, report.ID, lease.HolderID)
+		}
+		if err := s.notifyDisputed(ctx, round); err != nil {
+			return nil, nil, err
+		}
+		return nil, toConsensusRoundVO(round), nil
+	}
+
+	// The reviewers agree; the completing review becomes the decision
+	decision := &model.TriageDecision{
+		ReportID:        report.ID,
+		DecidedBy:       userID,
+		Decision:        review.Decision,
+		SeverityFinal:   review.SeverityFinal,
+		EvidenceLevel:   review.EvidenceLevel,
+		Rationale:       review.Rationale,
+		RubricVersion:   review.RubricVersion,
+		RationaleFields: review.RationaleFields,
+		Confirmations:   review.Confirmations,
+		RoundID:         &round.ID,
+	}
+	if err := s.finalize(ctx, report, decision, lease, actorIP); err != nil {
+		return nil, nil, err
+	}
+
+	return s.toTriageDecisionVO(decision), nil, nil
+}
+
+// ResolveRound breaks the tie in a disputed round with a lead's decision,
+// which becomes the final triage decision. The lead must not be one of the
+// reviewers.
+func (s *TriageService) ResolveRound(ctx context.Context, id string, req dto.TriageRequest, userID *uuid.UUID, actorIP string) (*vo.TriageDecisionVO, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrRoundNotFound
+	}
+	if userID == nil {
+		return nil, ErrReviewerRequired
+	}
+
+	round, err := s.consensusRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if round == nil {
+		return nil, ErrRoundNotFound
+	}
+	if round.Status != model.ConsensusStatusDisputed {
+		return nil, ErrRoundNotDisputed
+	}
+	for _, r := range round.Reviews {
+		if r.ReviewerID == *userID {
+			return nil, ErrLeadReviewed
+		}
+	}
+
+	report, err := s.reportRepo.GetByID(ctx, round.ReportID)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, ErrReportNotFound
+	}
+	rubricVersion, err := s.checkRubric(ctx, report, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The lead's decision also ends any claim on the report
+	lease, err := s.leases.Get(ctx, report.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := &model.TriageDecision{
+		ReportID:        report.ID,
+		DecidedBy:       userID,
+		Decision:        req.Decision,
+		SeverityFinal:   req.SeverityFinal,
+		EvidenceLevel:   req.EvidenceLevel,
+		Rationale:       req.Rationale,
+		RubricVersion:   rubricVersion,
+		RationaleFields: rationaleFieldsMap(req.RationaleFields),
+		Confirmations:   req.Confirmations,
+		RoundID:         &round.ID,
+	}
+	newStatus, err := prepareDecision(report, decision)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the round and record the decision together, so a failure
+	// leaves the round disputed for another try
+	resolved, err := s.consensusRepo.Resolve(ctx, decision, report.Status, newStatus)
+	if err != nil {
+		return nil, err
+	}
+	if !resolved {
+		return nil, ErrRoundChanged
+	}
+	s.decided(ctx, report, decision, lease, newStatus, actorIP)
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionResolve,
+		ObjectType: model.ObjectTypeConsensus,
+		ObjectID:   &round.ID,
+		Diff: model.JSONMap{
+			"reportId":      report.ID.String(),
+			"decisionId":    decision.ID.String(),
+			"decision":      decision.Decision,
+			"severityFinal": decision.SeverityFinal,
+		},
+	})
+
+	return s.toTriageDecisionVO(decision), nil
+}
+
+// ListRounds retrieves consensus rounds with pagination
+func (s *TriageService) ListRounds(ctx context.Context, query dto.ListConsensusRoundsQuery) (*vo.ConsensusRoundListVO, error) {
+	var reportID uuid.UUID
+	if query.ReportID != "" {
+		var err error
+		reportID, err = uuid.Parse(query.ReportID)
+		if err != nil {
+			return nil, errors.New("invalid report ID")
+		}
+	}
+
+	rounds, total, err := s.consensusRepo.List(ctx, repository.ListConsensusParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Status:   query.Status,
+		ReportID: reportID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roundVOs := make([]vo.ConsensusRoundVO, len(rounds))
+	for i := range rounds {
+		roundVOs[i] = *toConsensusRoundVO(&rounds[i])
+	}
+
+	return &vo.ConsensusRoundListVO{
+		Data:       roundVOs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// GetRound retrieves a consensus round
+func (s *TriageService) GetRound(ctx context.Context, id string) (*vo.ConsensusRoundVO, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrRoundNotFound
+	}
+
+	round, err := s.consensusRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if round == nil {
+		return nil, ErrRoundNotFound
+	}
+	return toConsensusRoundVO(round), nil
+}
+
+// auditReview records a review in the audit log. Auditors see the review
+// even while it is hidden from other reviewers.
+func (s *TriageService) auditReview(ctx context.Context, round *model.ConsensusRound, review *model.TriageReview, actorIP string) {
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    &review.ReviewerID,
+		ActorIP:    actorIP,
+		Action:     model.ActionReview,
+		ObjectType: model.ObjectTypeConsensus,
+		ObjectID:   &round.ID,
+		Diff: model.JSONMap{
+			"reportId":      round.ReportID.String(),
+			"review":        len(round.Reviews),
+			"decision":      review.Decision,
+			"severityFinal": review.SeverityFinal,
+			"evidenceLevel": review.EvidenceLevel,
+			"status":        round.Status,
+		},
+	})
+}
+
+// notifyDisputed asks active admins, the leads, to break a tie
+func (s *TriageService) notifyDisputed(ctx context.Context, round *model.ConsensusRound) error {
+	admins, err := s.userRepo.ListActiveByRole(ctx, model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	var recipients []uuid.UUID
+	for _, u := range admins {
+		// A lead who reviewed the report cannot break the tie
+		reviewed := false
+		for _, r := range round.Reviews {
+			if r.ReviewerID == u.ID {
+				reviewed = true
+			}
+		}
+		if !reviewed {
+			recipients = append(recipients, u.ID)
+		}
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	first, second := round.Reviews[0], round.Reviews[1]
+	return s.notificationSvc.Notify(ctx, recipients, model.Notification{
+		Kind:       model.NotificationDisputed,
+		Title:      "Triage reviewers disagree: tie-break needed",
+		Body:       fmt.Sprintf("One reviewer chose %s at %s, the other %s at %s.", first.Decision, first.SeverityFinal, second.Decision, second.SeverityFinal),
+		ObjectType: model.ObjectTypeConsensus,
+		ObjectID:   &round.ID,
+	})
+}
+
+// toConsensusRoundVO converts a consensus round to VO. Reviews are left out
+// while the round is open so that the second reviewer decides blind.
+func toConsensusRoundVO(round *model.ConsensusRound) *vo.ConsensusRoundVO {
+	result := &vo.ConsensusRoundVO{
+		ID:              round.ID.String(),
+		ReportID:        round.ReportID.String(),
+		Status:          round.Status,
+		ReviewCount:     len(round.Reviews),
+		ReviewsRequired: model.ConsensusReviews,
+		ResolvedBy:      toUserSummaryVO(round.Resolver),
+		CreatedAt:       round.CreatedAt,
+		ClosedAt:        round.ClosedAt,
+	}
+	if round.DecisionID != nil {
+		result.DecisionID = round.DecisionID.String()
+	}
+	if round.Status == model.ConsensusStatusOpen {
+		return result
+	}
+
+	result.Reviews = make([]vo.TriageReviewVO, len(round.Reviews))
+	for i, r := range round.Reviews {
+		review := vo.TriageReviewVO{
+			ID:            r.ID.String(),
+			Reviewer:      toUserSummaryVO(r.Reviewer),
+			Decision:      r.Decision,
+			SeverityFinal: r.SeverityFinal,
+			EvidenceLevel: r.EvidenceLevel,
+			Rationale:     r.Rationale,
+			Confirmations: r.Confirmations,
+			RubricVersion: r.RubricVersion,
+			CreatedAt:     r.CreatedAt,
+		}
+		if len(r.RationaleFields) > 0 {
+			review.RationaleFields = r.RationaleFields
+		}
+		result.Reviews[i] = review
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrCursorSort    = errors.New("cursor pagination requires sorting by createdAt")
+)
+
+// cursorPayload is the JSON inside an opaque cursor
+type cursorPayload struct {
+	T  time.Time `json:"t"`
+	ID uuid.UUID `json:"id"`
+}
+
+// encodeCursor turns a keyset position into an opaque string
+func encodeCursor(c repository.Cursor) string {
+	data, _ := json.Marshal(cursorPayload{T: c.Time, ID: c.ID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a cursor from a list query. An empty string means the
+// caller is using page numbers.
+func decodeCursor(s string) (*repository.Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var p cursorPayload
+	if err := json.Unmarshal(data, &p); err != nil || p.ID == uuid.Nil || p.T.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &repository.Cursor{Time: p.T, ID: p.ID}, nil
+}
+
+// newPagination builds page metadata for an offset list
+func newPagination(page, pageSize int, total int64) *vo.PaginationVO {
+	return &vo.PaginationVO{
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}
+}
+
+// hasNextPage reports whether more rows follow the current page. With a
+// cursor the repository fetched one extra row; otherwise the total decides.
+func hasNextPage(after *repository.Cursor, fetched, page, pageSize int, total int64) bool {
+	if after != nil {
+		return fetched > pageSize
+	}
+	return int64(page*pageSize) < total
+}
+
+// newCursorPagination builds cursor metadata pointing after last
+func newCursorPagination(pageSize int, hasMore bool, last repository.Cursor) *vo.CursorPaginationVO {
+	result := &vo.CursorPaginationVO{
+		HasMore:  hasMore,
+		PageSize: pageSize,
+	}
+	if hasMore {
+		result.NextCursor = encodeCursor(last)
+	}
+	return result
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/simhash"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrDuplicateNotFound = errors.New("duplicate candidate not found")
+	ErrDuplicateReviewed = errors.New("duplicate candidate already reviewed")
+)
+
+// Duplicate scoring weights and limits
+const (
+	duplicateTextWeight    = 0.6
+	duplicateAreaWeight    = 0.25
+	duplicateTimeWeight    = 0.15
+	duplicatePoolSize      = 500
+	duplicateMaxCandidates = 5
+	duplicateTimeDecay     = 6 * time.Hour
+)
+
+// DuplicateService scores new reports against recent ones and manages suggestions
+type DuplicateService struct {
+	duplicateRepo *repository.DuplicateRepository
+	reportRepo    *repository.ReportRepository
+	incidentRepo  *repository.IncidentRepository
+	auditRepo     *repository.AuditRepository
+	threshold     float64
+	lookback      time.Duration
+}
+
+// NewDuplicateService creates a new duplicate detection service
+func NewDuplicateService(
+	duplicateRepo *repository.DuplicateRepository,
+	reportRepo *repository.ReportRepository,
+	incidentRepo *repository.IncidentRepository,
+	auditRepo *repository.AuditRepository,
+	threshold float64,
+	lookback time.Duration,
+) *DuplicateService {
+	return &DuplicateService{
+		duplicateRepo: duplicateRepo,
+		reportRepo:    reportRepo,
+		incidentRepo:  incidentRepo,
+		auditRepo:     auditRepo,
+		threshold:     threshold,
+		lookback:      lookback,
+	}
+}
+
+// Fingerprint sets the SimHash of a report's description
+func (s *DuplicateService) Fingerprint(report *model.Report) {
+	report.SimHash = int64(simhash.Fingerprint(report.Description))
+}
+
+// Detect scores a newly created report against recent reports and stores
+// the best matches above the threshold as suggested duplicates
+func (s *DuplicateService) Detect(ctx context.Context, report *model.Report) ([]model.DuplicateCandidate, error) {
+	since := report.CreatedAt.Add(-s.lookback)
+	pool, err := s.reportRepo.ListRecent(ctx, since, report.ID, duplicatePoolSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []model.DuplicateCandidate
+	for _, other := range pool {
+		textScore, areaScore, timeScore := scoreDuplicate(report, &other)
+		score := duplicateTextWeight*textScore + duplicateAreaWeight*areaScore + duplicateTimeWeight*timeScore
+		if score < s.threshold {
+			continue
+		}
+		candidates = append(candidates, model.DuplicateCandidate{
+			ReportID:    report.ID,
+			CandidateID: other.ID,
+			Score:       roundScore(score),
+			TextScore:   roundScore(textScore),
+			AreaScore:   roundScore(areaScore),
+			TimeScore:   roundScore(timeScore),
+			Status:      model.DuplicateStatusSuggested,
+		})
+	}
+
+	// Keep only the strongest matches
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+	if len(candidates) > duplicateMaxCandidates {
+		candidates = candidates[:duplicateMaxCandidates]
+	}
+
+	if err := s.duplicateRepo.CreateBatch(ctx, candidates); err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// ListCandidates retrieves suggested duplicates for a report
+func (s *DuplicateService) ListCandidates(ctx context.Context, reportID string) ([]vo.DuplicateCandidateVO, error) {
+	uid, err := uuid.Parse(reportID)
+	if err != nil {
+		return nil, ErrReportNotFound
+	}
+
+	candidates, err := s.duplicateRepo.ListByReport(ctx, uid, "")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vo.DuplicateCandidateVO, len(candidates))
+	for i, c := range candidates {
+		result[i] = *toDuplicateCandidateVO(&c)
+	}
+	return result, nil
+}
+
+// Link confirms a suggested duplicate by grouping both reports under one incident
+func (s *DuplicateService) Link(ctx context.Context, reportID, candidateID string, userID *uuid.UUID, actorIP string) (*vo.DuplicateCandidateVO, error) {
+	candidate, err := s.getPending(ctx, reportID, candidateID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, other := &candidate.Report, &candidate.Candidate
+
+	// Reuse an existing incident where possible, otherwise open a new one
+	var incidentID uuid.UUID
+	switch {
+	case other.IncidentID != nil:
+		incidentID = *other.IncidentID
+		err = s.incidentRepo.AttachReports(ctx, incidentID, []uuid.UUID{report.ID})
+	case report.IncidentID != nil:
+		incidentID = *report.IncidentID
+		err = s.incidentRepo.AttachReports(ctx, incidentID, []uuid.UUID{other.ID})
+	default:
+		incident := &model.Incident{
+			Title:     duplicateIncidentTitle(other),
+			Category:  other.Category,
+			Severity:  other.SeveritySuggested,
+			Status:    model.IncidentStatusOpen,
+			AreaHint:  other.AreaHint,
+			CreatedBy: userID,
+		}
+		err = s.incidentRepo.Create(ctx, incident, []uuid.UUID{other.ID, report.ID})
+		incidentID = incident.ID
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.duplicateRepo.UpdateStatus(ctx, candidate.ID, model.DuplicateStatusLinked, userID); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionLink,
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &report.ID,
+		Diff: model.JSONMap{
+			"duplicateOf": other.ID.String(),
+			"incidentId":  incidentID.String(),
+			"score":       candidate.Score,
+		},
+	})
+
+	return s.reload(ctx, candidate.ID)
+}
+
+// Dismiss rejects a suggested duplicate
+func (s *DuplicateService) Dismiss(ctx context.Context, reportID, candidateID string, userID *uuid.UUID, actorIP string) (*vo.DuplicateCandidateVO, error) {
+	candidate, err := s.getPending(ctx, reportID, candidateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.duplicateRepo.UpdateStatus(ctx, candidate.ID, model.DuplicateStatusDismissed, userID); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionDismiss,
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &candidate.ReportID,
+		Diff: model.JSONMap{
+			"duplicateOf": candidate.CandidateID.String(),
+			"score":       candidate.Score,
+		},
+	})
+
+	return s.reload(ctx, candidate.ID)
+}
+
+// getPending loads a suggested duplicate that belongs to the given report
+func (s *DuplicateService) getPending(ctx context.Context, reportID, candidateID string) (*model.DuplicateCandidate, error) {
+	reportUUID, err := uuid.Parse(reportID)
+	if err != nil {
+		return nil, ErrReportNotFound
+	}
+	candidateUUID, err := uuid.Parse(candidateID)
+	if err != nil {
+		return nil, ErrDuplicateNotFound
+	}
+
+	candidate, err := s.duplicateRepo.GetByID(ctx, candidateUUID)
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil || candidate.ReportID != reportUUID {
+		return nil, ErrDuplicateNotFound
+	}
+	if candidate.Status != model.DuplicateStatusSuggested {
+		return nil, ErrDuplicateReviewed
+	}
+
+	return candidate, nil
+}
+
+// reload fetches a duplicate candidate after an update
+func (s *DuplicateService) reload(ctx context.Context, id uuid.UUID) (*vo.DuplicateCandidateVO, error) {
+	candidate, err := s.duplicateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if candidate == nil {
+		return nil, ErrDuplicateNotFound
+	}
+	return toDuplicateCandidateVO(candidate), nil
+}
+
+// scoreDuplicate compares two reports on text, area and time (each 0..1)
+func scoreDuplicate(a, b *model.Report) (text, area, when float64) {
+	// Unrelated texts agree on about half the SimHash bits, so rescale 0.5..1 to 0..1
+	similarity := simhash.Similarity(uint64(a.SimHash), uint64(b.SimHash))
+	if a.SimHash == 0 || b.SimHash == 0 {
+		similarity = simhash.Similarity(simhash.Fingerprint(a.Description), simhash.Fingerprint(b.Description))
+	}
+	text = math.Max(0, (similarity-0.5)*2)
+
+	// Blank hints say nothing about the place, so they never match exactly
+	if areaA := normalizeArea(a.AreaHint); areaA != "" && areaA == normalizeArea(b.AreaHint) {
+		area = 1
+	} else {
+		area = simhash.Jaccard(a.AreaHint, b.AreaHint)
+	}
+
+	startA, endA := parseTimeWindow(a.TimeWindow, a.CreatedAt)
+	startB, endB := parseTimeWindow(b.TimeWindow, b.CreatedAt)
+	gap := time.Duration(0)
+	if endA.Before(startB) {
+		gap = startB.Sub(endA)
+	} else if endB.Before(startA) {
+		gap = startA.Sub(endB)
+	}
+	when = math.Max(0, 1-float64(gap)/float64(duplicateTimeDecay))
+
+	return text, area, when
+}
+
+// parseTimeWindow interprets the free-text time window of a report. Windows
+// that cannot be parsed fall back to the hour before submission.
+func parseTimeWindow(window string, fallback time.Time) (time.Time, time.Time) {
+	window = strings.TrimSpace(window)
+	loc := fallback.Location()
+
+	// "2006-01-02 15:04-16:00" or "2006-01-02 15:04 - 16:00"
+	if len(window) >= 16 {
+		if start, err := time.ParseInLocation("2006-01-02 15:04", window[:16], loc); err == nil {
+			rest := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(window[16:]), "-"))
+			if endClock, err := time.Parse("15:04", rest); err == nil {
+				end := time.Date(start.Year(), start.Month(), start.Day(), endClock.Hour(), endClock.Minute(), 0, 0, loc)
+				if end.Before(start) {
+					end = end.Add(24 * time.Hour)
+				}
+				return start, end
+			}
+			return start, start.Add(time.Hour)
+		}
+	}
+
+	// "2006-01-02"
+	if day, err := time.ParseInLocation("2006-01-02", window, loc); err == nil {
+		return day, day.Add(24 * time.Hour)
+	}
+
+	return fallback.Add(-time.Hour), fallback
+}
+
+// normalizeArea lowercases an area hint and collapses whitespace
+func normalizeArea(area string) string {
+	return strings.Join(strings.Fields(strings.ToLower(area)), " ")
+}
+
+// duplicateIncidentTitle builds a default title for an incident opened from duplicates
+func duplicateIncidentTitle(report *model.Report) string {
+	title := strings.ReplaceAll(report.Category, "_", " ") + " near " + report.AreaHint
+	if len([]rune(title)) > 255 {
+		title = string([]rune(title)[:255])
+	}
+	return title
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*10000) / 10000
+}
+
+// toDuplicateCandidateVO converts a duplicate candidate model to VO
+func toDuplicateCandidateVO(candidate *model.DuplicateCandidate) *vo.DuplicateCandidateVO {
+	result := &vo.DuplicateCandidateVO{
+		ID:         candidate.ID.String(),
+		ReportID:   candidate.ReportID.String(),
+		Score:      candidate.Score,
+		TextScore:  candidate.TextScore,
+		AreaScore:  candidate.AreaScore,
+		TimeScore:  candidate.TimeScore,
+		Status:     candidate.Status,
+		ReviewedAt: candidate.ReviewedAt,
+		CreatedAt:  candidate.CreatedAt,
+	}
+
+	if candidate.Candidate.ID != uuid.Nil {
+		result.Candidate = toReportVO(&candidate.Candidate)
+	}
+
+	return result
+}
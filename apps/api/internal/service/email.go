@@ -0,0 +1,408 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/indicator"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/mailer"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/mailparse"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/smtpd"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrEmailTooLarge   = errors.New("email exceeds the size limit")
+	ErrEmailUnreadable = errors.New("email could not be parsed")
+	ErrEmailAutomated  = errors.New("email was sent automatically")
+	ErrEmailEmpty      = errors.New("email has no text")
+)
+
+// Email intake limits
+const (
+	// Longest report description built from an email, in runes
+	emailDescriptionLimit = 20000
+	// Area hint of emailed reports, which carry no location
+	emailAreaHint = "Forwarded email"
+	// Directory under the maildrop for files that could not be read
+	maildropFailedDir = "failed"
+)
+
+// EmailIntakeService turns forwarded scam mail into scam_phishing reports.
+// The forwarder is only known by a pseudonymous contact ref; their address
+// is used to send the follow-up token back and is not stored. Both need the
+// address to have been verified by the upstream MTA, since anyone can write
+// a From header.
+type EmailIntakeService struct {
+	reportSvc    *ReportService
+	indicatorSvc *IndicatorService
+	evidenceRepo *repository.EvidenceRepository
+	sender       mailer.Sender
+	secret       []byte
+	zoneID       string
+	replyFrom    string
+	authservID   string
+	maxSize      int64
+}
+
+// NewEmailIntakeService creates a new email intake service. Reports are
+// placed in zoneID (may be empty) and replies are sent from replyFrom.
+// Sender addresses are trusted only when verified in Authentication-Results
+// written by authservID; empty trusts none.
+func NewEmailIntakeService(reportSvc *ReportService, indicatorSvc *IndicatorService, evidenceRepo *repository.EvidenceRepository, sender mailer.Sender, secret, zoneID, replyFrom, authservID string, maxSize int64) *EmailIntakeService {
+	return &EmailIntakeService{
+		reportSvc:    reportSvc,
+		indicatorSvc: indicatorSvc,
+		evidenceRepo: evidenceRepo,
+		sender:       sender,
+		secret:       []byte(secret),
+		zoneID:       zoneID,
+		replyFrom:    replyFrom,
+		authservID:   authservID,
+		maxSize:      maxSize,
+	}
+}
+
+// Ingest files a report for a received message. The forwarded original is
+// kept as evidence with the recipient's headers removed, its sender headers
+// are recorded as indicators, and a verified forwarder gets their follow-up
+// token by reply. Mail sent by software (bounces, auto-replies) is refused with
+// ErrEmailAutomated so no reply loop can start.
+func (s *EmailIntakeService) Ingest(ctx context.Context, raw []byte) (*vo.ReportVO, error) {
+	if int64(len(raw)) > s.maxSize {
+		return nil, ErrEmailTooLarge
+	}
+	msg, err := mailparse.Parse(raw)
+	if err != nil {
+		return nil, ErrEmailUnreadable
+	}
+	if msg.Automated {
+		return nil, ErrEmailAutomated
+	}
+
+	var forwarder string
+	if msg.From != nil {
+		forwarder = strings.ToLower(msg.From.Address)
+	}
+	description := emailDescription(msg, forwarder)
+	if description == "" {
+		return nil, ErrEmailEmpty
+	}
+
+	// The contact ref doubles as the device ID, so the device and reputation
+	// gates track each forwarder. An unverified From header may name anyone,
+	// so it gets no contact ref and no reply. Email carries no client network.
+	verified := forwarder != "" && mailparse.Authenticated(msg.AuthResults, s.authservID, forwarder)
+	var contactRef string
+	if verified {
+		contactRef = s.contactRef(forwarder)
+	}
+	report, err := s.reportSvc.Create(ctx, dto.CreateReportRequest{
+		Category:        model.CategoryScamPhishing,
+		AreaHint:        emailAreaHint,
+		ZoneID:          s.zoneID,
+		Description:     description,
+		ReporterContact: contactRef,
+	}, &IntakeSignals{Channel: model.ChannelEmail, DeviceID: contactRef}, "")
+	if err != nil {
+		return nil, err
+	}
+	reportID := uuid.MustParse(report.ID)
+
+	// Evidence, header indicators and the reply are best effort: the report
+	// is filed and must not be filed again by a retrying sender
+	if err := s.attach(ctx, reportID, raw, msg); err != nil {
+		log.Printf("email evidence failed for report %s: %v", report.ID, err)
+	}
+	if original := msg.Original; original != nil {
+		var found []indicator.Indicator
+		for _, addr := range original.Addresses {
+			if addr != forwarder {
+				found = append(found, indicator.FromAddress(addr)...)
+			}
+		}
+		if _, err := s.indicatorSvc.Record(ctx, reportID, report.CreatedAt, found); err != nil {
+			log.Printf("email indicators failed for report %s: %v", report.ID, err)
+		}
+	}
+	if verified && !strings.EqualFold(forwarder, s.replyFrom) {
+		if err := s.reply(ctx, msg, forwarder, report); err != nil {
+			log.Printf("email reply failed for report %s: %v", report.ID, err)
+		}
+	}
+
+	return report, nil
+}
+
+// HandleSMTP ingests a message received by the SMTP listener. Refused mail
+// is answered with a permanent error; automated mail is accepted and
+// dropped, since bouncing it would answer a bounce.
+func (s *EmailIntakeService) HandleSMTP(ctx context.Context, env smtpd.Envelope, data []byte) error {
+	_, err := s.Ingest(ctx, data)
+	switch {
+	case err == nil, errors.Is(err, ErrEmailAutomated):
+		return nil
+	case errors.Is(err, ErrEmailTooLarge):
+		return &smtpd.Error{Code: 552, Message: "5.3.4 message too big"}
+	case errors.Is(err, ErrEmailUnreadable), errors.Is(err, ErrEmailEmpty):
+		return &smtpd.Error{Code: 554, Message: "5.6.0 message could not be read"}
+	}
+	return err
+}
+
+// RunMaildrop ingests the files dropped into dir every interval until ctx is
+// cancelled. Ingested and automated mail is deleted, since it names the
+// forwarder; unreadable files are moved to dir/failed. Files that failed for
+// other reasons are retried on the next round.
+func (s *EmailIntakeService) RunMaildrop(ctx context.Context, dir string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.drainMaildrop(ctx, dir); err != nil {
+			log.Printf("maildrop scan failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drainMaildrop ingests the regular, non-hidden files in dir, oldest first
+func (s *EmailIntakeService) drainMaildrop(ctx context.Context, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	type drop struct {
+		path    string
+		modTime time.Time
+	}
+	var drops []drop
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		drops = append(drops, drop{path: filepath.Join(dir, entry.Name()), modTime: info.ModTime()})
+	}
+	sort.Slice(drops, func(i, j int) bool { return drops[i].modTime.Before(drops[j].modTime) })
+
+	for _, d := range drops {
+		if ctx.Err() != nil {
+			return nil
+		}
+		raw, err := os.ReadFile(d.path)
+		if err != nil {
+			log.Printf("maildrop: cannot read %s: %v", filepath.Base(d.path), err)
+			continue
+		}
+
+		_, err = s.Ingest(ctx, raw)
+		switch {
+		case err == nil, errors.Is(err, ErrEmailAutomated):
+			if err := os.Remove(d.path); err != nil {
+				log.Printf("maildrop: cannot remove %s: %v", filepath.Base(d.path), err)
+			}
+		case errors.Is(err, ErrEmailTooLarge), errors.Is(err, ErrEmailUnreadable), errors.Is(err, ErrEmailEmpty):
+			log.Printf("maildrop: %s rejected: %v", filepath.Base(d.path), err)
+			if err := moveToFailed(dir, d.path); err != nil {
+				log.Printf("maildrop: cannot move %s: %v", filepath.Base(d.path), err)
+			}
+		default:
+			log.Printf("maildrop: %s will be retried: %v", filepath.Base(d.path), err)
+		}
+	}
+	return nil
+}
+
+// moveToFailed moves a maildrop file into the failed subdirectory
+func moveToFailed(dir, path string) error {
+	failed := filepath.Join(dir, maildropFailedDir)
+	if err := os.MkdirAll(failed, 0o700); err != nil {
+		return err
+	}
+	return os.Rename(path, filepath.Join(failed, filepath.Base(path)))
+}
+
+// attach stores the evidence of an emailed report: the attached original
+// with only its sender and content headers, or else the received message
+// with only its content headers
+func (s *EmailIntakeService) attach(ctx context.Context, reportID uuid.UUID, raw []byte, msg *mailparse.Message) error {
+	data, filename := mailparse.Redact(raw), "message.eml"
+	if msg.Original != nil && msg.Original.Raw != nil {
+		data, filename = mailparse.Redact(msg.Original.Raw, mailparse.SenderHeaders...), "original.eml"
+	}
+
+	sum := sha256.Sum256(data)
+	return s.evidenceRepo.Attach(ctx, &model.Evidence{
+		ReportID:    reportID,
+		Kind:        model.EvidenceKindEmail,
+		ContentType: "message/rfc822",
+		Filename:    filename,
+		Size:        int64(len(data)),
+		SHA256:      hex.EncodeToString(sum[:]),
+		Data:        data,
+	})
+}
+
+// contactRef derives the pseudonymous contact ref of a forwarder
+func (s *EmailIntakeService) contactRef(address string) string {
+	return emailContactRef(s.secret, address)
+}
+
+// emailContactRef derives the contact ref of an email address, which must be
+// lower case
+func emailContactRef(secret []byte, address string) string {
+	if address == "" {
+		return ""
+	}
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte("email:" + address))
+	return "email:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// emailReplyBody is the text of the follow-up token reply, in English and
+// Traditional Chinese
+const emailReplyBody = `Thank you for forwarding this message. It has been recorded as report
+%[1]s and will be reviewed by our triage team.
+
+Your follow-up token:
+
+    %[2]s
+
+Keep this token private. You can use it to appeal a decision on your report.
+We will never ask you for passwords, bank details or payments.
+
+感謝您轉寄這封郵件。此郵件已登錄為通報 %[1]s，將由我們的審核團隊處理。
+
+您的追蹤碼：
+
+    %[2]s
+
+請妥善保管此追蹤碼，可用於對通報的處理結果提出申訴。我們絕不會向您索取密碼、銀行資料或要求付款。
+`
+
+// emailReplySubject is the subject of the follow-up token reply. The
+// forwarded subject is not echoed, so the reply carries no sender-chosen text.
+const emailReplySubject = "Your report has been received / 您的通報已收到"
+
+// reply sends the follow-up token to the forwarder. It is marked as an
+// automatic reply and sent with an empty envelope sender (RFC 3834), so it
+// cannot trigger auto-replies or bounces back to the intake.
+func (s *EmailIntakeService) reply(ctx context.Context, msg *mailparse.Message, to string, report *vo.ReportVO) error {
+	domain := "localhost"
+	if _, d, ok := strings.Cut(s.replyFrom, "@"); ok {
+		domain = d
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", (&mail.Address{Address: s.replyFrom}).String())
+	fmt.Fprintf(&b, "To: %s\r\n", (&mail.Address{Address: to}).String())
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", emailReplySubject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Message-ID: <%s@%s>\r\n", uuid.NewString(), domain)
+	if id := strings.Map(headerSafe, msg.MessageID); id != "" {
+		fmt.Fprintf(&b, "In-Reply-To: %s\r\nReferences: %s\r\n", id, id)
+	}
+	b.WriteString("Auto-Submitted: auto-replied\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	qp := quotedprintable.NewWriter(&b)
+	fmt.Fprintf(qp, strings.ReplaceAll(emailReplyBody, "\n", "\r\n"), report.ID, report.FollowUpToken)
+	if err := qp.Close(); err != nil {
+		return err
+	}
+
+	return s.sender.Send(ctx, "", []string{to}, b.Bytes())
+}
+
+// headerSafe drops the control characters a decoded header may carry, so
+// it can be echoed into a new header
+func headerSafe(r rune) rune {
+	if r < ' ' || r == 0x7f {
+		return -1
+	}
+	return r
+}
+
+// emailSignature is the RFC 3676 signature separator
+const emailSignature = "\n-- \n"
+
+// emailDescription builds a report description from a message: the
+// forwarder's note without its signature, the original's sender headers and
+// its text. Occurrences of the forwarder's address are masked.
+func emailDescription(msg *mailparse.Message, forwarder string) string {
+	var b strings.Builder
+	if original := msg.Original; original != nil {
+		if note := stripSignature(msg.Note); note != "" {
+			b.WriteString(note + "\n\n")
+		}
+		b.WriteString("--- Forwarded message ---\n")
+		for _, h := range [][2]string{
+			{"From", original.From},
+			{"Reply-To", original.ReplyTo},
+			{"Return-Path", original.ReturnPath},
+			{"Subject", original.Subject},
+			{"Date", original.Date},
+		} {
+			if h[1] != "" {
+				b.WriteString(h[0] + ": " + h[1] + "\n")
+			}
+		}
+		b.WriteString("\n" + original.Text)
+	} else {
+		text := stripSignature(msg.Text)
+		if text == "" {
+			return ""
+		}
+		if msg.Subject != "" {
+			b.WriteString("Subject: " + msg.Subject + "\n\n")
+		}
+		b.WriteString(text)
+	}
+
+	description := strings.TrimSpace(b.String())
+	if forwarder != "" {
+		description = regexp.MustCompile(`(?i)`+regexp.QuoteMeta(forwarder)).ReplaceAllLiteralString(description, "[forwarder]")
+	}
+	return truncate(description, emailDescriptionLimit)
+}
+
+// stripSignature cuts text at its signature separator
+func stripSignature(text string) string {
+	text = "\n" + strings.ReplaceAll(text, "\r\n", "\n")
+	if i := strings.Index(text, emailSignature); i >= 0 {
+		text = text[:i]
+	}
+	return strings.TrimSpace(text)
+}
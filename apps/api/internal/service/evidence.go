@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+)
+
+var (
+	ErrEvidenceNotFound = errors.New("evidence not found")
+)
+
+// EvidenceService serves the evidence files stored with reports
+type EvidenceService struct {
+	evidenceRepo *repository.EvidenceRepository
+	auditRepo    *repository.AuditRepository
+}
+
+// NewEvidenceService creates a new evidence service
+func NewEvidenceService(evidenceRepo *repository.EvidenceRepository, auditRepo *repository.AuditRepository) *EvidenceService {
+	return &EvidenceService{
+		evidenceRepo: evidenceRepo,
+		auditRepo:    auditRepo,
+	}
+}
+
+// Download returns an evidence file of a report. Every download is audited,
+// since files such as forwarded emails can hold personal data.
+func (s *EvidenceService) Download(ctx context.Context, reportID, id string, userID *uuid.UUID, actorIP string) (*model.Evidence, error) {
+	rid, err := uuid.Parse(reportID)
+	if err != nil {
+		return nil, ErrEvidenceNotFound
+	}
+	eid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrEvidenceNotFound
+	}
+
+	evidence, err := s.evidenceRepo.GetByID(ctx, rid, eid)
+	if err != nil {
+		return nil, err
+	}
+	if evidence == nil {
+		return nil, ErrEvidenceNotFound
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionDownload,
+		ObjectType: model.ObjectTypeEvidence,
+		ObjectID:   &evidence.ID,
+		Diff: model.JSONMap{
+			"reportId": evidence.ReportID.String(),
+			"sha256":   evidence.SHA256,
+		},
+	})
+
+	return evidence, nil
+}
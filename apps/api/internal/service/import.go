@@ -0,0 +1,526 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrImportInvalid  = errors.New("import file is invalid")
+	ErrImportTooLarge = errors.New("import exceeds the row or size limit")
+	ErrImportEmpty    = errors.New("import has no rows")
+	ErrImportNotFound = errors.New("import batch not found")
+)
+
+// Import row outcomes
+const (
+	importRowImported   = "imported"
+	importRowValid      = "valid"
+	importRowInvalid    = "invalid"
+	importRowFailed     = "failed"
+	importRowRolledBack = "rolled_back"
+)
+
+// maxImportLine is the longest JSON Lines row read, in bytes
+const maxImportLine = 1 << 20
+
+// ImportService loads reports in bulk, for tabletop drills and backfills of
+// paper forms. Rows are validated like POST /v1/reports but skip the intake
+// gates, and imported reports are tagged with their batch.
+type ImportService struct {
+	importRepo   *repository.ImportRepository
+	reportRepo   *repository.ReportRepository
+	auditRepo    *repository.AuditRepository
+	locationSvc  *LocationService
+	duplicateSvc *DuplicateService
+	indicatorSvc *IndicatorService
+	maxRows      int
+	maxSize      int64
+}
+
+// NewImportService creates a new import service that accepts files of up to
+// maxRows rows and maxSize bytes
+func NewImportService(importRepo *repository.ImportRepository, reportRepo *repository.ReportRepository, auditRepo *repository.AuditRepository, locationSvc *LocationService, duplicateSvc *DuplicateService, indicatorSvc *IndicatorService, maxRows int, maxSize int64) *ImportService {
+	return &ImportService{
+		importRepo:   importRepo,
+		reportRepo:   reportRepo,
+		auditRepo:    auditRepo,
+		locationSvc:  locationSvc,
+		duplicateSvc: duplicateSvc,
+		indicatorSvc: indicatorSvc,
+		maxRows:      maxRows,
+		maxSize:      maxSize,
+	}
+}
+
+// importRow is one row of an import file
+type importRow struct {
+	line   int
+	status string
+	req    dto.CreateReportRequest
+	report *model.Report
+	errs   []string
+}
+
+// Import reads reports from r and, unless it is a dry run, stores them as
+// one batch. Without atomic, invalid rows are skipped and the rest kept;
+// with atomic, any invalid or unstorable row rolls back the whole batch,
+// which is then recorded as rolled back with no reports. Problems with the
+// file as a whole (format, header, row limit) fail the import with an error.
+func (s *ImportService) Import(ctx context.Context, r io.Reader, opts dto.ImportReportsQuery, userID *uuid.UUID, actorIP string) (*vo.ImportResultVO, error) {
+	r = &importLimitReader{r: r, left: s.maxSize}
+	var rows []*importRow
+	var err error
+	switch opts.Format {
+	case model.ImportFormatCSV:
+		rows, err = parseImportCSV(r, s.maxRows)
+	case model.ImportFormatJSONL:
+		rows, err = parseImportJSONL(r, s.maxRows)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrImportInvalid, opts.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrImportEmpty
+	}
+
+	// Validate every row the way a submission is validated
+	var valid []*importRow
+	for _, row := range rows {
+		if row.errs == nil {
+			if err := binding.Validator.ValidateStruct(&row.req); err != nil {
+				row.errs = strings.Split(err.Error(), "\n")
+			}
+		}
+		if row.errs == nil {
+			report, err := buildReport(row.req, s.locationSvc, s.duplicateSvc)
+			if err != nil {
+				row.errs = []string{err.Error()}
+			} else {
+				report.Channel = model.ChannelImport
+				row.report = report
+			}
+		}
+		if row.errs != nil {
+			row.status = importRowInvalid
+			continue
+		}
+		row.status = importRowValid
+		valid = append(valid, row)
+	}
+
+	batch := &model.ImportBatch{
+		Name:      opts.Name,
+		Format:    opts.Format,
+		Atomic:    opts.Atomic,
+		Total:     len(rows),
+		CreatedBy: userID,
+	}
+	result := &vo.ImportResultVO{
+		DryRun: opts.DryRun,
+		Atomic: opts.Atomic,
+		Total:  len(rows),
+		Valid:  len(valid),
+	}
+
+	switch {
+	case opts.DryRun:
+		batch.Status = importStatus(opts.Atomic, len(valid), len(rows))
+		result.Failed = len(rows) - len(valid)
+		return toImportResultVO(result, batch, rows), nil
+
+	case opts.Atomic && len(valid) < len(rows):
+		// Nothing is stored when any row is invalid
+		markRolledBack(valid)
+		batch.Status = model.ImportStatusRolledBack
+		if err := s.importRepo.Create(ctx, batch); err != nil {
+			return nil, err
+		}
+
+	case opts.Atomic:
+		reports := make([]*model.Report, len(valid))
+		for i, row := range valid {
+			reports[i] = row.report
+		}
+		batch.Status = model.ImportStatusCompleted
+		err := s.importRepo.CreateWithReports(ctx, batch, reports)
+		var rowErr *repository.ImportRowError
+		if errors.As(err, &rowErr) {
+			log.Printf("atomic import %q rolled back: %v", opts.Name, err)
+			markRolledBack(valid)
+			valid[rowErr.Index].status = importRowFailed
+			valid[rowErr.Index].errs = []string{"could not be stored"}
+			batch.ID = uuid.Nil
+			batch.Status = model.ImportStatusRolledBack
+			err = s.importRepo.Create(ctx, batch)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if batch.Status == model.ImportStatusCompleted {
+			for _, row := range valid {
+				row.status = importRowImported
+			}
+			batch.Imported = len(valid)
+		}
+
+	default:
+		// Rows are stored one by one; a failed row does not stop the rest
+		batch.Status = model.ImportStatusCompleted
+		if err := s.importRepo.Create(ctx, batch); err != nil {
+			return nil, err
+		}
+		for _, row := range valid {
+			row.report.ImportBatchID = &batch.ID
+			if err := s.reportRepo.Create(ctx, row.report); err != nil {
+				log.Printf("import %s line %d failed: %v", batch.ID, row.line, err)
+				row.status = importRowFailed
+				row.errs = []string{"could not be stored"}
+				continue
+			}
+			row.status = importRowImported
+			batch.Imported++
+		}
+		batch.Status = importStatus(false, batch.Imported, batch.Total)
+	}
+
+	batch.Failed = batch.Total - batch.Imported
+	if err := s.importRepo.UpdateCounts(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	// Duplicate detection and indicator extraction are best effort, as for
+	// submissions. Brigade detection is skipped: a drill floods one area by
+	// design.
+	for _, row := range valid {
+		if row.status != importRowImported {
+			continue
+		}
+		if _, err := s.duplicateSvc.Detect(ctx, row.report); err != nil {
+			log.Printf("duplicate detection failed for report %s: %v", row.report.ID, err)
+		}
+		if _, err := s.indicatorSvc.Extract(ctx, row.report); err != nil {
+			log.Printf("indicator extraction failed for report %s: %v", row.report.ID, err)
+		}
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionImport,
+		ObjectType: model.ObjectTypeImport,
+		ObjectID:   &batch.ID,
+		Diff: model.JSONMap{
+			"name":     batch.Name,
+			"format":   batch.Format,
+			"atomic":   batch.Atomic,
+			"status":   batch.Status,
+			"total":    batch.Total,
+			"imported": batch.Imported,
+			"failed":   batch.Failed,
+		},
+	})
+
+	result.BatchID = batch.ID.String()
+	result.Imported = batch.Imported
+	result.Failed = batch.Failed
+	return toImportResultVO(result, batch, rows), nil
+}
+
+// markRolledBack marks valid rows as rolled back with the rest of the batch
+func markRolledBack(rows []*importRow) {
+	for _, row := range rows {
+		row.status = importRowRolledBack
+	}
+}
+
+// List retrieves past import batches
+func (s *ImportService) List(ctx context.Context, query dto.ListImportsQuery) (*vo.ImportBatchListVO, error) {
+	batches, total, err := s.importRepo.List(ctx, repository.ListImportParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Status:   query.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	batchVOs := make([]vo.ImportBatchVO, len(batches))
+	for i, b := range batches {
+		batchVOs[i] = *toImportBatchVO(&b)
+	}
+
+	return &vo.ImportBatchListVO{
+		Data:       batchVOs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// GetByID retrieves an import batch
+func (s *ImportService) GetByID(ctx context.Context, id string) (*vo.ImportBatchVO, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrImportNotFound
+	}
+
+	batch, err := s.importRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrImportNotFound
+	}
+	return toImportBatchVO(batch), nil
+}
+
+// importStatus is the status of a batch that keeps imported of total rows
+func importStatus(atomic bool, imported, total int) string {
+	switch {
+	case imported == total:
+		return model.ImportStatusCompleted
+	case atomic:
+		return model.ImportStatusRolledBack
+	}
+	return model.ImportStatusPartial
+}
+
+// importColumns maps normalised CSV header names to request fields
+var importColumns = map[string]string{
+	"category":          "category",
+	"severitysuggested": "severitySuggested",
+	"areahint":          "areaHint",
+	"zoneid":            "zoneId",
+	"latitude":          "latitude",
+	"longitude":         "longitude",
+	"timewindow":        "timeWindow",
+	"description":       "description",
+	"evidence":          "evidence",
+	"reportercontact":   "reporterContact",
+}
+
+// parseImportCSV reads a CSV file with a header row. Header names match the
+// JSON fields of a report submission, in any case and with or without
+// underscores. Several evidence refs go in one cell, separated by "|".
+func parseImportCSV(r io.Reader, maxRows int) ([]*importRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrImportEmpty
+	}
+	if errors.Is(err, ErrImportTooLarge) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrImportInvalid, err)
+	}
+
+	columns := make([]string, len(header))
+	seen := make(map[string]bool)
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // spreadsheet byte order mark
+		}
+		key := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+		field, ok := importColumns[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrImportInvalid, name)
+		}
+		if seen[field] {
+			return nil, fmt.Errorf("%w: duplicate column %q", ErrImportInvalid, name)
+		}
+		seen[field] = true
+		columns[i] = field
+	}
+	for _, field := range []string{"category", "areaHint", "description"} {
+		if !seen[field] {
+			return nil, fmt.Errorf("%w: missing column %q", ErrImportInvalid, field)
+		}
+	}
+
+	var rows []*importRow
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, ErrImportTooLarge) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrImportInvalid, err)
+		}
+		line, _ := cr.FieldPos(0)
+		if len(rows) >= maxRows {
+			return nil, ErrImportTooLarge
+		}
+
+		row := &importRow{line: line}
+		rows = append(rows, row)
+		if len(record) != len(columns) {
+			row.errs = []string{fmt.Sprintf("has %d fields, the header has %d", len(record), len(columns))}
+			continue
+		}
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			if err := setImportField(&row.req, columns[i], value); err != nil {
+				row.errs = append(row.errs, err.Error())
+			}
+		}
+	}
+	return rows, nil
+}
+
+// setImportField sets one CSV cell on a request
+func setImportField(req *dto.CreateReportRequest, field, value string) error {
+	switch field {
+	case "category":
+		req.Category = value
+	case "severitySuggested":
+		req.SeveritySuggested = value
+	case "areaHint":
+		req.AreaHint = value
+	case "zoneId":
+		req.ZoneID = value
+	case "latitude", "longitude":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a number", field)
+		}
+		if field == "latitude" {
+			req.Latitude = &f
+		} else {
+			req.Longitude = &f
+		}
+	case "timeWindow":
+		req.TimeWindow = value
+	case "description":
+		req.Description = value
+	case "evidence":
+		for _, ref := range strings.Split(value, "|") {
+			if ref = strings.TrimSpace(ref); ref != "" {
+				req.Evidence = append(req.Evidence, ref)
+			}
+		}
+	case "reporterContact":
+		req.ReporterContact = value
+	}
+	return nil
+}
+
+// parseImportJSONL reads one report submission object per line. Blank lines
+// are skipped; unknown fields make the row invalid.
+func parseImportJSONL(r io.Reader, maxRows int) ([]*importRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxImportLine)
+
+	var rows []*importRow
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if line == 1 {
+			text = bytes.TrimPrefix(text, []byte("\ufeff"))
+		}
+		if len(text) == 0 {
+			continue
+		}
+		if len(rows) >= maxRows {
+			return nil, ErrImportTooLarge
+		}
+
+		row := &importRow{line: line}
+		rows = append(rows, row)
+		dec := json.NewDecoder(bytes.NewReader(text))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&row.req); err != nil {
+			row.errs = []string{err.Error()}
+		}
+	}
+	if err := scanner.Err(); errors.Is(err, ErrImportTooLarge) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: line %d: %v", ErrImportInvalid, line+1, err)
+	}
+	return rows, nil
+}
+
+// importLimitReader fails with ErrImportTooLarge once more than left bytes
+// have been read
+type importLimitReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (l *importLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.left -= int64(n)
+	if l.left < 0 {
+		return n, ErrImportTooLarge
+	}
+	return n, err
+}
+
+// toImportResultVO fills in a result's status and rows
+func toImportResultVO(result *vo.ImportResultVO, batch *model.ImportBatch, rows []*importRow) *vo.ImportResultVO {
+	result.Status = batch.Status
+	result.Rows = make([]vo.ImportRowVO, len(rows))
+	for i, row := range rows {
+		result.Rows[i] = vo.ImportRowVO{
+			Line:   row.line,
+			Status: row.status,
+			Errors: row.errs,
+		}
+		if row.status == importRowImported {
+			result.Rows[i].ReportID = row.report.ID.String()
+		}
+	}
+	return result
+}
+
+// toImportBatchVO converts an import batch to its response
+func toImportBatchVO(batch *model.ImportBatch) *vo.ImportBatchVO {
+	result := &vo.ImportBatchVO{
+		ID:        batch.ID.String(),
+		Name:      batch.Name,
+		Format:    batch.Format,
+		Atomic:    batch.Atomic,
+		Status:    batch.Status,
+		Total:     batch.Total,
+		Imported:  batch.Imported,
+		Failed:    batch.Failed,
+		CreatedAt: batch.CreatedAt,
+	}
+	if batch.Creator != nil {
+		result.CreatedBy = &vo.UserSummaryVO{
+			ID:          batch.Creator.ID.String(),
+			DisplayName: batch.Creator.DisplayName,
+			Role:        batch.Creator.Role,
+		}
+	}
+	return result
+}
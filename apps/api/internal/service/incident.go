@@ -0,0 +1,468 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrIncidentNotFound     = errors.New("incident not found")
+	ErrIncidentMerged       = errors.New("incident has been merged")
+	ErrInvalidIncidentMerge = errors.New("invalid incident merge")
+	ErrReportNotInIncident  = errors.New("report does not belong to incident")
+)
+
+// IncidentService handles incident business logic
+type IncidentService struct {
+	incidentRepo *repository.IncidentRepository
+	reportRepo   *repository.ReportRepository
+	auditRepo    *repository.AuditRepository
+}
+
+// NewIncidentService creates a new incident service
+func NewIncidentService(
+	incidentRepo *repository.IncidentRepository,
+	reportRepo *repository.ReportRepository,
+	auditRepo *repository.AuditRepository,
+) *IncidentService {
+	return &IncidentService{
+		incidentRepo: incidentRepo,
+		reportRepo:   reportRepo,
+		auditRepo:    auditRepo,
+	}
+}
+
+// Create creates a new incident from zero or more reports
+func (s *IncidentService) Create(ctx context.Context, req dto.CreateIncidentRequest, userID *uuid.UUID, actorIP string) (*vo.IncidentDetailVO, error) {
+	reportIDs, err := s.resolveReports(ctx, req.ReportIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	incident := &model.Incident{
+		Title:     req.Title,
+		Category:  req.Category,
+		Severity:  req.Severity,
+		Status:    model.IncidentStatusOpen,
+		Summary:   req.Summary,
+		AreaHint:  req.AreaHint,
+		CreatedBy: userID,
+	}
+
+	if err := s.incidentRepo.Create(ctx, incident, reportIDs); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionCreate,
+		ObjectType: model.ObjectTypeIncident,
+		ObjectID:   &incident.ID,
+		Diff: model.JSONMap{
+			"title":     incident.Title,
+			"category":  incident.Category,
+			"severity":  incident.Severity,
+			"reportIds": req.ReportIDs,
+		},
+	})
+
+	return s.getDetail(ctx, incident.ID)
+}
+
+// GetByID retrieves an incident with its reports and alerts
+func (s *IncidentService) GetByID(ctx context.Context, id string) (*vo.IncidentDetailVO, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrIncidentNotFound
+	}
+
+	return s.getDetail(ctx, uid)
+}
+
+// List retrieves incidents with pagination
+func (s *IncidentService) List(ctx context.Context, query dto.ListIncidentsQuery) (*vo.IncidentListVO, error) {
+	params := repository.ListIncidentParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Status:   query.Status,
+		Category: query.Category,
+		Severity: query.Severity,
+	}
+
+	incidents, total, err := s.incidentRepo.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(incidents))
+	for i, inc := range incidents {
+		ids[i] = inc.ID
+	}
+	counts, err := s.incidentRepo.CountReports(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	incidentVOs := make([]vo.IncidentVO, len(incidents))
+	for i, inc := range incidents {
+		incidentVOs[i] = *toIncidentVO(&inc, counts[inc.ID])
+	}
+
+	return &vo.IncidentListVO{
+		Data: incidentVOs,
+		Pagination: vo.PaginationVO{
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			Total:      total,
+			TotalPages: int((total + int64(query.PageSize) - 1) / int64(query.PageSize)),
+		},
+	}, nil
+}
+
+// Update updates incident metadata and lifecycle status
+func (s *IncidentService) Update(ctx context.Context, id string, req dto.UpdateIncidentRequest, userID *uuid.UUID, actorIP string) (*vo.IncidentDetailVO, error) {
+	incident, err := s.getOpenIncident(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate status transition
+	if req.Status != "" && req.Status != incident.Status && !isValidIncidentTransition(incident.Status, req.Status) {
+		return nil, ErrInvalidTransition
+	}
+
+	changes := make(model.JSONMap)
+	if req.Status != "" && req.Status != incident.Status {
+		changes["status"] = map[string]string{"from": incident.Status, "to": req.Status}
+		incident.Status = req.Status
+		if req.Status == model.IncidentStatusResolved || req.Status == model.IncidentStatusClosed {
+			now := time.Now().UTC()
+			incident.ResolvedAt = &now
+		} else {
+			incident.ResolvedAt = nil
+		}
+	}
+	if req.Severity != "" && req.Severity != incident.Severity {
+		changes["severity"] = map[string]string{"from": incident.Severity, "to": req.Severity}
+		incident.Severity = req.Severity
+	}
+	if req.Title != "" {
+		incident.Title = req.Title
+	}
+	if req.Summary != "" {
+		incident.Summary = req.Summary
+	}
+	if req.AreaHint != "" {
+		incident.AreaHint = req.AreaHint
+	}
+
+	incident.UpdatedAt = time.Now().UTC()
+
+	if err := s.incidentRepo.Update(ctx, incident); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionUpdate,
+		ObjectType: model.ObjectTypeIncident,
+		ObjectID:   &incident.ID,
+		Diff:       changes,
+	})
+
+	return s.getDetail(ctx, incident.ID)
+}
+
+// LinkReports attaches reports to an incident
+func (s *IncidentService) LinkReports(ctx context.Context, id string, req dto.LinkReportsRequest, userID *uuid.UUID, actorIP string) (*vo.IncidentDetailVO, error) {
+	incident, err := s.getOpenIncident(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reportIDs, err := s.resolveReports(ctx, req.ReportIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.incidentRepo.AttachReports(ctx, incident.ID, reportIDs); err != nil {
+		return nil, err
+	}
+
+	for _, reportID := range reportIDs {
+		reportID := reportID
+		s.auditRepo.Create(ctx, &model.AuditLog{
+			ActorID:    userID,
+			ActorIP:    actorIP,
+			Action:     model.ActionLink,
+			ObjectType: model.ObjectTypeReport,
+			ObjectID:   &reportID,
+			Diff: model.JSONMap{
+				"incidentId": incident.ID.String(),
+			},
+		})
+	}
+
+	return s.getDetail(ctx, incident.ID)
+}
+
+// Merge folds the source incidents into the target incident
+func (s *IncidentService) Merge(ctx context.Context, id string, req dto.MergeIncidentsRequest, userID *uuid.UUID, actorIP string) (*vo.IncidentDetailVO, error) {
+	target, err := s.getOpenIncident(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceIDs := make([]uuid.UUID, 0, len(req.SourceIDs))
+	for _, raw := range req.SourceIDs {
+		sourceID, err := uuid.Parse(raw)
+		if err != nil || sourceID == target.ID {
+			return nil, ErrInvalidIncidentMerge
+		}
+		source, err := s.incidentRepo.GetByID(ctx, sourceID)
+		if err != nil {
+			return nil, err
+		}
+		if source == nil {
+			return nil, ErrIncidentNotFound
+		}
+		if source.Status == model.IncidentStatusMerged {
+			return nil, ErrIncidentMerged
+		}
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+
+	if err := s.incidentRepo.Merge(ctx, target.ID, sourceIDs); err != nil {
+		return nil, err
+	}
+
+	// Audit both sides of the merge
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionMerge,
+		ObjectType: model.ObjectTypeIncident,
+		ObjectID:   &target.ID,
+		Diff: model.JSONMap{
+			"sourceIds": req.SourceIDs,
+			"reason":    req.Reason,
+		},
+	})
+	for _, sourceID := range sourceIDs {
+		sourceID := sourceID
+		s.auditRepo.Create(ctx, &model.AuditLog{
+			ActorID:    userID,
+			ActorIP:    actorIP,
+			Action:     model.ActionMerge,
+			ObjectType: model.ObjectTypeIncident,
+			ObjectID:   &sourceID,
+			Diff: model.JSONMap{
+				"mergedInto": target.ID.String(),
+				"reason":     req.Reason,
+			},
+		})
+	}
+
+	return s.getDetail(ctx, target.ID)
+}
+
+// Split moves a subset of an incident's reports into a new incident
+func (s *IncidentService) Split(ctx context.Context, id string, req dto.SplitIncidentRequest, userID *uuid.UUID, actorIP string) (*vo.IncidentDetailVO, error) {
+	source, err := s.getOpenIncident(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[uuid.UUID]bool, len(source.Reports))
+	for _, r := range source.Reports {
+		members[r.ID] = true
+	}
+
+	reportIDs := make([]uuid.UUID, 0, len(req.ReportIDs))
+	for _, raw := range req.ReportIDs {
+		reportID, err := uuid.Parse(raw)
+		if err != nil || !members[reportID] {
+			return nil, ErrReportNotInIncident
+		}
+		reportIDs = append(reportIDs, reportID)
+	}
+
+	severity := req.Severity
+	if severity == "" {
+		severity = source.Severity
+	}
+
+	incident := &model.Incident{
+		Title:     req.Title,
+		Category:  source.Category,
+		Severity:  severity,
+		Status:    model.IncidentStatusOpen,
+		AreaHint:  source.AreaHint,
+		CreatedBy: userID,
+	}
+
+	if err := s.incidentRepo.Split(ctx, source.ID, incident, reportIDs); err != nil {
+		return nil, err
+	}
+
+	// Audit both sides of the split
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionSplit,
+		ObjectType: model.ObjectTypeIncident,
+		ObjectID:   &source.ID,
+		Diff: model.JSONMap{
+			"newIncidentId": incident.ID.String(),
+			"reportIds":     req.ReportIDs,
+			"reason":        req.Reason,
+		},
+	})
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionCreate,
+		ObjectType: model.ObjectTypeIncident,
+		ObjectID:   &incident.ID,
+		Diff: model.JSONMap{
+			"splitFrom": source.ID.String(),
+			"title":     incident.Title,
+			"reportIds": req.ReportIDs,
+		},
+	})
+
+	return s.getDetail(ctx, incident.ID)
+}
+
+// getOpenIncident loads an incident that has not been merged away
+func (s *IncidentService) getOpenIncident(ctx context.Context, id string) (*model.Incident, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrIncidentNotFound
+	}
+
+	incident, err := s.incidentRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if incident == nil {
+		return nil, ErrIncidentNotFound
+	}
+	if incident.Status == model.IncidentStatusMerged {
+		return nil, ErrIncidentMerged
+	}
+
+	return incident, nil
+}
+
+// resolveReports parses report IDs and checks that every report exists
+func (s *IncidentService) resolveReports(ctx context.Context, raw []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, r := range raw {
+		uid, err := uuid.Parse(r)
+		if err != nil {
+			return nil, ErrReportNotFound
+		}
+		ids = append(ids, uid)
+	}
+
+	reports, err := s.reportRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) != len(ids) {
+		return nil, ErrReportNotFound
+	}
+
+	return ids, nil
+}
+
+// getDetail loads an incident and converts it to a detail VO
+func (s *IncidentService) getDetail(ctx context.Context, id uuid.UUID) (*vo.IncidentDetailVO, error) {
+	incident, err := s.incidentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if incident == nil {
+		return nil, ErrIncidentNotFound
+	}
+
+	detail := &vo.IncidentDetailVO{
+		IncidentVO: *toIncidentVO(incident, int64(len(incident.Reports))),
+		Reports:    make([]vo.ReportVO, len(incident.Reports)),
+		Alerts:     make([]vo.AlertVO, len(incident.Alerts)),
+	}
+	for i, r := range incident.Reports {
+		detail.Reports[i] = *toReportVO(&r)
+	}
+	for i, a := range incident.Alerts {
+		detail.Alerts[i] = *toAlertVO(&a)
+	}
+
+	return detail, nil
+}
+
+// toIncidentVO converts an incident model to VO
+func toIncidentVO(incident *model.Incident, reportCount int64) *vo.IncidentVO {
+	result := &vo.IncidentVO{
+		ID:          incident.ID.String(),
+		Title:       incident.Title,
+		Category:    incident.Category,
+		Severity:    incident.Severity,
+		Status:      incident.Status,
+		Summary:     incident.Summary,
+		AreaHint:    incident.AreaHint,
+		ReportCount: reportCount,
+		CreatedAt:   incident.CreatedAt,
+		UpdatedAt:   incident.UpdatedAt,
+		ResolvedAt:  incident.ResolvedAt,
+	}
+
+	if incident.MergedInto != nil {
+		result.MergedInto = incident.MergedInto.String()
+	}
+
+	if incident.Creator != nil {
+		result.CreatedBy = &vo.UserSummaryVO{
+			ID:          incident.Creator.ID.String(),
+			DisplayName: incident.Creator.DisplayName,
+			Role:        incident.Creator.Role,
+		}
+	}
+
+	return result
+}
+
+// isValidIncidentTransition checks if an incident status transition is valid
+func isValidIncidentTransition(from, to string) bool {
+	validTransitions := map[string][]string{
+		model.IncidentStatusOpen:       {model.IncidentStatusMonitoring, model.IncidentStatusResolved, model.IncidentStatusClosed},
+		model.IncidentStatusMonitoring: {model.IncidentStatusOpen, model.IncidentStatusResolved, model.IncidentStatusClosed},
+		model.IncidentStatusResolved:   {model.IncidentStatusOpen, model.IncidentStatusClosed},
+		model.IncidentStatusClosed:     {model.IncidentStatusOpen},
+	}
+
+	allowed, ok := validTransitions[from]
+	if !ok {
+		return false
+	}
+
+	for _, s := range allowed {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/indicator"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/stix"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrIndicatorNotFound = errors.New("indicator not found")
+	ErrInvalidLookupHash = errors.New("invalid lookup hash prefix")
+)
+
+// Indicator registry limits
+const (
+	// Reports shown on an indicator's detail
+	indicatorDetailReports = 50
+	// Reports loaded per page while rescanning
+	indicatorRescanPage = 100
+)
+
+// IndicatorService extracts scam indicators from scam_phishing reports and
+// keeps the indicator registry
+type IndicatorService struct {
+	indicatorRepo *repository.IndicatorRepository
+	reportRepo    *repository.ReportRepository
+	auditRepo     *repository.AuditRepository
+	stixIdentity  string
+	stixTLP       string
+}
+
+// NewIndicatorService creates a new indicator service. STIX exports are
+// created by the named identity and marked with the given TLP level.
+func NewIndicatorService(indicatorRepo *repository.IndicatorRepository, reportRepo *repository.ReportRepository, auditRepo *repository.AuditRepository, stixIdentity, stixTLP string) *IndicatorService {
+	return &IndicatorService{
+		indicatorRepo: indicatorRepo,
+		reportRepo:    reportRepo,
+		auditRepo:     auditRepo,
+		stixIdentity:  stixIdentity,
+		stixTLP:       stixTLP,
+	}
+}
+
+// STIXExport is a page of the STIX export
+type STIXExport struct {
+	Bundle *stix.Bundle
+	// Change times of the first and last exported indicator; the last one is
+	// the added_after of the next page. Nil when the page is empty.
+	First, Last *time.Time
+	// More is set when further indicators changed after Last
+	More bool
+}
+
+// Extract records the indicators in a scam_phishing report's description
+// and returns how many were found. Other categories are ignored.
+func (s *IndicatorService) Extract(ctx context.Context, report *model.Report) (int, error) {
+	if report.Category != model.CategoryScamPhishing {
+		return 0, nil
+	}
+
+	seenAt := report.CreatedAt
+	if seenAt.IsZero() {
+		seenAt = time.Now().UTC()
+	}
+	return s.Record(ctx, report.ID, seenAt, indicator.Extract(report.Description, indicatorRegion(report)))
+}
+
+// Record links indicators found outside the description, such as the
+// headers of a forwarded email, to a report and returns how many were kept.
+// Duplicates are stored once.
+func (s *IndicatorService) Record(ctx context.Context, reportID uuid.UUID, seenAt time.Time, found []indicator.Indicator) (int, error) {
+	indicators := make([]model.Indicator, 0, len(found))
+	seen := make(map[indicator.Indicator]bool, len(found))
+	for _, f := range found {
+		if seen[f] {
+			continue
+		}
+		seen[f] = true
+		indicators = append(indicators, model.Indicator{Type: f.Type, Value: f.Value, Hash: indicator.Hash(f.Type, f.Value)})
+	}
+
+	if err := s.indicatorRepo.Record(ctx, reportID, seenAt, indicators); err != nil {
+		return 0, err
+	}
+	return len(indicators), nil
+}
+
+// Rescan re-extracts indicators from every scam_phishing report, oldest
+// first. It picks up reports filed before the registry existed and values
+// the extractor has since learned to read; known links are kept.
+func (s *IndicatorService) Rescan(ctx context.Context, userID *uuid.UUID, actorIP string) (*vo.IndicatorRescanVO, error) {
+	result := &vo.IndicatorRescanVO{}
+	for page := 1; ; page++ {
+		reports, _, err := s.reportRepo.List(ctx, repository.ListReportParams{
+			Page:     page,
+			PageSize: indicatorRescanPage,
+			Category: model.CategoryScamPhishing,
+			SortDir:  "asc",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range reports {
+			n, err := s.Extract(ctx, &reports[i])
+			if err != nil {
+				return nil, err
+			}
+			result.Reports++
+			result.Indicators += n
+		}
+		if len(reports) < indicatorRescanPage {
+			break
+		}
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionUpdate,
+		ObjectType: model.ObjectTypeIndicator,
+		Diff: model.JSONMap{
+			"rescan":     true,
+			"reports":    result.Reports,
+			"indicators": result.Indicators,
+		},
+	})
+
+	return result, nil
+}
+
+// List retrieves indicators with pagination
+func (s *IndicatorService) List(ctx context.Context, query dto.ListIndicatorsQuery) (*vo.IndicatorListVO, error) {
+	params := repository.ListIndicatorParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Type:     query.Type,
+		Status:   query.Status,
+		Query:    strings.TrimSpace(query.Q),
+	}
+	if query.ReportID != "" {
+		reportID, err := uuid.Parse(query.ReportID)
+		if err != nil {
+			return nil, errors.New("invalid report ID")
+		}
+		params.ReportID = reportID
+	}
+
+	indicators, total, err := s.indicatorRepo.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	indicatorVOs := make([]vo.IndicatorVO, len(indicators))
+	for i, ind := range indicators {
+		indicatorVOs[i] = *toIndicatorVO(&ind)
+	}
+
+	return &vo.IndicatorListVO{
+		Data:       indicatorVOs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// GetByID retrieves an indicator with the newest reports it was found in
+func (s *IndicatorService) GetByID(ctx context.Context, id string) (*vo.IndicatorDetailVO, error) {
+	ind, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := s.indicatorRepo.ListReports(ctx, ind.ID, indicatorDetailReports)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &vo.IndicatorDetailVO{
+		IndicatorVO: *toIndicatorVO(ind),
+		Reports:     make([]vo.ReportVO, len(reports)),
+	}
+	for i := range reports {
+		result.Reports[i] = *toReportVO(&reports[i])
+	}
+	return result, nil
+}
+
+// Verify sets the verification status of an indicator
+func (s *IndicatorService) Verify(ctx context.Context, id string, req dto.VerifyIndicatorRequest, userID *uuid.UUID, actorIP string) (*vo.IndicatorDetailVO, error) {
+	ind, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := ind.Status
+	now := time.Now().UTC()
+	ind.Status = req.Status
+	ind.VerificationNote = req.Note
+	ind.VerifiedBy = userID
+	ind.VerifiedAt = &now
+	if req.Status == model.IndicatorStatusUnverified {
+		ind.VerifiedBy = nil
+		ind.VerifiedAt = nil
+	}
+	if err := s.indicatorRepo.SetStatus(ctx, ind); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionUpdate,
+		ObjectType: model.ObjectTypeIndicator,
+		ObjectID:   &ind.ID,
+		Diff: model.JSONMap{
+			"status": map[string]string{"from": from, "to": req.Status},
+			"note":   req.Note,
+		},
+	})
+
+	return s.GetByID(ctx, id)
+}
+
+// Lookup answers a public hash-prefix query. The client sends the first
+// indicator.LookupPrefixLength hex characters of indicator.Hash for the value
+// it wants to check and compares the full hashes it gets back itself, so the
+// value never leaves the client. Only verified indicators are returned.
+func (s *IndicatorService) Lookup(ctx context.Context, prefix string) (*vo.IndicatorLookupVO, error) {
+	prefix = strings.ToLower(prefix)
+	if len(prefix) != indicator.LookupPrefixLength || strings.Trim(prefix, "0123456789abcdef") != "" {
+		return nil, ErrInvalidLookupHash
+	}
+
+	indicators, err := s.indicatorRepo.LookupPrefix(ctx, prefix, []string{
+		model.IndicatorStatusConfirmed,
+		model.IndicatorStatusFalsePositive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &vo.IndicatorLookupVO{
+		Prefix:  prefix,
+		Matches: make([]vo.IndicatorMatchVO, len(indicators)),
+	}
+	for i, ind := range indicators {
+		result.Matches[i] = vo.IndicatorMatchVO{
+			Hash:        ind.Hash,
+			Type:        ind.Type,
+			Status:      ind.Status,
+			ReportCount: ind.ReportCount,
+			FirstSeenAt: ind.FirstSeenAt,
+			LastSeenAt:  ind.LastSeenAt,
+		}
+	}
+	return result, nil
+}
+
+// ExportSTIX builds a STIX 2.1 bundle of confirmed indicators, oldest change
+// first. With addedAfter set only indicators changed since are included, and
+// those marked false_positive since are included as revoked, so a consumer
+// pulling incrementally drops them; a full export leaves them out.
+func (s *IndicatorService) ExportSTIX(ctx context.Context, query dto.ExportIndicatorsQuery) (*STIXExport, error) {
+	statuses := []string{model.IndicatorStatusConfirmed}
+	if !query.AddedAfter.IsZero() {
+		statuses = append(statuses, model.IndicatorStatusFalsePositive)
+	}
+
+	// One extra row tells whether there is a next page
+	indicators, err := s.indicatorRepo.ListForExport(ctx, statuses, query.AddedAfter, query.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &STIXExport{}
+	if len(indicators) > query.Limit {
+		result.More = true
+		// Indicators recorded together share a change time; cut the page
+		// before the first of the group that did not fit, or the next page,
+		// starting after Last, would skip the rest of it
+		next := indicators[query.Limit].UpdatedAt
+		indicators = indicators[:query.Limit]
+		cut := len(indicators)
+		for cut > 0 && indicators[cut-1].UpdatedAt.Equal(next) {
+			cut--
+		}
+		if cut > 0 {
+			indicators = indicators[:cut]
+		}
+	}
+
+	builder, err := stix.NewBuilder(s.stixIdentity, s.stixTLP)
+	if err != nil {
+		return nil, err
+	}
+	for _, ind := range indicators {
+		builder.Add(stix.Indicator{
+			Type:        ind.Type,
+			Value:       ind.Value,
+			ReportCount: ind.ReportCount,
+			FirstSeenAt: ind.FirstSeenAt,
+			LastSeenAt:  ind.LastSeenAt,
+			Created:     ind.CreatedAt,
+			Modified:    ind.UpdatedAt,
+			Revoked:     ind.Status == model.IndicatorStatusFalsePositive,
+		})
+	}
+	result.Bundle = builder.Bundle()
+
+	if len(indicators) > 0 {
+		result.First = &indicators[0].UpdatedAt
+		result.Last = &indicators[len(indicators)-1].UpdatedAt
+	}
+	return result, nil
+}
+
+// get loads an indicator by its string ID
+func (s *IndicatorService) get(ctx context.Context, id string) (*model.Indicator, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrIndicatorNotFound
+	}
+	ind, err := s.indicatorRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if ind == nil {
+		return nil, ErrIndicatorNotFound
+	}
+	return ind, nil
+}
+
+// indicatorRegion derives the country used to read national phone numbers
+// from the report's pilot zone, e.g. "tw-tpe-daan" → "TW"
+func indicatorRegion(report *model.Report) string {
+	country, _, _ := strings.Cut(report.Location.ZoneID, "-")
+	switch country {
+	case "tw":
+		return "TW"
+	case "gb":
+		return "GB"
+	default:
+		return ""
+	}
+}
+
+// toIndicatorVO converts an indicator model to VO
+func toIndicatorVO(ind *model.Indicator) *vo.IndicatorVO {
+	result := &vo.IndicatorVO{
+		ID:               ind.ID.String(),
+		Type:             ind.Type,
+		Value:            ind.Value,
+		Status:           ind.Status,
+		ReportCount:      ind.ReportCount,
+		FirstSeenAt:      ind.FirstSeenAt,
+		LastSeenAt:       ind.LastSeenAt,
+		VerificationNote: ind.VerificationNote,
+		VerifiedAt:       ind.VerifiedAt,
+	}
+
+	if ind.Verifier != nil {
+		result.VerifiedBy = &vo.UserSummaryVO{
+			ID:          ind.Verifier.ID.String(),
+			DisplayName: ind.Verifier.DisplayName,
+			Role:        ind.Verifier.Role,
+		}
+	}
+
+	return result
+}
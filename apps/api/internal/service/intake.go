@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net"
+	"time"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/captcha"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/pow"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrChallengeDisabled = errors.New("proof-of-work gate is not enabled")
+)
+
+// IntakeSignals is what a public submission presents to the intake gates
+type IntakeSignals struct {
+	Channel      string // model.Channel*; empty is the web form
+	IP           string
+	UserAgent    string
+	DeviceID     string // client-generated, sent as X-Device-ID
+	DeviceHash   string // set by IntakeService before the gates run
+	NetworkHash  string // set by IntakeService before the gates run
+	Fallback     bool   // DeviceHash came from UserAgent and IP, not DeviceID
+	AreaKey      string // area the report targets, see brigadeAreaKey
+	Category     string
+	PowToken     string
+	PowSolution  string
+	CaptchaToken string
+}
+
+// GateResult is one gate's verdict on a submission
+type GateResult struct {
+	Risk   float64 // 0 is no evidence of abuse, 1 is certain abuse
+	Signal string  // short finding, e.g. "pow:ok" or "captcha:failed"
+}
+
+// IntakeGate scores one kind of abuse evidence for a public submission
+type IntakeGate interface {
+	Name() string
+	Check(ctx context.Context, signals *IntakeSignals) (GateResult, error)
+}
+
+// IntakeAssessment is the combined verdict of all gates
+type IntakeAssessment struct {
+	Risk           float64
+	Signals        []string
+	DeviceHash     string
+	DeviceFallback bool
+	NetworkHash    string
+	Quarantine     bool
+}
+
+// challenger is implemented by gates that hand out challenges before submission
+type challenger interface {
+	Challenge() (pow.Challenge, error)
+}
+
+// interactive is implemented by gates that need the submitter to solve
+// something in a browser; they are skipped for channels that cannot, such as
+// email
+type interactive interface {
+	Interactive() bool
+}
+
+// IntakeService runs public report submissions through the configured gates
+type IntakeService struct {
+	gates     []IntakeGate
+	secret    []byte
+	threshold float64
+}
+
+// NewIntakeService creates a new intake service. Submissions scoring at or
+// above threshold are quarantined.
+func NewIntakeService(secret string, threshold float64, gates ...IntakeGate) *IntakeService {
+	return &IntakeService{
+		gates:     gates,
+		secret:    []byte(secret),
+		threshold: threshold,
+	}
+}
+
+// Assess scores a submission. Gate risks are combined as independent
+// evidence: 1 - (1-r1)(1-r2)... A gate that fails to run counts as mild risk
+// rather than blocking intake.
+func (s *IntakeService) Assess(ctx context.Context, signals *IntakeSignals) *IntakeAssessment {
+	signals.DeviceHash = s.deviceHash(signals)
+	signals.NetworkHash = s.networkHash(signals)
+	signals.Fallback = signals.DeviceID == ""
+
+	assessment := &IntakeAssessment{
+		DeviceHash:     signals.DeviceHash,
+		DeviceFallback: signals.Fallback,
+		NetworkHash:    signals.NetworkHash,
+	}
+	clean := 1.0
+	for _, gate := range s.gates {
+		if g, ok := gate.(interactive); ok && g.Interactive() && !webChannel(signals.Channel) {
+			continue
+		}
+		result, err := gate.Check(ctx, signals)
+		if err != nil {
+			log.Printf("intake gate %s failed: %v", gate.Name(), err)
+			result = GateResult{Risk: 0.3, Signal: gate.Name() + ":error"}
+		}
+		clean *= 1 - result.Risk
+		assessment.Signals = append(assessment.Signals, result.Signal)
+	}
+
+	assessment.Risk = 1 - clean
+	assessment.Quarantine = len(s.gates) > 0 && assessment.Risk >= s.threshold
+	return assessment
+}
+
+// Challenge issues a proof-of-work challenge for the next submission
+func (s *IntakeService) Challenge() (*vo.IntakeChallengeVO, error) {
+	for _, gate := range s.gates {
+		if c, ok := gate.(challenger); ok {
+			challenge, err := c.Challenge()
+			if err != nil {
+				return nil, err
+			}
+			return &vo.IntakeChallengeVO{
+				Token:      challenge.Token,
+				Difficulty: challenge.Difficulty,
+				Algorithm:  "sha256",
+				ExpiresAt:  challenge.ExpiresAt,
+			}, nil
+		}
+	}
+	return nil, ErrChallengeDisabled
+}
+
+// webChannel reports whether submissions on a channel come through the web
+// form or API
+func webChannel(channel string) bool {
+	return channel == "" || channel == model.ChannelWeb
+}
+
+// deviceHash derives a pseudonymous device key. Without a device ID it falls
+// back to the user agent and network prefix, which is coarser but still
+// groups bursts from one source; it is too coarse for reputation, which
+// would be shared by everyone on the same browser and network.
+func (s *IntakeService) deviceHash(signals *IntakeSignals) string {
+	key := "id:" + signals.DeviceID
+	if signals.DeviceID == "" {
+		key = "ua:" + signals.UserAgent + "|" + networkPrefix(signals.IP)
+	}
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(key))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// networkHash derives a pseudonymous key for the client's network prefix,
+// used to spot many reports arriving from one /24 (or /48)
+func (s *IntakeService) networkHash(signals *IntakeSignals) string {
+	prefix := networkPrefix(signals.IP)
+	if prefix == "" {
+		return ""
+	}
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte("net:" + prefix))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// networkPrefix truncates an IP to its /24 (IPv4) or /48 (IPv6) network
+func networkPrefix(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return parsed.Mask(net.CIDRMask(48, 128)).String()
+}
+
+// PowGate requires a solved proof-of-work challenge
+type PowGate struct {
+	secret     []byte
+	difficulty int
+	ttl        time.Duration
+	nonces     repository.NonceStore
+}
+
+// NewPowGate creates a proof-of-work gate
+func NewPowGate(secret string, difficulty int, ttl time.Duration, nonces repository.NonceStore) *PowGate {
+	return &PowGate{
+		secret:     []byte(secret),
+		difficulty: difficulty,
+		ttl:        ttl,
+		nonces:     nonces,
+	}
+}
+
+// Name returns the gate name
+func (g *PowGate) Name() string {
+	return "pow"
+}
+
+// Interactive marks the gate as needing a browser
+func (g *PowGate) Interactive() bool {
+	return true
+}
+
+// Challenge issues a new challenge
+func (g *PowGate) Challenge() (pow.Challenge, error) {
+	return pow.Issue(g.secret, g.difficulty, g.ttl, time.Now().UTC())
+}
+
+// Check verifies the solution and spends the challenge
+func (g *PowGate) Check(ctx context.Context, signals *IntakeSignals) (GateResult, error) {
+	if signals.PowToken == "" || signals.PowSolution == "" {
+		return GateResult{Risk: 0.5, Signal: "pow:missing"}, nil
+	}
+
+	nonce, err := pow.Verify(g.secret, signals.PowToken, signals.PowSolution, time.Now().UTC())
+	switch {
+	case errors.Is(err, pow.ErrExpired):
+		return GateResult{Risk: 0.6, Signal: "pow:expired"}, nil
+	case err != nil:
+		return GateResult{Risk: 1, Signal: "pow:invalid"}, nil
+	}
+
+	fresh, err := g.nonces.Use(ctx, nonce, time.Now().UTC().Add(g.ttl))
+	if err != nil {
+		return GateResult{}, err
+	}
+	if !fresh {
+		return GateResult{Risk: 1, Signal: "pow:replayed"}, nil
+	}
+	return GateResult{Risk: 0, Signal: "pow:ok"}, nil
+}
+
+// CaptchaGate requires a CAPTCHA token accepted by the verifier
+type CaptchaGate struct {
+	verifier captcha.Verifier
+}
+
+// NewCaptchaGate creates a CAPTCHA gate
+func NewCaptchaGate(verifier captcha.Verifier) *CaptchaGate {
+	return &CaptchaGate{verifier: verifier}
+}
+
+// Name returns the gate name
+func (g *CaptchaGate) Name() string {
+	return "captcha"
+}
+
+// Interactive marks the gate as needing a browser
+func (g *CaptchaGate) Interactive() bool {
+	return true
+}
+
+// Check verifies the CAPTCHA token. If the verifier is unreachable the
+// submission is scored as mildly risky instead of rejected.
+func (g *CaptchaGate) Check(ctx context.Context, signals *IntakeSignals) (GateResult, error) {
+	if signals.CaptchaToken == "" {
+		return GateResult{Risk: 0.5, Signal: "captcha:missing"}, nil
+	}
+
+	ok, err := g.verifier.Verify(ctx, signals.CaptchaToken, signals.IP)
+	if err != nil {
+		log.Printf("captcha verify failed: %v", err)
+		return GateResult{Risk: 0.3, Signal: "captcha:unavailable"}, nil
+	}
+	if !ok {
+		return GateResult{Risk: 1, Signal: "captcha:failed"}, nil
+	}
+	return GateResult{Risk: 0, Signal: "captcha:ok"}, nil
+}
+
+// DeviceGate scores how many reports a device has sent recently
+type DeviceGate struct {
+	reportRepo *repository.ReportRepository
+	limit      int
+	window     time.Duration
+}
+
+// NewDeviceGate creates a device gate that flags more than limit reports per window
+func NewDeviceGate(reportRepo *repository.ReportRepository, limit int, window time.Duration) *DeviceGate {
+	if limit < 1 {
+		limit = 1
+	}
+	return &DeviceGate{
+		reportRepo: reportRepo,
+		limit:      limit,
+		window:     window,
+	}
+}
+
+// Name returns the gate name
+func (g *DeviceGate) Name() string {
+	return "device"
+}
+
+// Check counts recent reports from the same device. Risk rises with the count
+// and jumps once the limit is reached.
+func (g *DeviceGate) Check(ctx context.Context, signals *IntakeSignals) (GateResult, error) {
+	count, err := g.reportRepo.CountByDeviceSince(ctx, signals.DeviceHash, time.Now().UTC().Add(-g.window))
+	if err != nil {
+		return GateResult{}, err
+	}
+
+	if count >= int64(g.limit) {
+		return GateResult{Risk: 0.9, Signal: "device:burst"}, nil
+	}
+
+	result := GateResult{Risk: 0.5 * float64(count) / float64(g.limit), Signal: "device:ok"}
+	if signals.DeviceID == "" {
+		// No device ID: the fallback key is shared by everyone behind the same network
+		result.Risk += 0.1
+		result.Signal = "device:anonymous"
+	}
+	return result, nil
+}
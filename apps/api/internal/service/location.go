@@ -0,0 +1,81 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/geo"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrUnknownZone = errors.New("unknown zone")
+)
+
+// LocationService coarsens coordinates and resolves pilot zones
+type LocationService struct {
+	gazetteer *geo.Gazetteer
+	precision int
+}
+
+// NewLocationService creates a new location service
+func NewLocationService(gazetteer *geo.Gazetteer, precision int) *LocationService {
+	return &LocationService{
+		gazetteer: gazetteer,
+		precision: geo.ClampPrecision(precision),
+	}
+}
+
+// Resolve builds a location from an explicit zone, a point or free text, in
+// that order of preference. The point itself is only kept as a geohash cell.
+func (s *LocationService) Resolve(zoneID, text string, lat, lng *float64) (model.Location, error) {
+	var location model.Location
+
+	if zoneID != "" {
+		if s.gazetteer.Zone(zoneID) == nil {
+			return location, ErrUnknownZone
+		}
+		location.ZoneID = zoneID
+	}
+
+	if lat != nil && lng != nil {
+		location.Geohash = geo.Encode(*lat, *lng, s.precision)
+		if location.ZoneID == "" {
+			if zone := s.gazetteer.Locate(*lat, *lng); zone != nil {
+				location.ZoneID = zone.ID
+			}
+		}
+	}
+
+	if location.ZoneID == "" {
+		if zone := s.gazetteer.Resolve(text); zone != nil {
+			location.ZoneID = zone.ID
+		}
+	}
+
+	return location, nil
+}
+
+// ListZones returns all pilot zones
+func (s *LocationService) ListZones() []vo.ZoneVO {
+	zones := s.gazetteer.Zones()
+	result := make([]vo.ZoneVO, len(zones))
+	for i, z := range zones {
+		result[i] = vo.ZoneVO{
+			ID:        z.ID,
+			Name:      z.Name,
+			LocalName: z.LocalName,
+			CityID:    z.City.ID,
+			City:      z.City.Name,
+			Country:   z.City.Country,
+			Latitude:  z.Center[0],
+			Longitude: z.Center[1],
+		}
+	}
+	return result
+}
+
+// ValidZone reports whether a zone ID exists in the gazetteer
+func (s *LocationService) ValidZone(zoneID string) bool {
+	return s.gazetteer.Zone(zoneID) != nil
+}
@@ -4,17 +4,25 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/agreement"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
 )
 
 // MetricsService handles KPI and metrics business logic
 type MetricsService struct {
-	reportRepo   *repository.ReportRepository
-	triageRepo   *repository.TriageRepository
-	alertRepo    *repository.AlertRepository
-	trainingRepo *repository.TrainingRepository
-	userRepo     *repository.UserRepository
+	reportRepo    *repository.ReportRepository
+	triageRepo    *repository.TriageRepository
+	alertRepo     *repository.AlertRepository
+	trainingRepo  *repository.TrainingRepository
+	userRepo      *repository.UserRepository
+	incidentRepo  *repository.IncidentRepository
+	appealRepo    *repository.AppealRepository
+	consensusRepo *repository.ConsensusRepository
 }
 
 // NewMetricsService creates a new metrics service
@@ -24,13 +32,19 @@ func NewMetricsService(
 	alertRepo *repository.AlertRepository,
 	trainingRepo *repository.TrainingRepository,
 	userRepo *repository.UserRepository,
+	incidentRepo *repository.IncidentRepository,
+	appealRepo *repository.AppealRepository,
+	consensusRepo *repository.ConsensusRepository,
 ) *MetricsService {
 	return &MetricsService{
-		reportRepo:   reportRepo,
-		triageRepo:   triageRepo,
-		alertRepo:    alertRepo,
-		trainingRepo: trainingRepo,
-		userRepo:     userRepo,
+		reportRepo:    reportRepo,
+		triageRepo:    triageRepo,
+		alertRepo:     alertRepo,
+		trainingRepo:  trainingRepo,
+		userRepo:      userRepo,
+		incidentRepo:  incidentRepo,
+		appealRepo:    appealRepo,
+		consensusRepo: consensusRepo,
 	}
 }
 
@@ -58,12 +72,38 @@ func (s *MetricsService) GetKPIMetrics(ctx context.Context) (*vo.KPIMetricsVO, e
 		abuseRate = 0
 	}
 
+	triagedReports, triagedIncidents, err := s.triageRepo.CountTriaged(ctx)
+	if err != nil {
+		triagedReports, triagedIncidents = 0, 0
+	}
+
+	openIncidents, err := s.incidentRepo.CountByStatus(ctx, model.IncidentStatusOpen)
+	if err != nil {
+		openIncidents = 0
+	}
+
+	appealStats, err := s.appealRepo.GetStats(ctx)
+	if err != nil {
+		appealStats = &repository.AppealStats{}
+	}
+	var appealOverturnRate float64
+	if appealStats.Decided > 0 {
+		appealOverturnRate = float64(appealStats.Overturned) / float64(appealStats.Decided) * 100
+	}
+
 	// Get alert metrics
 	publishLatency, err := s.alertRepo.GetPublishLatency(ctx)
 	if err != nil {
 		publishLatency = 0
 	}
 
+	// Get agreement of independent reviewers
+	var consensusKappa *float64
+	pairs, err := s.consensusRepo.ListPairs(ctx, time.Now().UTC().AddDate(0, 0, -90))
+	if err == nil {
+		consensusKappa, _, _ = reviewAgreement(pairs)
+	}
+
 	// Get certified triagers count
 	certifiedTriagers, err := s.userRepo.CountByRole(ctx, "triager")
 	if err != nil {
@@ -88,6 +128,11 @@ func (s *MetricsService) GetKPIMetrics(ctx context.Context) (*vo.KPIMetricsVO, e
 			AbuseRateTarget:      5.0,
 			AlertPublishLatency:  publishLatency.Minutes(),
 			PublishLatencyTarget: 15.0,
+			TriagedReports:       int(triagedReports),
+			TriagedIncidents:     int(triagedIncidents),
+			OpenIncidents:        int(openIncidents),
+			PendingAppeals:       int(appealStats.Pending),
+			AppealOverturnRate:   appealOverturnRate,
 		},
 		Adoption: vo.AdoptionKPIVO{
 			PartnerOrgs:            0, // Manually tracked
@@ -98,10 +143,121 @@ func (s *MetricsService) GetKPIMetrics(ctx context.Context) (*vo.KPIMetricsVO, e
 		Governance: vo.GovernanceKPIVO{
 			CertifiedTriagers: int(certifiedTriagers),
 			TriagersTarget:    15,
+			ConsensusKappa:    consensusKappa,
 		},
 	}, nil
 }
 
+// GetTriageAgreement measures how often the independent reviewers of
+// high-severity reports agree, overall, per pair of triagers and per month
+func (s *MetricsService) GetTriageAgreement(ctx context.Context, query dto.TriageAgreementQuery) (*vo.TriageAgreementVO, error) {
+	since := time.Now().UTC().AddDate(0, 0, -query.Days)
+	pairs, err := s.consensusRepo.ListPairs(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &vo.TriageAgreementVO{
+		Since:  since,
+		Rounds: len(pairs),
+		Pairs:  []vo.TriagerPairAgreementVO{},
+		Trend:  []vo.AgreementTrendVO{},
+	}
+	result.DecisionKappa, result.SeverityKappa, result.DisagreementRate = reviewAgreement(pairs)
+
+	// Group by pair of triagers, in order of first shared round
+	type pairKey struct{ a, b uuid.UUID }
+	var keys []pairKey
+	byPair := make(map[pairKey][]repository.ReviewPair)
+	for _, p := range pairs {
+		k := pairKey{p.ReviewerA, p.ReviewerB}
+		if _, ok := byPair[k]; !ok {
+			keys = append(keys, k)
+		}
+		byPair[k] = append(byPair[k], p)
+	}
+	users := make(map[uuid.UUID]*vo.UserSummaryVO)
+	summary := func(id uuid.UUID) (*vo.UserSummaryVO, error) {
+		if u, ok := users[id]; ok {
+			return u, nil
+		}
+		u, err := s.userRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		users[id] = toUserSummaryVO(u)
+		return users[id], nil
+	}
+	for _, k := range keys {
+		group := byPair[k]
+		decisionsA, decisionsB, severitiesA, severitiesB := reviewLabels(group)
+		decision := agreement.Cohen(decisionsA, decisionsB)
+		severity := agreement.Cohen(severitiesA, severitiesB)
+
+		triagerA, err := summary(k.a)
+		if err != nil {
+			return nil, err
+		}
+		triagerB, err := summary(k.b)
+		if err != nil {
+			return nil, err
+		}
+		result.Pairs = append(result.Pairs, vo.TriagerPairAgreementVO{
+			TriagerA:          triagerA,
+			TriagerB:          triagerB,
+			Rounds:            len(group),
+			DecisionAgreement: decision.Observed * 100,
+			SeverityAgreement: severity.Observed * 100,
+			DecisionKappa:     decision.Kappa,
+			SeverityKappa:     severity.Kappa,
+		})
+	}
+
+	// Pairs are ordered by round creation, so months come out in order
+	for start := 0; start < len(pairs); {
+		month := pairs[start].CreatedAt.UTC().Format("2006-01")
+		end := start
+		for end < len(pairs) && pairs[end].CreatedAt.UTC().Format("2006-01") == month {
+			end++
+		}
+		trend := vo.AgreementTrendVO{Month: month, Rounds: end - start}
+		trend.DecisionKappa, trend.SeverityKappa, trend.DisagreementRate = reviewAgreement(pairs[start:end])
+		result.Trend = append(result.Trend, trend)
+		start = end
+	}
+
+	return result, nil
+}
+
+// reviewAgreement returns Cohen's kappa on the decisions and severities of
+// review pairs, and the share of pairs that disagreed (percentage)
+func reviewAgreement(pairs []repository.ReviewPair) (decisionKappa, severityKappa *float64, disagreementRate float64) {
+	if len(pairs) == 0 {
+		return nil, nil, 0
+	}
+	decisionsA, decisionsB, severitiesA, severitiesB := reviewLabels(pairs)
+	disagreed := 0
+	for i := range pairs {
+		if decisionsA[i] != decisionsB[i] || severitiesA[i] != severitiesB[i] {
+			disagreed++
+		}
+	}
+	return agreement.Cohen(decisionsA, decisionsB).Kappa,
+		agreement.Cohen(severitiesA, severitiesB).Kappa,
+		float64(disagreed) / float64(len(pairs)) * 100
+}
+
+// reviewLabels splits review pairs into the labels each side gave
+func reviewLabels(pairs []repository.ReviewPair) (decisionsA, decisionsB, severitiesA, severitiesB []string) {
+	for _, p := range pairs {
+		decisionsA = append(decisionsA, p.DecisionA)
+		decisionsB = append(decisionsB, p.DecisionB)
+		severitiesA = append(severitiesA, p.SeverityA)
+		severitiesB = append(severitiesB, p.SeverityB)
+	}
+	return
+}
+
 // GetDashboardStats retrieves public dashboard statistics
 func (s *MetricsService) GetDashboardStats(ctx context.Context) (*vo.DashboardStatsVO, error) {
 	// Get report stats
@@ -112,11 +268,9 @@ func (s *MetricsService) GetDashboardStats(ctx context.Context) (*vo.DashboardSt
 
 	// Get reports this week
 	weekAgo := time.Now().AddDate(0, 0, -7)
-	reportsThisWeek := int64(0)
-	for status, count := range reportStats.ByStatus {
-		if status != "spam" {
-			reportsThisWeek += count
-		}
+	reportsThisWeek, err := s.reportRepo.CountCreatedSince(ctx, weekAgo)
+	if err != nil {
+		reportsThisWeek = 0
 	}
 
 	// Get active alerts
@@ -138,6 +292,7 @@ func (s *MetricsService) GetDashboardStats(ctx context.Context) (*vo.DashboardSt
 			Event:     a.Event,
 			Severity:  a.Severity,
 			Area:      a.Area,
+			ZoneID:    a.Location.ZoneID,
 			CreatedAt: a.CreatedAt.Format(time.RFC3339),
 		}
 	}
@@ -151,11 +306,21 @@ func (s *MetricsService) GetDashboardStats(ctx context.Context) (*vo.DashboardSt
 		})
 	}
 
+	// Get pilot zone breakdown
+	zoneBreakdown := make([]vo.ZoneCountVO, 0, len(reportStats.ByZone))
+	for zoneID, count := range reportStats.ByZone {
+		zoneBreakdown = append(zoneBreakdown, vo.ZoneCountVO{
+			ZoneID: zoneID,
+			Count:  int(count),
+		})
+	}
+
 	return &vo.DashboardStatsVO{
 		TotalReports:      int(reportStats.Total),
 		ReportsThisWeek:   int(reportsThisWeek),
 		ActiveAlerts:      int(activeAlerts),
 		RecentAlerts:      alertSummaries,
 		CategoryBreakdown: categoryBreakdown,
+		ZoneBreakdown:     zoneBreakdown,
 	}, nil
 }
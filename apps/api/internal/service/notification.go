@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+// NotificationService delivers and lists in-app notifications
+type NotificationService struct {
+	notificationRepo *repository.NotificationRepository
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(notificationRepo *repository.NotificationRepository) *NotificationService {
+	return &NotificationService{notificationRepo: notificationRepo}
+}
+
+// Notify sends the same notification to each user
+func (s *NotificationService) Notify(ctx context.Context, userIDs []uuid.UUID, template model.Notification) error {
+	notifications := make([]model.Notification, len(userIDs))
+	for i, uid := range userIDs {
+		notifications[i] = template
+		notifications[i].UserID = uid
+	}
+	return s.notificationRepo.CreateBatch(ctx, notifications)
+}
+
+// List retrieves the caller's notifications
+func (s *NotificationService) List(ctx context.Context, userID uuid.UUID, query dto.ListNotificationsQuery) (*vo.NotificationListVO, error) {
+	notifications, total, err := s.notificationRepo.ListByUser(ctx, userID, query.UnreadOnly, query.Page, query.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationVOs := make([]vo.NotificationVO, len(notifications))
+	for i, n := range notifications {
+		notificationVOs[i] = vo.NotificationVO{
+			ID:         n.ID.String(),
+			Kind:       n.Kind,
+			Title:      n.Title,
+			Body:       n.Body,
+			ObjectType: n.ObjectType,
+			ReadAt:     n.ReadAt,
+			CreatedAt:  n.CreatedAt,
+		}
+		if n.ObjectID != nil {
+			notificationVOs[i].ObjectID = n.ObjectID.String()
+		}
+	}
+
+	return &vo.NotificationListVO{
+		Data: notificationVOs,
+		Pagination: vo.PaginationVO{
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			Total:      total,
+			TotalPages: int((total + int64(query.PageSize) - 1) / int64(query.PageSize)),
+		},
+	}, nil
+}
+
+// MarkRead marks one of the caller's notifications as read
+func (s *NotificationService) MarkRead(ctx context.Context, id string, userID uuid.UUID) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return ErrNotificationNotFound
+	}
+
+	found, err := s.notificationRepo.MarkRead(ctx, uid, userID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrReportClaimed   = errors.New("report claimed by another user")
+	ErrNotClaimHolder  = errors.New("report not claimed by this user")
+	ErrInvalidAssignee = errors.New("assignee must be an admin or triager")
+)
+
+// QueueService handles the triage queue and report claims
+type QueueService struct {
+	reportRepo *repository.ReportRepository
+	userRepo   *repository.UserRepository
+	auditRepo  *repository.AuditRepository
+	leases     repository.LeaseStore
+	leaseTTL   time.Duration
+
+	reputationSvc *ReputationService
+}
+
+// NewQueueService creates a new triage queue service
+func NewQueueService(
+	reportRepo *repository.ReportRepository,
+	userRepo *repository.UserRepository,
+	auditRepo *repository.AuditRepository,
+	leases repository.LeaseStore,
+	leaseTTL time.Duration,
+	reputationSvc *ReputationService,
+) *QueueService {
+	return &QueueService{
+		reportRepo: reportRepo,
+		userRepo:   userRepo,
+		auditRepo:  auditRepo,
+		leases:     leases,
+		leaseTTL:   leaseTTL,
+
+		reputationSvc: reputationSvc,
+	}
+}
+
+// List retrieves unclaimed reports ordered by suggested severity and age
+func (s *QueueService) List(ctx context.Context, query dto.ListQueueQuery) (*vo.ReportListVO, error) {
+	leases, err := s.leases.Active(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]uuid.UUID, len(leases))
+	for i, l := range leases {
+		claimed[i] = l.ReportID
+	}
+
+	reports, total, err := s.reportRepo.ListQueue(ctx, repository.ListQueueParams{
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		Category:   query.Category,
+		ZoneID:     query.ZoneID,
+		ExcludeIDs: claimed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deviceHashes := make([]string, len(reports))
+	for i, r := range reports {
+		deviceHashes[i] = reputationHash(&r)
+	}
+	trust, err := s.reputationSvc.TrustMany(ctx, deviceHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	reportVOs := make([]vo.ReportVO, len(reports))
+	for i, r := range reports {
+		reportVOs[i] = *toReportVO(&r)
+		reportVOs[i].ReporterTrust = trust[reputationHash(&r)]
+	}
+
+	return &vo.ReportListVO{
+		Data:       reportVOs,
+		Pagination: newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// ListClaims retrieves all active claims
+func (s *QueueService) ListClaims(ctx context.Context) ([]vo.LeaseVO, error) {
+	leases, err := s.leases.Active(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vo.LeaseVO, len(leases))
+	for i, l := range leases {
+		result[i] = *toLeaseVO(&l, nil)
+	}
+	return result, nil
+}
+
+// Claim takes a lease on a report and moves it to under_review.
+// Claiming a report you already hold renews the lease.
+func (s *QueueService) Claim(ctx context.Context, reportID string, userID *uuid.UUID, actorIP string) (*vo.LeaseVO, error) {
+	return s.claim(ctx, reportID, userID, actorIP, "")
+}
+
+// claim takes or renews the caller's lease on a report, recording why when a
+// reason is given
+func (s *QueueService) claim(ctx context.Context, reportID string, userID *uuid.UUID, actorIP, reason string) (*vo.LeaseVO, error) {
+	if userID == nil {
+		return nil, ErrNotClaimHolder
+	}
+
+	report, err := s.getClaimable(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	lease := &model.ReportLease{
+		ReportID:   report.ID,
+		HolderID:   *userID,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(s.leaseTTL),
+	}
+	if err := s.leases.Acquire(ctx, lease); err != nil {
+		if errors.Is(err, repository.ErrLeaseHeld) {
+			return nil, ErrReportClaimed
+		}
+		return nil, err
+	}
+
+	if err := s.startReview(ctx, report); err != nil {
+		s.leases.Release(ctx, report.ID, *userID)
+		return nil, err
+	}
+
+	diff := model.JSONMap{
+		"expiresAt": lease.ExpiresAt,
+	}
+	if reason != "" {
+		diff["reason"] = reason
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionClaim,
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &report.ID,
+		Diff:       diff,
+	})
+
+	return toLeaseVO(lease, report), nil
+}
+
+// Release gives up the caller's claim on a report
+func (s *QueueService) Release(ctx context.Context, reportID string, userID *uuid.UUID, actorIP string) error {
+	uid, err := uuid.Parse(reportID)
+	if err != nil {
+		return ErrReportNotFound
+	}
+
+	lease, err := s.leases.Get(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if lease == nil || userID == nil || lease.HolderID != *userID {
+		return ErrNotClaimHolder
+	}
+
+	if err := s.leases.Release(ctx, uid, *userID); err != nil {
+		return err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionRelease,
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &uid,
+	})
+
+	return nil
+}
+
+// Assign gives a report to a triager, replacing any existing claim
+func (s *QueueService) Assign(ctx context.Context, reportID string, req dto.AssignReportRequest, adminID *uuid.UUID, actorIP string) (*vo.LeaseVO, error) {
+	report, err := s.getClaimable(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return nil, ErrInvalidAssignee
+	}
+	assignee, err := s.userRepo.GetByID(ctx, assigneeID)
+	if err != nil {
+		return nil, err
+	}
+	if assignee == nil || !assignee.IsActive || (assignee.Role != model.RoleAdmin && assignee.Role != model.RoleTriager) {
+		return nil, ErrInvalidAssignee
+	}
+
+	previous, err := s.leases.Get(ctx, report.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	lease := &model.ReportLease{
+		ReportID:   report.ID,
+		HolderID:   assigneeID,
+		AssignedBy: adminID,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(s.leaseTTL),
+	}
+	if err := s.leases.Assign(ctx, lease); err != nil {
+		return nil, err
+	}
+
+	if err := s.startReview(ctx, report); err != nil {
+		return nil, err
+	}
+
+	diff := model.JSONMap{
+		"assignee":  assigneeID.String(),
+		"expiresAt": lease.ExpiresAt,
+		"reason":    req.Reason,
+	}
+	if previous != nil {
+		diff["previousHolder"] = previous.HolderID.String()
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    adminID,
+		ActorIP:    actorIP,
+		Action:     model.ActionAssign,
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &report.ID,
+		Diff:       diff,
+	})
+
+	return toLeaseVO(lease, report), nil
+}
+
+// getClaimable loads a report that is waiting in the triage queue. Closed and
+// spam reports come back through Reopen, which records why.
+func (s *QueueService) getClaimable(ctx context.Context, reportID string) (*model.Report, error) {
+	uid, err := uuid.Parse(reportID)
+	if err != nil {
+		return nil, ErrReportNotFound
+	}
+
+	report, err := s.reportRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, ErrReportNotFound
+	}
+	if report.Status != model.StatusSubmitted && report.Status != model.StatusUnderReview {
+		return nil, ErrInvalidTransition
+	}
+
+	return report, nil
+}
+
+// startReview moves a claimed report to under_review
+func (s *QueueService) startReview(ctx context.Context, report *model.Report) error {
+	if report.Status == model.StatusUnderReview {
+		return nil
+	}
+	ok, err := s.reportRepo.TransitionStatus(ctx, report.ID, report.Status, model.StatusUnderReview)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidTransition
+	}
+	report.Status = model.StatusUnderReview
+	return nil
+}
+
+// toLeaseVO converts a lease to VO
+func toLeaseVO(lease *model.ReportLease, report *model.Report) *vo.LeaseVO {
+	result := &vo.LeaseVO{
+		ReportID:   lease.ReportID.String(),
+		HolderID:   lease.HolderID.String(),
+		AcquiredAt: lease.AcquiredAt,
+		ExpiresAt:  lease.ExpiresAt,
+	}
+
+	if lease.AssignedBy != nil {
+		result.AssignedBy = lease.AssignedBy.String()
+	}
+
+	if report != nil {
+		result.Report = toReportVO(report)
+	}
+
+	return result
+}
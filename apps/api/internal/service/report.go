@@ -3,51 +3,107 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/pkg/search"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
 	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
 )
 
 var (
-	ErrReportNotFound = errors.New("report not found")
+	ErrReportNotFound   = errors.New("report not found")
+	ErrInvalidDateRange = errors.New("from date is after to date")
 )
 
 // ReportService handles report business logic
 type ReportService struct {
-	reportRepo *repository.ReportRepository
-	auditRepo  *repository.AuditRepository
+	reportRepo    *repository.ReportRepository
+	consensusRepo *repository.ConsensusRepository
+	auditRepo     *repository.AuditRepository
+	duplicateSvc  *DuplicateService
+	locationSvc   *LocationService
+	intakeSvc     *IntakeService
+	queueSvc      *QueueService
+	leases        repository.LeaseStore
+
+	reputationSvc *ReputationService
+	brigadeSvc    *BrigadeService
+	indicatorSvc  *IndicatorService
 }
 
 // NewReportService creates a new report service
-func NewReportService(reportRepo *repository.ReportRepository, auditRepo *repository.AuditRepository) *ReportService {
+func NewReportService(reportRepo *repository.ReportRepository, consensusRepo *repository.ConsensusRepository, auditRepo *repository.AuditRepository, duplicateSvc *DuplicateService, locationSvc *LocationService, intakeSvc *IntakeService, queueSvc *QueueService, leases repository.LeaseStore, reputationSvc *ReputationService, brigadeSvc *BrigadeService, indicatorSvc *IndicatorService) *ReportService {
 	return &ReportService{
-		reportRepo: reportRepo,
-		auditRepo:  auditRepo,
+		reportRepo:    reportRepo,
+		consensusRepo: consensusRepo,
+		auditRepo:     auditRepo,
+		duplicateSvc:  duplicateSvc,
+		locationSvc:   locationSvc,
+		intakeSvc:     intakeSvc,
+		queueSvc:      queueSvc,
+		leases:        leases,
+		reputationSvc: reputationSvc,
+		brigadeSvc:    brigadeSvc,
+		indicatorSvc:  indicatorSvc,
 	}
 }
 
-// Create creates a new report
-func (s *ReportService) Create(ctx context.Context, req dto.CreateReportRequest, actorIP string) (*vo.ReportVO, error) {
-	report := &model.Report{
-		Category:           req.Category,
-		SeveritySuggested:  req.SeveritySuggested,
-		AreaHint:           req.AreaHint,
-		TimeWindow:         req.TimeWindow,
-		Description:        req.Description,
-		EvidenceRefs:       req.Evidence,
-		ReporterContactRef: req.ReporterContact,
-		Status:             model.StatusSubmitted,
+// Create creates a new report. Public submissions carry intake signals and
+// are scored by the intake gates; high-risk ones are quarantined instead of
+// entering the triage queue. The submitter is not told either way.
+func (s *ReportService) Create(ctx context.Context, req dto.CreateReportRequest, signals *IntakeSignals, actorIP string) (*vo.ReportVO, error) {
+	report, err := buildReport(req, s.locationSvc, s.duplicateSvc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Public submitters get a follow-up token so they can appeal later
+	var followUpToken string
+	if signals != nil {
+		followUpToken, report.FollowUpHash, err = newFollowUpToken()
+		if err != nil {
+			return nil, err
+		}
+
+		if !webChannel(signals.Channel) {
+			report.Channel = signals.Channel
+		}
+		signals.AreaKey = brigadeAreaKey(report)
+		signals.Category = report.Category
+		assessment := s.intakeSvc.Assess(ctx, signals)
+		report.RiskScore = assessment.Risk
+		report.RiskSignals = assessment.Signals
+		report.DeviceHash = assessment.DeviceHash
+		report.DeviceFallback = assessment.DeviceFallback
+		report.NetworkHash = assessment.NetworkHash
+		if assessment.Quarantine {
+			report.Status = model.StatusQuarantined
+		}
 	}
 
 	if err := s.reportRepo.Create(ctx, report); err != nil {
 		return nil, err
 	}
 
+	// Duplicate and brigade detection and indicator extraction are best
+	// effort and must not block intake
+	if _, err := s.duplicateSvc.Detect(ctx, report); err != nil {
+		log.Printf("duplicate detection failed for report %s: %v", report.ID, err)
+	}
+	if _, err := s.brigadeSvc.Detect(ctx, report); err != nil {
+		log.Printf("brigade detection failed for report %s: %v", report.ID, err)
+	}
+	if _, err := s.indicatorSvc.Extract(ctx, report); err != nil {
+		log.Printf("indicator extraction failed for report %s: %v", report.ID, err)
+	}
+
 	// Create audit log
 	s.auditRepo.Create(ctx, &model.AuditLog{
 		ActorIP:    actorIP,
@@ -55,12 +111,47 @@ func (s *ReportService) Create(ctx context.Context, req dto.CreateReportRequest,
 		ObjectType: model.ObjectTypeReport,
 		ObjectID:   &report.ID,
 		Diff: model.JSONMap{
-			"category": report.Category,
-			"status":   report.Status,
+			"category":  report.Category,
+			"status":    report.Status,
+			"zoneId":    report.Location.ZoneID,
+			"riskScore": report.RiskScore,
+			"channel":   report.Channel,
 		},
 	})
 
-	return s.toReportVO(report), nil
+	result := toReportVO(report)
+	if signals != nil {
+		result.Status = model.StatusSubmitted
+		result.RiskScore = 0
+		result.RiskSignals = nil
+		result.BrigadeClusterID = ""
+		result.FollowUpToken = followUpToken
+	}
+	return result, nil
+}
+
+// buildReport builds a submitted web report from a request, resolving its
+// location and fingerprinting it for duplicate detection
+func buildReport(req dto.CreateReportRequest, locationSvc *LocationService, duplicateSvc *DuplicateService) (*model.Report, error) {
+	location, err := locationSvc.Resolve(req.ZoneID, req.AreaHint, req.Latitude, req.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.Report{
+		Category:           req.Category,
+		SeveritySuggested:  req.SeveritySuggested,
+		AreaHint:           req.AreaHint,
+		Location:           location,
+		TimeWindow:         req.TimeWindow,
+		Description:        req.Description,
+		EvidenceRefs:       req.Evidence,
+		ReporterContactRef: model.EncryptedString(req.ReporterContact),
+		Status:             model.StatusSubmitted,
+		Channel:            model.ChannelWeb,
+	}
+	duplicateSvc.Fingerprint(report)
+	return report, nil
 }
 
 // GetByID retrieves a report by ID
@@ -78,18 +169,72 @@ func (s *ReportService) GetByID(ctx context.Context, id string) (*vo.ReportDetai
 		return nil, ErrReportNotFound
 	}
 
-	return s.toReportDetailVO(report), nil
+	detail := toReportDetailVO(report)
+	detail.ReporterTrust, err = s.reputationSvc.Trust(ctx, reputationHash(report))
+	if err != nil {
+		return nil, err
+	}
+
+	duplicates, err := s.duplicateSvc.ListCandidates(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range duplicates {
+		if d.Status == model.DuplicateStatusSuggested {
+			detail.Duplicates = append(detail.Duplicates, d)
+		}
+	}
+
+	return detail, nil
+}
+
+// Challenge issues a proof-of-work challenge for a public submission
+func (s *ReportService) Challenge() (*vo.IntakeChallengeVO, error) {
+	return s.intakeSvc.Challenge()
 }
 
 // List retrieves reports with pagination
 func (s *ReportService) List(ctx context.Context, query dto.ListReportsQuery) (*vo.ReportListVO, error) {
+	var incidentID uuid.UUID
+	if query.IncidentID != "" {
+		var err error
+		incidentID, err = uuid.Parse(query.IncidentID)
+		if err != nil {
+			return nil, errors.New("invalid incident ID")
+		}
+	}
+
+	var importBatchID uuid.UUID
+	if query.ImportBatchID != "" {
+		var err error
+		importBatchID, err = uuid.Parse(query.ImportBatchID)
+		if err != nil {
+			return nil, errors.New("invalid import batch ID")
+		}
+	}
+
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	sortBy := toSnakeCase(query.SortBy)
+	keyset := sortBy == "" || sortBy == "created_at"
+	if after != nil && !keyset {
+		return nil, ErrCursorSort
+	}
+
 	params := repository.ListReportParams{
-		Page:     query.Page,
-		PageSize: query.PageSize,
-		Status:   query.Status,
-		Category: query.Category,
-		SortBy:   toSnakeCase(query.SortBy),
-		SortDir:  query.SortDir,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		Status:     query.Status,
+		Category:   query.Category,
+		IncidentID: incidentID,
+		ZoneID:     query.ZoneID,
+		SortBy:     sortBy,
+		SortDir:    query.SortDir,
+		After:      after,
+
+		ImportBatchID: importBatchID,
 	}
 
 	reports, total, err := s.reportRepo.List(ctx, params)
@@ -97,13 +242,95 @@ func (s *ReportService) List(ctx context.Context, query dto.ListReportsQuery) (*
 		return nil, err
 	}
 
+	hasMore := hasNextPage(after, len(reports), query.Page, query.PageSize, total)
+	if len(reports) > query.PageSize {
+		reports = reports[:query.PageSize]
+	}
+
 	reportVOs := make([]vo.ReportVO, len(reports))
+	var last repository.Cursor
 	for i, r := range reports {
-		reportVOs[i] = *s.toReportVO(&r)
+		reportVOs[i] = *toReportVO(&r)
+		last = repository.Cursor{Time: r.CreatedAt, ID: r.ID}
+	}
+
+	result := &vo.ReportListVO{Data: reportVOs}
+	if after == nil {
+		result.Pagination = newPagination(query.Page, query.PageSize, total)
+	}
+	// Other sort orders have no stable keyset to continue from
+	if keyset {
+		result.Cursor = newCursorPagination(query.PageSize, hasMore, last)
+	}
+	return result, nil
+}
+
+// Search finds reports by free text and filters, with facet counts over all matches
+func (s *ReportService) Search(ctx context.Context, query dto.SearchReportsQuery) (*vo.ReportSearchVO, error) {
+	params := repository.SearchReportParams{
+		Page:              query.Page,
+		PageSize:          query.PageSize,
+		Query:             search.Parse(query.Q),
+		Status:            query.Status,
+		Category:          query.Category,
+		SeveritySuggested: query.SeveritySuggested,
+		SeverityFinal:     query.SeverityFinal,
+		EvidenceLevel:     query.EvidenceLevel,
+		ZoneID:            query.ZoneID,
 	}
 
-	return &vo.ReportListVO{
+	if query.IncidentID != "" {
+		incidentID, err := uuid.Parse(query.IncidentID)
+		if err != nil {
+			return nil, errors.New("invalid incident ID")
+		}
+		params.IncidentID = incidentID
+	}
+
+	// Dates are whole UTC days; "to" includes the whole day
+	if query.From != "" {
+		from, err := time.Parse("2006-01-02", query.From)
+		if err != nil {
+			return nil, ErrInvalidDateRange
+		}
+		params.From = from
+	}
+	if query.To != "" {
+		to, err := time.Parse("2006-01-02", query.To)
+		if err != nil {
+			return nil, ErrInvalidDateRange
+		}
+		params.To = to.AddDate(0, 0, 1)
+	}
+	if !params.From.IsZero() && !params.To.IsZero() && !params.From.Before(params.To) {
+		return nil, ErrInvalidDateRange
+	}
+
+	reports, total, err := s.reportRepo.Search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := s.reportRepo.SearchFacets(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	reportVOs := make([]vo.ReportVO, len(reports))
+	for i, r := range reports {
+		reportVOs[i] = *toReportVO(&r)
+	}
+
+	return &vo.ReportSearchVO{
 		Data: reportVOs,
+		Facets: vo.ReportFacetsVO{
+			Status:            toFacetCountVOs(facets.Status),
+			Category:          toFacetCountVOs(facets.Category),
+			SeveritySuggested: toFacetCountVOs(facets.SeveritySuggested),
+			SeverityFinal:     toFacetCountVOs(facets.SeverityFinal),
+			EvidenceLevel:     toFacetCountVOs(facets.EvidenceLevel),
+			Zone:              toFacetCountVOs(facets.Zone),
+		},
 		Pagination: vo.PaginationVO{
 			Page:       query.Page,
 			PageSize:   query.PageSize,
@@ -113,26 +340,226 @@ func (s *ReportService) List(ctx context.Context, query dto.ListReportsQuery) (*
 	}, nil
 }
 
+// Review claims a report for review. It takes the same lease as a claim from
+// the triage queue.
+func (s *ReportService) Review(ctx context.Context, id string, req dto.ReportStatusRequest, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error) {
+	lease, err := s.queueSvc.claim(ctx, id, userID, actorIP, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return lease.Report, nil
+}
+
+// MarkSpam marks a report as spam or abuse
+func (s *ReportService) MarkSpam(ctx context.Context, id string, req dto.ReportStatusRequest, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error) {
+	report, err := s.getReport(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.transition(ctx, report, model.StatusSpam, model.ActionSpam, req.Reason, userID, actorIP)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reputation is best effort and must not block moderation
+	if err := s.reputationSvc.RecordOutcome(ctx, report, model.OutcomeSpam); err != nil {
+		log.Printf("reputation update failed for report %s: %v", report.ID, err)
+	}
+	return result, nil
+}
+
+// Close closes a report
+func (s *ReportService) Close(ctx context.Context, id string, req dto.ReportStatusRequest, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error) {
+	return s.changeStatus(ctx, id, model.StatusClosed, model.ActionClose, req.Reason, userID, actorIP)
+}
+
+// Reopen returns a closed or spam report to review
+func (s *ReportService) Reopen(ctx context.Context, id string, req dto.ReportStatusRequest, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error) {
+	report, err := s.getReport(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != model.StatusClosed && report.Status != model.StatusSpam {
+		return nil, ErrInvalidTransition
+	}
+	return s.transition(ctx, report, model.StatusUnderReview, model.ActionReopen, req.Reason, userID, actorIP)
+}
+
+// Admit moves a quarantined report into the triage queue
+func (s *ReportService) Admit(ctx context.Context, id string, req dto.ReportStatusRequest, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error) {
+	report, err := s.getReport(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != model.StatusQuarantined {
+		return nil, ErrInvalidTransition
+	}
+	return s.transition(ctx, report, model.StatusSubmitted, model.ActionAdmit, req.Reason, userID, actorIP)
+}
+
+// changeStatus applies a validated status transition to a report
+func (s *ReportService) changeStatus(ctx context.Context, id, to, action, reason string, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error) {
+	report, err := s.getReport(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.transition(ctx, report, to, action, reason, userID, actorIP)
+}
+
+// transition moves a report to a new status and writes the audit entry. Only
+// the claim holder may change a claimed report; closing it or marking it as
+// spam ends the claim. A report in a consensus round is decided by the round.
+func (s *ReportService) transition(ctx context.Context, report *model.Report, to, action, reason string, userID *uuid.UUID, actorIP string) (*vo.ReportVO, error) {
+	from := report.Status
+	if !isValidReportTransition(from, to) {
+		return nil, ErrInvalidTransition
+	}
+
+	final := to == model.StatusClosed || to == model.StatusSpam
+	if final {
+		round, err := s.consensusRepo.GetPendingRound(ctx, report.ID)
+		if err != nil {
+			return nil, err
+		}
+		if round != nil {
+			return nil, ErrInConsensus
+		}
+	}
+
+	lease, err := s.leases.Get(ctx, report.ID)
+	if err != nil {
+		return nil, err
+	}
+	if lease != nil && (userID == nil || lease.HolderID != *userID) {
+		return nil, ErrReportClaimed
+	}
+
+	ok, err := s.reportRepo.TransitionStatus(ctx, report.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// Status changed underneath us
+		return nil, ErrInvalidTransition
+	}
+	report.Status = to
+
+	if lease != nil && final {
+		s.leases.Release(ctx, report.ID, lease.HolderID)
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     action,
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &report.ID,
+		Diff: model.JSONMap{
+			"status": map[string]string{"from": from, "to": to},
+			"reason": reason,
+		},
+	})
+
+	return toReportVO(report), nil
+}
+
+// getReport loads a report by its string ID
+func (s *ReportService) getReport(ctx context.Context, id string) (*model.Report, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrReportNotFound
+	}
+
+	report, err := s.reportRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, ErrReportNotFound
+	}
+
+	return report, nil
+}
+
+// isValidReportTransition checks if a report status transition is valid
+func isValidReportTransition(from, to string) bool {
+	validTransitions := map[string][]string{
+		model.StatusSubmitted:   {model.StatusUnderReview, model.StatusTriaged, model.StatusEscalated, model.StatusClosed, model.StatusSpam},
+		model.StatusUnderReview: {model.StatusTriaged, model.StatusEscalated, model.StatusClosed, model.StatusSpam},
+		model.StatusTriaged:     {model.StatusUnderReview, model.StatusEscalated, model.StatusClosed, model.StatusSpam},
+		model.StatusEscalated:   {model.StatusUnderReview, model.StatusTriaged, model.StatusClosed},
+		model.StatusClosed:      {model.StatusUnderReview},
+		model.StatusSpam:        {model.StatusUnderReview},
+		model.StatusQuarantined: {model.StatusSubmitted, model.StatusClosed, model.StatusSpam},
+	}
+
+	allowed, ok := validTransitions[from]
+	if !ok {
+		return false
+	}
+
+	for _, s := range allowed {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
 // toReportVO converts a report model to VO
-func (s *ReportService) toReportVO(report *model.Report) *vo.ReportVO {
-	return &vo.ReportVO{
+func toReportVO(report *model.Report) *vo.ReportVO {
+	result := &vo.ReportVO{
 		ID:                report.ID.String(),
 		Category:          report.Category,
 		SeveritySuggested: report.SeveritySuggested,
 		AreaHint:          report.AreaHint,
+		ZoneID:            report.Location.ZoneID,
+		Geohash:           report.Location.Geohash,
 		TimeWindow:        report.TimeWindow,
 		Description:       report.Description,
 		Evidence:          report.EvidenceRefs,
 		Status:            report.Status,
+		Channel:           report.Channel,
+		RiskScore:         report.RiskScore,
+		RiskSignals:       report.RiskSignals,
 		CreatedAt:         report.CreatedAt,
 		UpdatedAt:         report.UpdatedAt,
 	}
+
+	if report.BrigadeClusterID != nil {
+		result.BrigadeClusterID = report.BrigadeClusterID.String()
+	}
+	if report.IncidentID != nil {
+		result.IncidentID = report.IncidentID.String()
+	}
+	if report.ImportBatchID != nil {
+		result.ImportBatchID = report.ImportBatchID.String()
+	}
+	result.PurgedAt = report.PurgedAt
+
+	return result
+}
+
+// toFacetCountVOs sorts facet counts by count, then value
+func toFacetCountVOs(counts map[string]int64) []vo.FacetCountVO {
+	result := make([]vo.FacetCountVO, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, vo.FacetCountVO{Value: value, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Value < result[j].Value
+	})
+	return result
 }
 
 // toReportDetailVO converts a report model to detailed VO with triage decisions
-func (s *ReportService) toReportDetailVO(report *model.Report) *vo.ReportDetailVO {
+func toReportDetailVO(report *model.Report) *vo.ReportDetailVO {
 	detail := &vo.ReportDetailVO{
-		ReportVO: *s.toReportVO(report),
+		ReportVO: *toReportVO(report),
 	}
 
 	if len(report.TriageDecisions) > 0 {
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// ReputationService learns how much to trust a reporter from the triage
+// outcomes of their earlier reports, without knowing who they are. Reporters
+// are identified only by a salted key derived from the report's device hash.
+// The salt mixes in a rotation epoch, so keys change every rotation period
+// and old keys expire under the retention policy.
+type ReputationService struct {
+	reputationRepo *repository.ReputationRepository
+	salt           []byte
+	rotation       time.Duration
+	retention      time.Duration
+}
+
+// NewReputationService creates a new reputation service
+func NewReputationService(reputationRepo *repository.ReputationRepository, salt string, rotation, retention time.Duration) *ReputationService {
+	if rotation <= 0 {
+		rotation = 90 * 24 * time.Hour
+	}
+	if retention < rotation {
+		retention = rotation
+	}
+	return &ReputationService{
+		reputationRepo: reputationRepo,
+		salt:           []byte(salt),
+		rotation:       rotation,
+		retention:      retention,
+	}
+}
+
+// Run purges expired reputations every interval until ctx is cancelled
+func (s *ReputationService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.reputationRepo.DeleteExpired(ctx, time.Now().UTC()); err != nil {
+			log.Printf("reputation purge failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecordOutcome credits a triage outcome to the report's reporter. A later
+// outcome for the same report replaces the earlier one. Reports without a
+// device hash of their own (e.g. staff-entered ones, or ones that fell back
+// to a shared hash) are ignored.
+func (s *ReputationService) RecordOutcome(ctx context.Context, report *model.Report, outcome string) error {
+	if reputationHash(report) == "" || outcome == "" {
+		return nil
+	}
+
+	previous, err := s.reputationRepo.GetOutcome(ctx, report.ID)
+	if err != nil {
+		return err
+	}
+	if previous != nil && previous.Outcome == outcome {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	key, err := s.currentKey(ctx, report.DeviceHash, now)
+	if err != nil {
+		return err
+	}
+
+	return s.reputationRepo.ApplyOutcome(ctx, &model.ReputationOutcome{
+		ReportID:    report.ID,
+		ReporterKey: key,
+		Outcome:     outcome,
+		RecordedAt:  now,
+		ExpiresAt:   now.Add(s.retention),
+	}, previous)
+}
+
+// WithdrawOutcome removes whatever outcome a report contributed, e.g. when
+// an appeal overturns a spam decision
+func (s *ReputationService) WithdrawOutcome(ctx context.Context, report *model.Report) error {
+	outcome, err := s.reputationRepo.GetOutcome(ctx, report.ID)
+	if err != nil || outcome == nil {
+		return err
+	}
+	return s.reputationRepo.RemoveOutcome(ctx, outcome)
+}
+
+// Trust returns the trust indicator for a device hash
+func (s *ReputationService) Trust(ctx context.Context, deviceHash string) (*vo.ReporterTrustVO, error) {
+	if deviceHash == "" {
+		return nil, nil
+	}
+	trust, err := s.TrustMany(ctx, []string{deviceHash})
+	if err != nil {
+		return nil, err
+	}
+	return trust[deviceHash], nil
+}
+
+// TrustMany returns trust indicators keyed by device hash. Reputations from
+// the previous rotation period are used until the reporter is seen again.
+func (s *ReputationService) TrustMany(ctx context.Context, deviceHashes []string) (map[string]*vo.ReporterTrustVO, error) {
+	epoch := s.epoch(time.Now().UTC())
+	current := make(map[string]string)
+	previous := make(map[string]string)
+	var keys []string
+	for _, h := range deviceHashes {
+		if h == "" {
+			continue
+		}
+		if _, ok := current[h]; ok {
+			continue
+		}
+		current[h] = s.key(h, epoch)
+		previous[h] = s.key(h, epoch-1)
+		keys = append(keys, current[h], previous[h])
+	}
+
+	reps, err := s.reputationRepo.GetMany(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*model.ReporterReputation, len(reps))
+	for i := range reps {
+		byKey[reps[i].Key] = &reps[i]
+	}
+
+	result := make(map[string]*vo.ReporterTrustVO, len(current))
+	for h, key := range current {
+		rep := byKey[key]
+		if rep == nil {
+			rep = byKey[previous[h]]
+		}
+		result[h] = toReporterTrustVO(rep)
+	}
+	return result, nil
+}
+
+// currentKey returns the reporter key for this rotation period, carrying
+// the previous period's counts over the first time the reporter is seen
+func (s *ReputationService) currentKey(ctx context.Context, deviceHash string, now time.Time) (string, error) {
+	epoch := s.epoch(now)
+	key := s.key(deviceHash, epoch)
+
+	existing, err := s.reputationRepo.Get(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	if existing == nil {
+		if err := s.reputationRepo.Rotate(ctx, s.key(deviceHash, epoch-1), key, now.Add(s.retention)); err != nil {
+			return "", err
+		}
+	}
+	return key, nil
+}
+
+func (s *ReputationService) epoch(t time.Time) int64 {
+	return t.Unix() / int64(s.rotation/time.Second)
+}
+
+func (s *ReputationService) key(deviceHash string, epoch int64) string {
+	mac := hmac.New(sha256.New, s.salt)
+	mac.Write([]byte(strconv.FormatInt(epoch, 10) + ":" + deviceHash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// toReporterTrustVO converts a reputation to its trust indicator
+func toReporterTrustVO(rep *model.ReporterReputation) *vo.ReporterTrustVO {
+	if rep == nil {
+		return &vo.ReporterTrustVO{Level: model.TrustNew, Score: 0.5}
+	}
+
+	result := &vo.ReporterTrustVO{
+		Level:    trustLevel(rep),
+		Score:    rep.Score(),
+		Accepted: rep.Accepted,
+		Rejected: rep.Rejected,
+		Spam:     rep.Spam,
+	}
+	return result
+}
+
+// trustLevel buckets a reputation; fewer than two outcomes is too little to judge
+func trustLevel(rep *model.ReporterReputation) string {
+	score := rep.Score()
+	switch {
+	case rep.Observations() < 2:
+		return model.TrustNew
+	case score >= 0.7:
+		return model.TrustTrusted
+	case score <= 0.3:
+		return model.TrustLow
+	default:
+		return model.TrustNeutral
+	}
+}
+
+// decisionOutcome maps a triage decision to a reputation outcome.
+// needs_more_info says nothing about the reporter yet.
+func decisionOutcome(decision string) string {
+	switch decision {
+	case model.DecisionAccept, model.DecisionEscalate:
+		return model.OutcomeAccepted
+	case model.DecisionReject:
+		return model.OutcomeRejected
+	default:
+		return ""
+	}
+}
+
+// ReputationGate raises risk for reporters whose earlier reports were
+// rejected or marked as spam, and lowers nothing for unknown reporters
+type ReputationGate struct {
+	reputationSvc *ReputationService
+}
+
+// NewReputationGate creates a reputation gate
+func NewReputationGate(reputationSvc *ReputationService) *ReputationGate {
+	return &ReputationGate{reputationSvc: reputationSvc}
+}
+
+// Name returns the gate name
+func (g *ReputationGate) Name() string {
+	return "reputation"
+}
+
+// Check scores the device's reporter trust. Neutral and better scores add no
+// risk; risk climbs to 0.8 as the score falls to zero.
+func (g *ReputationGate) Check(ctx context.Context, signals *IntakeSignals) (GateResult, error) {
+	if signals.Fallback {
+		// A shared hash says nothing about this reporter
+		return GateResult{Risk: 0, Signal: "reputation:unknown"}, nil
+	}
+	trust, err := g.reputationSvc.Trust(ctx, signals.DeviceHash)
+	if err != nil {
+		return GateResult{}, err
+	}
+	if trust == nil || trust.Level == model.TrustNew {
+		return GateResult{Risk: 0, Signal: "reputation:new"}, nil
+	}
+
+	risk := (0.5 - trust.Score) * 1.6
+	if risk < 0 {
+		risk = 0
+	}
+	signal := "reputation:ok"
+	if trust.Level == model.TrustLow {
+		signal = "reputation:low"
+	}
+	return GateResult{Risk: risk, Signal: signal}, nil
+}
+
+// reputationHash returns the device hash a report's reputation is kept
+// under, or "" if it has none. Fallback hashes are shared by unrelated
+// reporters and never carry reputation.
+func reputationHash(report *model.Report) string {
+	if report.DeviceFallback {
+		return ""
+	}
+	return report.DeviceHash
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+// retentionBatchSize is how many records one purge transaction handles
+const retentionBatchSize = 500
+
+// RetentionPolicy holds the retention window of each data class. A zero
+// window keeps the class indefinitely.
+type RetentionPolicy struct {
+	Reports   time.Duration // content of decided reports, counted from submission
+	Contacts  time.Duration // reporter contact refs and pseudonymous hashes
+	Evidence  time.Duration // stored evidence files
+	AuditIPs  time.Duration // actor IPs in the audit log
+	AuditLogs time.Duration // audit log entries; purge summaries are always kept
+}
+
+// retentionClass is one retention class and how it is counted and purged
+type retentionClass struct {
+	name   string
+	action string
+	window time.Duration
+	count  func(ctx context.Context, before time.Time) (int64, int64, error)
+	purge  func(ctx context.Context, before, now time.Time) (int64, int64, error)
+}
+
+// RetentionService enforces the retention policy. Expired records are deleted
+// or anonymized in batches, keeping what the KPI figures are computed from,
+// and every purge writes a signed summary to the audit log.
+type RetentionService struct {
+	retentionRepo *repository.RetentionRepository
+	auditRepo     *repository.AuditRepository
+	classes       []retentionClass
+	signingKey    []byte
+}
+
+// NewRetentionService creates a new retention service. Purge summaries are
+// signed with HMAC-SHA256 under signingKey.
+func NewRetentionService(retentionRepo *repository.RetentionRepository, auditRepo *repository.AuditRepository, policy RetentionPolicy, signingKey string) *RetentionService {
+	s := &RetentionService{
+		retentionRepo: retentionRepo,
+		auditRepo:     auditRepo,
+		signingKey:    []byte(signingKey),
+	}
+
+	// Reports go first: anonymizing them also clears their contacts and
+	// evidence, leaving less for the later classes.
+	s.classes = []retentionClass{
+		{
+			name:   model.RetentionReports,
+			action: "anonymize",
+			window: policy.Reports,
+			count:  retentionRepo.CountReports,
+			purge: func(ctx context.Context, before, now time.Time) (int64, int64, error) {
+				return retentionRepo.PurgeReports(ctx, before, now, retentionBatchSize)
+			},
+		},
+		{
+			name:   model.RetentionContacts,
+			action: "clear",
+			window: policy.Contacts,
+			count:  countOnly(retentionRepo.CountContacts),
+			purge:  purgeOnly(retentionRepo.PurgeContacts),
+		},
+		{
+			name:   model.RetentionEvidence,
+			action: "delete",
+			window: policy.Evidence,
+			count:  countOnly(retentionRepo.CountEvidence),
+			purge:  purgeOnly(retentionRepo.PurgeEvidence),
+		},
+		{
+			name:   model.RetentionAuditIPs,
+			action: "clear",
+			window: policy.AuditIPs,
+			count:  countOnly(retentionRepo.CountAuditIPs),
+			purge:  purgeOnly(retentionRepo.PurgeAuditIPs),
+		},
+		{
+			name:   model.RetentionAuditLog,
+			action: "delete",
+			window: policy.AuditLogs,
+			count:  countOnly(retentionRepo.CountAuditLogs),
+			purge:  purgeOnly(retentionRepo.PurgeAuditLogs),
+		},
+	}
+	return s
+}
+
+func countOnly(count func(context.Context, time.Time) (int64, error)) func(context.Context, time.Time) (int64, int64, error) {
+	return func(ctx context.Context, before time.Time) (int64, int64, error) {
+		n, err := count(ctx, before)
+		return n, 0, err
+	}
+}
+
+func purgeOnly(purge func(context.Context, time.Time, int) (int64, error)) func(context.Context, time.Time, time.Time) (int64, int64, error) {
+	return func(ctx context.Context, before, now time.Time) (int64, int64, error) {
+		n, err := purge(ctx, before, retentionBatchSize)
+		return n, 0, err
+	}
+}
+
+// Run purges expired data every interval until ctx is cancelled
+func (s *RetentionService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Purge(ctx); err != nil {
+			log.Printf("retention purge failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Preview reports what a purge would remove now, without changing anything
+func (s *RetentionService) Preview(ctx context.Context) (*vo.RetentionSummaryVO, error) {
+	now := time.Now().UTC()
+	summary := &vo.RetentionSummaryVO{RunAt: now, DryRun: true, Classes: []vo.RetentionClassVO{}}
+
+	for _, c := range s.classes {
+		if c.window <= 0 {
+			continue
+		}
+		class := newRetentionClassVO(c, now)
+		records, files, err := c.count(ctx, class.Cutoff)
+		if err != nil {
+			return nil, err
+		}
+		class.Records, class.EvidenceFiles = records, files
+		summary.Classes = append(summary.Classes, class)
+	}
+	return summary, nil
+}
+
+// Purge removes everything past its retention window and records a signed
+// summary in the audit log. If a class fails the purge stops there; the
+// summary then records the error and what was removed before it.
+func (s *RetentionService) Purge(ctx context.Context) (*vo.RetentionSummaryVO, error) {
+	now := time.Now().UTC()
+	summary := &vo.RetentionSummaryVO{RunAt: now, Classes: []vo.RetentionClassVO{}}
+
+	var purgeErr error
+	for _, c := range s.classes {
+		if c.window <= 0 {
+			continue
+		}
+		class := newRetentionClassVO(c, now)
+		for {
+			records, files, err := c.purge(ctx, class.Cutoff, now)
+			class.Records += records
+			class.EvidenceFiles += files
+			if err != nil {
+				purgeErr = err
+				break
+			}
+			if records < retentionBatchSize {
+				break
+			}
+		}
+		summary.Classes = append(summary.Classes, class)
+		if purgeErr != nil {
+			summary.Error = purgeErr.Error()
+			break
+		}
+	}
+
+	// Create audit log
+	diff, err := s.signSummary(summary)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.auditRepo.Create(ctx, &model.AuditLog{
+		Action:     model.ActionPurge,
+		ObjectType: model.ObjectTypeRetention,
+		Diff:       diff,
+	}); err != nil {
+		return nil, err
+	}
+
+	return summary, purgeErr
+}
+
+// ListRuns retrieves recorded purges, newest first, checking each signature
+func (s *RetentionService) ListRuns(ctx context.Context, query dto.ListRetentionRunsQuery) (*vo.RetentionRunListVO, error) {
+	logs, total, err := s.auditRepo.List(ctx, repository.ListAuditParams{
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		ObjectType: model.ObjectTypeRetention,
+		Action:     model.ActionPurge,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]vo.RetentionRunVO, len(logs))
+	for i, l := range logs {
+		runs[i] = vo.RetentionRunVO{ID: l.ID.String()}
+		raw, err := json.Marshal(l.Diff["summary"])
+		if err != nil {
+			continue
+		}
+		if err := json.Unmarshal(raw, &runs[i].Summary); err != nil {
+			continue
+		}
+		signature, _ := l.Diff["signature"].(string)
+		runs[i].SignatureValid = hmac.Equal([]byte(signature), []byte(s.sign(raw)))
+	}
+
+	return &vo.RetentionRunListVO{
+		Data:       runs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// signSummary builds the audit diff of a purge: the summary and its
+// signature. The summary is signed as it reads back from the audit log, a
+// JSON object with sorted keys, so the signature can be checked later.
+func (s *RetentionService) signSummary(summary *vo.RetentionSummaryVO) (model.JSONMap, error) {
+	raw, err := json.Marshal(summary)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	canonical, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return model.JSONMap{
+		"summary":   fields,
+		"signature": s.sign(canonical),
+	}, nil
+}
+
+// sign returns the hex HMAC-SHA256 of a canonical summary
+func (s *RetentionService) sign(canonical []byte) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write(canonical)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newRetentionClassVO(c retentionClass, now time.Time) vo.RetentionClassVO {
+	return vo.RetentionClassVO{
+		Class:  c.name,
+		Action: c.action,
+		Window: c.window.String(),
+		Cutoff: now.Add(-c.window),
+	}
+}
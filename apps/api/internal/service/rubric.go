@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrRubricNotFound = errors.New("rubric version not found")
+	ErrNoActiveRubric = errors.New("no rubric version is active")
+	ErrRubricCategory = errors.New("rubric names an unknown report category")
+)
+
+// RubricError lists what a decision is missing under a rubric version
+type RubricError struct {
+	Version    int
+	Violations map[string]string // field -> what the rubric requires
+}
+
+func (e *RubricError) Error() string {
+	keys := make([]string, 0, len(e.Violations))
+	for k := range e.Violations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("decision does not meet triage rubric version %d: %s", e.Version, strings.Join(keys, ", "))
+}
+
+// RubricService manages triage rubric versions
+type RubricService struct {
+	rubricRepo *repository.RubricRepository
+	auditRepo  *repository.AuditRepository
+}
+
+// NewRubricService creates a new rubric service
+func NewRubricService(rubricRepo *repository.RubricRepository, auditRepo *repository.AuditRepository) *RubricService {
+	return &RubricService{
+		rubricRepo: rubricRepo,
+		auditRepo:  auditRepo,
+	}
+}
+
+// List retrieves all rubric versions, newest first
+func (s *RubricService) List(ctx context.Context) ([]vo.TriageRubricVO, error) {
+	rubrics, err := s.rubricRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vo.TriageRubricVO, len(rubrics))
+	for i := range rubrics {
+		result[i] = *toTriageRubricVO(&rubrics[i])
+	}
+	return result, nil
+}
+
+// GetActive retrieves the rubric decisions are currently checked against
+func (s *RubricService) GetActive(ctx context.Context) (*vo.TriageRubricVO, error) {
+	rubric, err := s.rubricRepo.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rubric == nil {
+		return nil, ErrNoActiveRubric
+	}
+	return toTriageRubricVO(rubric), nil
+}
+
+// GetByVersion retrieves a rubric version
+func (s *RubricService) GetByVersion(ctx context.Context, version string) (*vo.TriageRubricVO, error) {
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return nil, ErrRubricNotFound
+	}
+	return s.getByVersion(ctx, v)
+}
+
+// getByVersion retrieves a rubric version by number
+func (s *RubricService) getByVersion(ctx context.Context, version int) (*vo.TriageRubricVO, error) {
+	rubric, err := s.rubricRepo.GetByVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	if rubric == nil {
+		return nil, ErrRubricNotFound
+	}
+	return toTriageRubricVO(rubric), nil
+}
+
+// Create saves a new rubric version and makes it the active one
+func (s *RubricService) Create(ctx context.Context, req dto.CreateRubricRequest, userID *uuid.UUID, actorIP string) (*vo.TriageRubricVO, error) {
+	categories := model.ValidCategories()
+	rules := model.RubricRules{Default: toRubricRule(req.Default)}
+	if len(req.Categories) > 0 {
+		rules.Categories = make(map[string]model.RubricRule, len(req.Categories))
+		for category, rule := range req.Categories {
+			if !containsString(categories, category) {
+				return nil, ErrRubricCategory
+			}
+			rules.Categories[category] = toRubricRule(rule)
+		}
+	}
+
+	rubric := &model.TriageRubric{
+		Note:      req.Note,
+		Rules:     rules,
+		CreatedBy: userID,
+	}
+	if err := s.rubricRepo.Create(ctx, rubric); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionCreate,
+		ObjectType: model.ObjectTypeRubric,
+		Diff: model.JSONMap{
+			"version": rubric.Version,
+			"note":    req.Note,
+		},
+	})
+
+	return s.getByVersion(ctx, rubric.Version)
+}
+
+// Activate makes an earlier version the active one again, e.g. to roll back
+func (s *RubricService) Activate(ctx context.Context, version string, userID *uuid.UUID, actorIP string) (*vo.TriageRubricVO, error) {
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return nil, ErrRubricNotFound
+	}
+
+	previous, err := s.rubricRepo.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.rubricRepo.Activate(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrRubricNotFound
+	}
+
+	diff := model.JSONMap{"version": v}
+	if previous != nil {
+		diff["previousVersion"] = previous.Version
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionActivate,
+		ObjectType: model.ObjectTypeRubric,
+		Diff:       diff,
+	})
+
+	return s.getByVersion(ctx, v)
+}
+
+// checkRubric returns what a decision on a report of the given category is
+// missing under the rubric, keyed by request field; nil when it complies.
+func checkRubric(rubric *model.TriageRubric, category, decision, severity, evidenceLevel string, fields map[string]string, confirmations []string) map[string]string {
+	rule := rubric.Rules.RuleFor(category)
+	if !rule.AppliesTo(decision) {
+		return nil
+	}
+
+	violations := make(map[string]string)
+	if required, ok := rule.MinEvidence[severity]; ok && model.EvidenceRank(evidenceLevel) < model.EvidenceRank(required) {
+		violations["evidenceLevel"] = fmt.Sprintf("%s needs evidence level %s or higher", severity, required)
+	}
+	for _, field := range rule.Fields {
+		if strings.TrimSpace(fields[field]) == "" {
+			violations["rationaleFields."+field] = "required"
+		}
+	}
+	for _, confirmation := range rule.Confirmations {
+		if !containsString(confirmations, confirmation) {
+			violations["confirmations."+confirmation] = "must be confirmed"
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return violations
+}
+
+// rationaleFieldsMap stores the filled-in rationale fields of a decision
+func rationaleFieldsMap(fields map[string]string) model.JSONMap {
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make(model.JSONMap, len(fields))
+	for k, v := range fields {
+		if v = strings.TrimSpace(v); v != "" {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// toRubricRule converts a rule in a request to its stored form
+func toRubricRule(req dto.RubricRuleRequest) model.RubricRule {
+	return model.RubricRule{
+		Decisions:     req.Decisions,
+		MinEvidence:   req.MinEvidence,
+		Fields:        req.Fields,
+		Confirmations: req.Confirmations,
+	}
+}
+
+// toTriageRubricVO converts a rubric model to VO
+func toTriageRubricVO(rubric *model.TriageRubric) *vo.TriageRubricVO {
+	result := &vo.TriageRubricVO{
+		Version:   rubric.Version,
+		Note:      rubric.Note,
+		Active:    rubric.Active,
+		Default:   toRubricRuleVO(rubric.Rules.Default),
+		CreatedBy: toUserSummaryVO(rubric.Creator),
+		CreatedAt: rubric.CreatedAt,
+	}
+	if len(rubric.Rules.Categories) > 0 {
+		result.Categories = make(map[string]vo.RubricRuleVO, len(rubric.Rules.Categories))
+		for category, rule := range rubric.Rules.Categories {
+			result.Categories[category] = toRubricRuleVO(rule)
+		}
+	}
+	return result
+}
+
+// toRubricRuleVO converts a rubric rule to VO
+func toRubricRuleVO(rule model.RubricRule) vo.RubricRuleVO {
+	return vo.RubricRuleVO{
+		Decisions:     rule.Decisions,
+		MinEvidence:   rule.MinEvidence,
+		Fields:        rule.Fields,
+		Confirmations: rule.Confirmations,
+	}
+}
+
+// containsString reports whether list holds s
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
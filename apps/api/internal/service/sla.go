@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrInvalidSeverity = errors.New("invalid severity")
+)
+
+// slaDefaultSeverity is the policy used for reports without a suggested severity
+const slaDefaultSeverity = model.SeverityS2
+
+// SLAService enforces triage SLAs and escalates reports that breach them
+type SLAService struct {
+	slaRepo         *repository.SLARepository
+	reportRepo      *repository.ReportRepository
+	userRepo        *repository.UserRepository
+	auditRepo       *repository.AuditRepository
+	leases          repository.LeaseStore
+	notificationSvc *NotificationService
+}
+
+// NewSLAService creates a new SLA service
+func NewSLAService(
+	slaRepo *repository.SLARepository,
+	reportRepo *repository.ReportRepository,
+	userRepo *repository.UserRepository,
+	auditRepo *repository.AuditRepository,
+	leases repository.LeaseStore,
+	notificationSvc *NotificationService,
+) *SLAService {
+	return &SLAService{
+		slaRepo:         slaRepo,
+		reportRepo:      reportRepo,
+		userRepo:        userRepo,
+		auditRepo:       auditRepo,
+		leases:          leases,
+		notificationSvc: notificationSvc,
+	}
+}
+
+// Run checks SLAs every interval until ctx is cancelled
+func (s *SLAService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Check(ctx); err != nil {
+			log.Printf("sla check failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check finds reports that are about to breach or have breached their SLA,
+// records each breach once per level and notifies the responsible people
+func (s *SLAService) Check(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	policies, err := s.policyMap(ctx)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	// Only reports old enough to reach the earliest warning can matter
+	earliest := time.Duration(0)
+	for _, p := range policies {
+		lead := time.Duration(p.TriageMinutes-p.WarnMinutes) * time.Minute
+		if earliest == 0 || lead < earliest {
+			earliest = lead
+		}
+	}
+
+	reports, err := s.reportRepo.ListPending(ctx, now.Add(-earliest))
+	if err != nil {
+		return err
+	}
+
+	for i := range reports {
+		report := &reports[i]
+		severity := report.SeveritySuggested
+		if severity == "" {
+			severity = slaDefaultSeverity
+		}
+		policy, ok := policies[severity]
+		if !ok {
+			continue
+		}
+
+		level := ""
+		switch {
+		case !now.Before(policy.Deadline(report.CreatedAt)):
+			level = model.SLALevelBreach
+		case policy.WarnMinutes > 0 && !now.Before(policy.WarnAt(report.CreatedAt)):
+			level = model.SLALevelWarning
+		default:
+			continue
+		}
+
+		// A failed escalation is retried on the next check and must not hold
+		// up the other reports
+		if err := s.escalate(ctx, report, severity, level, policy.Deadline(report.CreatedAt), now); err != nil {
+			log.Printf("sla escalation failed for report %s: %v", report.ID, err)
+		}
+	}
+
+	return s.slaRepo.ResolveBreaches(ctx, now)
+}
+
+// escalate records a breach the first time it is seen and notifies
+// recipients until a notification goes out
+func (s *SLAService) escalate(ctx context.Context, report *model.Report, severity, level string, dueAt, now time.Time) error {
+	breach := &model.SLABreach{
+		ReportID:   report.ID,
+		Level:      level,
+		Severity:   severity,
+		DueAt:      dueAt,
+		DetectedAt: now,
+	}
+	created, err := s.slaRepo.CreateBreach(ctx, breach)
+	if err != nil {
+		return err
+	}
+	if !created {
+		// Already recorded; retry only if its notification has not gone out
+		breach, err = s.slaRepo.GetBreach(ctx, report.ID, level)
+		if err != nil || breach == nil || breach.NotifiedAt != nil {
+			return err
+		}
+	}
+
+	recipients, err := s.recipients(ctx, report.ID, level)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	kind, title := model.NotificationSLAWarning, fmt.Sprintf("SLA warning: %s report due for triage", severity)
+	if level == model.SLALevelBreach {
+		kind, title = model.NotificationSLABreach, fmt.Sprintf("SLA breached: %s report awaiting triage", severity)
+	}
+	if err := s.notificationSvc.Notify(ctx, recipients, model.Notification{
+		Kind:       kind,
+		Title:      title,
+		Body:       fmt.Sprintf("Report %s (%s) was due for triage at %s.", report.ID, report.Category, dueAt.Format(time.RFC3339)),
+		ObjectType: model.ObjectTypeReport,
+		ObjectID:   &report.ID,
+	}); err != nil {
+		return err
+	}
+
+	notified := make(model.StringArray, len(recipients))
+	for i, uid := range recipients {
+		notified[i] = uid.String()
+	}
+	return s.slaRepo.SetNotified(ctx, breach.ID, notified, now)
+}
+
+// recipients picks who to notify: the claim holder if the report is claimed,
+// otherwise the on-call triagers (or every triager when nobody is on call).
+// Breaches are also escalated to leads (admins).
+func (s *SLAService) recipients(ctx context.Context, reportID uuid.UUID, level string) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool)
+	var result []uuid.UUID
+	add := func(id uuid.UUID) {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+
+	lease, err := s.leases.Get(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if lease != nil {
+		add(lease.HolderID)
+	} else {
+		onCall, err := s.userRepo.ListOnCall(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(onCall) == 0 {
+			onCall, err = s.userRepo.ListActiveByRole(ctx, model.RoleTriager)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, u := range onCall {
+			add(u.ID)
+		}
+	}
+
+	if level == model.SLALevelBreach {
+		leads, err := s.userRepo.ListActiveByRole(ctx, model.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range leads {
+			add(u.ID)
+		}
+	}
+
+	return result, nil
+}
+
+// ListPolicies retrieves all SLA policies
+func (s *SLAService) ListPolicies(ctx context.Context) ([]vo.SLAPolicyVO, error) {
+	policies, err := s.slaRepo.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vo.SLAPolicyVO, len(policies))
+	for i, p := range policies {
+		result[i] = *toSLAPolicyVO(&p)
+	}
+	return result, nil
+}
+
+// UpdatePolicy sets the SLA for a severity
+func (s *SLAService) UpdatePolicy(ctx context.Context, severity string, req dto.UpdateSLAPolicyRequest, userID *uuid.UUID, actorIP string) (*vo.SLAPolicyVO, error) {
+	if !isValidSeverity(severity) {
+		return nil, ErrInvalidSeverity
+	}
+
+	policy := &model.SLAPolicy{
+		Severity:      severity,
+		TriageMinutes: req.TriageMinutes,
+		WarnMinutes:   req.WarnMinutes,
+		UpdatedBy:     userID,
+		UpdatedAt:     time.Now().UTC(),
+	}
+	if err := s.slaRepo.SavePolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionUpdate,
+		ObjectType: model.ObjectTypeSLAPolicy,
+		Diff: model.JSONMap{
+			"severity":      severity,
+			"triageMinutes": req.TriageMinutes,
+			"warnMinutes":   req.WarnMinutes,
+		},
+	})
+
+	return toSLAPolicyVO(policy), nil
+}
+
+// ListBreaches retrieves recorded SLA warnings and breaches
+func (s *SLAService) ListBreaches(ctx context.Context, query dto.ListSLABreachesQuery) (*vo.SLABreachListVO, error) {
+	breaches, total, err := s.slaRepo.ListBreaches(ctx, repository.ListBreachParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Level:    query.Level,
+		Severity: query.Severity,
+		OpenOnly: query.Open,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	breachVOs := make([]vo.SLABreachVO, len(breaches))
+	for i, b := range breaches {
+		breachVOs[i] = vo.SLABreachVO{
+			ID:         b.ID.String(),
+			ReportID:   b.ReportID.String(),
+			Level:      b.Level,
+			Severity:   b.Severity,
+			DueAt:      b.DueAt,
+			DetectedAt: b.DetectedAt,
+			Notified:   b.Notified,
+			NotifiedAt: b.NotifiedAt,
+			ResolvedAt: b.ResolvedAt,
+		}
+	}
+
+	return &vo.SLABreachListVO{
+		Data: breachVOs,
+		Pagination: vo.PaginationVO{
+			Page:       query.Page,
+			PageSize:   query.PageSize,
+			Total:      total,
+			TotalPages: int((total + int64(query.PageSize) - 1) / int64(query.PageSize)),
+		},
+	}, nil
+}
+
+// GetStats summarizes SLA performance over the last days
+func (s *SLAService) GetStats(ctx context.Context, query dto.SLAStatsQuery) ([]vo.SLAStatVO, error) {
+	since := time.Now().UTC().AddDate(0, 0, -query.Days)
+	stats, err := s.slaRepo.GetStats(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]vo.SLAStatVO, len(stats))
+	for i, st := range stats {
+		result[i] = vo.SLAStatVO{
+			Severity:          st.Severity,
+			Level:             st.Level,
+			Count:             int(st.Count),
+			Open:              int(st.Open),
+			AvgOverrunMinutes: st.AvgOverrunMinutes,
+		}
+	}
+	return result, nil
+}
+
+// SetOnCall marks the caller as on call (or not) for SLA escalations
+func (s *SLAService) SetOnCall(ctx context.Context, userID uuid.UUID, onCall bool, actorIP string) error {
+	if err := s.userRepo.SetOnCall(ctx, userID, onCall); err != nil {
+		return err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    &userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionUpdate,
+		ObjectType: model.ObjectTypeUser,
+		ObjectID:   &userID,
+		Diff: model.JSONMap{
+			"onCall": onCall,
+		},
+	})
+
+	return nil
+}
+
+// policyMap loads SLA policies keyed by severity
+func (s *SLAService) policyMap(ctx context.Context) (map[string]model.SLAPolicy, error) {
+	policies, err := s.slaRepo.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]model.SLAPolicy, len(policies))
+	for _, p := range policies {
+		result[p.Severity] = p
+	}
+	return result, nil
+}
+
+// isValidSeverity checks a severity against the known levels
+func isValidSeverity(severity string) bool {
+	for _, s := range model.ValidSeverities() {
+		if s == severity {
+			return true
+		}
+	}
+	return false
+}
+
+// toSLAPolicyVO converts an SLA policy model to VO
+func toSLAPolicyVO(policy *model.SLAPolicy) *vo.SLAPolicyVO {
+	return &vo.SLAPolicyVO{
+		Severity:      policy.Severity,
+		TriageMinutes: policy.TriageMinutes,
+		WarnMinutes:   policy.WarnMinutes,
+		UpdatedAt:     policy.UpdatedAt,
+	}
+}
@@ -0,0 +1,683 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/dto"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/model"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/repository"
+	"github.com/dennislee928/rotary-global-grant-safety-resilience/apps/api/internal/vo"
+)
+
+var (
+	ErrSubjectRequestNotFound = errors.New("subject request not found")
+	ErrSubjectRequestClosed   = errors.New("subject request already fulfilled or rejected")
+	ErrSubjectNotVerified     = errors.New("subject request is not verified")
+	ErrSubjectVerified        = errors.New("subject request already verified")
+	ErrSubjectExtended        = errors.New("deadline already extended")
+	ErrSubjectPastDue         = errors.New("deadline has passed")
+	ErrSubjectReceivedAt      = errors.New("receivedAt is in the future")
+)
+
+// SubjectService tracks data subject access and erasure requests from
+// receipt through identity verification to fulfilment, and finds, exports
+// and erases the data held about a subject
+type SubjectService struct {
+	subjectRepo     *repository.SubjectRepository
+	userRepo        *repository.UserRepository
+	auditRepo       *repository.AuditRepository
+	notificationSvc *NotificationService
+	secret          []byte
+	reminder        time.Duration
+}
+
+// NewSubjectService creates a new subject request service. secret is the
+// intake secret contact refs are derived with; admins are reminded of open
+// requests reminder before their deadline.
+func NewSubjectService(
+	subjectRepo *repository.SubjectRepository,
+	userRepo *repository.UserRepository,
+	auditRepo *repository.AuditRepository,
+	notificationSvc *NotificationService,
+	secret string,
+	reminder time.Duration,
+) *SubjectService {
+	return &SubjectService{
+		subjectRepo:     subjectRepo,
+		userRepo:        userRepo,
+		auditRepo:       auditRepo,
+		notificationSvc: notificationSvc,
+		secret:          []byte(secret),
+		reminder:        reminder,
+	}
+}
+
+// Create logs a request received from a data subject. Its deadline is one
+// month from receipt.
+func (s *SubjectService) Create(ctx context.Context, req dto.CreateSubjectRequest, userID *uuid.UUID, actorIP string) (*vo.SubjectRequestVO, error) {
+	now := time.Now().UTC()
+	receivedAt := now
+	if req.ReceivedAt != nil {
+		receivedAt = req.ReceivedAt.UTC()
+		if receivedAt.After(now) {
+			return nil, ErrSubjectReceivedAt
+		}
+	}
+
+	subject := &model.SubjectRequest{
+		Kind:            req.Kind,
+		Status:          model.SubjectStatusReceived,
+		ContactRef:      model.EncryptedString(strings.TrimSpace(req.ContactRef)),
+		ParticipantHash: strings.TrimSpace(req.ParticipantHash),
+		Note:            req.Note,
+		ReceivedAt:      receivedAt,
+		DueAt:           receivedAt.AddDate(0, model.SubjectDeadlineMonths, 0),
+		CreatedBy:       userID,
+	}
+	if req.FollowUpToken != "" {
+		subject.FollowUpHash = hashFollowUpToken(strings.TrimSpace(req.FollowUpToken))
+	}
+
+	if err := s.subjectRepo.Create(ctx, subject); err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionCreate,
+		ObjectType: model.ObjectTypeSubject,
+		ObjectID:   &subject.ID,
+		Diff: model.JSONMap{
+			"kind":        subject.Kind,
+			"identifiers": subjectIdentifiers(subject),
+			"receivedAt":  subject.ReceivedAt,
+			"dueAt":       subject.DueAt,
+		},
+	})
+
+	return s.GetByID(ctx, subject.ID.String())
+}
+
+// List retrieves subject requests, soonest deadline first
+func (s *SubjectService) List(ctx context.Context, query dto.ListSubjectRequestsQuery) (*vo.SubjectRequestListVO, error) {
+	now := time.Now().UTC()
+	reqs, total, err := s.subjectRepo.List(ctx, repository.ListSubjectParams{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Status:   query.Status,
+		Kind:     query.Kind,
+		Overdue:  query.Overdue,
+		Now:      now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reqVOs := make([]vo.SubjectRequestVO, len(reqs))
+	for i, r := range reqs {
+		reqVOs[i] = *toSubjectRequestVO(&r, now)
+	}
+
+	return &vo.SubjectRequestListVO{
+		Data:       reqVOs,
+		Pagination: *newPagination(query.Page, query.PageSize, total),
+	}, nil
+}
+
+// GetByID retrieves a subject request
+func (s *SubjectService) GetByID(ctx context.Context, id string) (*vo.SubjectRequestVO, error) {
+	subject, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toSubjectRequestVO(subject, time.Now().UTC()), nil
+}
+
+// Verify records that the requester's identity was checked. Data is only
+// exported or erased for verified requests.
+func (s *SubjectService) Verify(ctx context.Context, id string, req dto.VerifySubjectRequest, userID *uuid.UUID, actorIP string) (*vo.SubjectRequestVO, error) {
+	subject, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !subject.Open() {
+		return nil, ErrSubjectRequestClosed
+	}
+	if subject.Status == model.SubjectStatusVerified {
+		return nil, ErrSubjectVerified
+	}
+
+	ok, err := s.subjectRepo.Transition(ctx, subject.ID, []string{model.SubjectStatusReceived}, map[string]interface{}{
+		"status":            model.SubjectStatusVerified,
+		"verification_note": req.Note,
+		"verified_by":       userID,
+		"verified_at":       time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSubjectVerified
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionVerify,
+		ObjectType: model.ObjectTypeSubject,
+		ObjectID:   &subject.ID,
+		Diff:       model.JSONMap{"note": req.Note},
+	})
+
+	return s.GetByID(ctx, id)
+}
+
+// Extend extends an open request's deadline by two months. It can be done
+// once, before the original deadline.
+func (s *SubjectService) Extend(ctx context.Context, id string, req dto.ExtendSubjectRequest, userID *uuid.UUID, actorIP string) (*vo.SubjectRequestVO, error) {
+	subject, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !subject.Open() {
+		return nil, ErrSubjectRequestClosed
+	}
+	if subject.ExtendedAt != nil {
+		return nil, ErrSubjectExtended
+	}
+	now := time.Now().UTC()
+	if now.After(subject.DueAt) {
+		return nil, ErrSubjectPastDue
+	}
+
+	dueAt := subject.DueAt.AddDate(0, model.SubjectExtensionMonths, 0)
+	ok, err := s.subjectRepo.Transition(ctx, subject.ID, []string{model.SubjectStatusReceived, model.SubjectStatusVerified}, map[string]interface{}{
+		"due_at":           dueAt,
+		"extended_at":      now,
+		"extension_reason": req.Reason,
+		"reminded_at":      nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSubjectRequestClosed
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionExtend,
+		ObjectType: model.ObjectTypeSubject,
+		ObjectID:   &subject.ID,
+		Diff: model.JSONMap{
+			"dueAt":  map[string]time.Time{"from": subject.DueAt, "to": dueAt},
+			"reason": req.Reason,
+		},
+	})
+
+	return s.GetByID(ctx, id)
+}
+
+// Reject closes an open request without acting on it, for instance when the
+// requester's identity could not be verified
+func (s *SubjectService) Reject(ctx context.Context, id string, req dto.RejectSubjectRequest, userID *uuid.UUID, actorIP string) (*vo.SubjectRequestVO, error) {
+	subject, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !subject.Open() {
+		return nil, ErrSubjectRequestClosed
+	}
+
+	ok, err := s.subjectRepo.Transition(ctx, subject.ID, []string{model.SubjectStatusReceived, model.SubjectStatusVerified},
+		closeSubjectRequest(model.SubjectStatusRejected, userID, map[string]interface{}{
+			"rejection_reason": req.Reason,
+		}))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSubjectRequestClosed
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionReject,
+		ObjectType: model.ObjectTypeSubject,
+		ObjectID:   &subject.ID,
+		Diff:       model.JSONMap{"reason": req.Reason},
+	})
+
+	return s.GetByID(ctx, id)
+}
+
+// Export builds the machine-readable bundle of everything held about the
+// subject of a verified request. Every export is audited.
+func (s *SubjectService) Export(ctx context.Context, id string, userID *uuid.UUID, actorIP string) (*vo.SubjectExportVO, error) {
+	subject, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !subject.Open() {
+		return nil, ErrSubjectRequestClosed
+	}
+	if subject.Status != model.SubjectStatusVerified {
+		return nil, ErrSubjectNotVerified
+	}
+
+	data, err := s.findData(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     model.ActionDownload,
+		ObjectType: model.ObjectTypeSubject,
+		ObjectID:   &subject.ID,
+		Diff:       subjectDataCounts(data),
+	})
+
+	return toSubjectExportVO(subject.ID, data), nil
+}
+
+// Fulfil closes a verified request. For an access request it records that
+// the bundle was sent and what it held; for an erasure request it erases the
+// subject's data first. The subject's identifiers are then cleared from the
+// request.
+func (s *SubjectService) Fulfil(ctx context.Context, id string, userID *uuid.UUID, actorIP string) (*vo.SubjectRequestVO, error) {
+	subject, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !subject.Open() {
+		return nil, ErrSubjectRequestClosed
+	}
+	if subject.Status != model.SubjectStatusVerified {
+		return nil, ErrSubjectNotVerified
+	}
+
+	reportIDs, err := s.findReports(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	var result model.JSONMap
+	action := model.ActionResolve
+	if subject.Kind == model.SubjectKindErasure {
+		erased, err := s.subjectRepo.Erase(ctx, reportIDs, subject.ParticipantHash, time.Now().UTC())
+		if err != nil {
+			return nil, err
+		}
+		result = model.JSONMap{
+			"reports":       erased.Reports,
+			"evidence":      erased.Evidence,
+			"appeals":       erased.Appeals,
+			"subscriptions": erased.Subscriptions,
+			"reputation":    erased.Reputation,
+			"submissionIps": erased.SubmissionIPs,
+			"quizResults":   erased.QuizResults,
+			"participants":  erased.Participants,
+		}
+		action = model.ActionErase
+	} else {
+		data, err := s.subjectRepo.LoadData(ctx, reportIDs, subject.ParticipantHash)
+		if err != nil {
+			return nil, err
+		}
+		result = subjectDataCounts(data)
+	}
+
+	ok, err := s.subjectRepo.Transition(ctx, subject.ID, []string{model.SubjectStatusVerified},
+		closeSubjectRequest(model.SubjectStatusFulfilled, userID, map[string]interface{}{
+			"result": result,
+		}))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSubjectRequestClosed
+	}
+
+	// Create audit log
+	s.auditRepo.Create(ctx, &model.AuditLog{
+		ActorID:    userID,
+		ActorIP:    actorIP,
+		Action:     action,
+		ObjectType: model.ObjectTypeSubject,
+		ObjectID:   &subject.ID,
+		Diff:       model.JSONMap{"kind": subject.Kind, "result": result},
+	})
+
+	return s.GetByID(ctx, id)
+}
+
+// RunDeadlines reminds admins of approaching and missed deadlines every
+// interval until ctx is cancelled
+func (s *SubjectService) RunDeadlines(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.CheckDeadlines(ctx); err != nil {
+			log.Printf("subject request deadline check failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckDeadlines notifies admins once when an open request comes within the
+// reminder period of its deadline and once when the deadline passes
+func (s *SubjectService) CheckDeadlines(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	overdue, err := s.subjectRepo.ListDue(ctx, now, "overdue_at")
+	if err != nil {
+		return err
+	}
+	for _, r := range overdue {
+		if err := s.notifyDeadline(ctx, &r, model.NotificationSubjectOverdue, "overdue_at", now); err != nil {
+			return err
+		}
+	}
+
+	due, err := s.subjectRepo.ListDue(ctx, now.Add(s.reminder), "reminded_at")
+	if err != nil {
+		return err
+	}
+	for _, r := range due {
+		kind := model.NotificationSubjectDue
+		if now.After(r.DueAt) {
+			// Already reported as overdue above
+			kind = ""
+		}
+		if err := s.notifyDeadline(ctx, &r, kind, "reminded_at", now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyDeadline notifies active admins about a request's deadline and
+// records that it was done. An empty kind only records it.
+func (s *SubjectService) notifyDeadline(ctx context.Context, r *model.SubjectRequest, kind, column string, now time.Time) error {
+	if kind != "" {
+		admins, err := s.userRepo.ListActiveByRole(ctx, model.RoleAdmin)
+		if err != nil {
+			return err
+		}
+		recipients := make([]uuid.UUID, len(admins))
+		for i, u := range admins {
+			recipients[i] = u.ID
+		}
+
+		title := fmt.Sprintf("Data subject %s request due %s", r.Kind, r.DueAt.Format("2 Jan 2006"))
+		if kind == model.NotificationSubjectOverdue {
+			title = fmt.Sprintf("Data subject %s request overdue since %s", r.Kind, r.DueAt.Format("2 Jan 2006"))
+		}
+		if err := s.notificationSvc.Notify(ctx, recipients, model.Notification{
+			Kind:       kind,
+			Title:      title,
+			Body:       fmt.Sprintf("The request is %s. Verify, fulfil or extend it before the deadline.", r.Status),
+			ObjectType: model.ObjectTypeSubject,
+			ObjectID:   &r.ID,
+		}); err != nil {
+			return err
+		}
+	}
+	return s.subjectRepo.MarkNotified(ctx, r.ID, column, now)
+}
+
+// findReports finds the reports a subject filed, by follow-up token or contact
+func (s *SubjectService) findReports(ctx context.Context, subject *model.SubjectRequest) ([]uuid.UUID, error) {
+	return s.subjectRepo.FindReports(ctx, subject.FollowUpHash, s.contactMatcher(string(subject.ContactRef)))
+}
+
+// findData loads everything held about a subject
+func (s *SubjectService) findData(ctx context.Context, subject *model.SubjectRequest) (*repository.SubjectData, error) {
+	reportIDs, err := s.findReports(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return s.subjectRepo.LoadData(ctx, reportIDs, subject.ParticipantHash)
+}
+
+// contactMatcher matches the stored contact refs a subject's contact may
+// appear as: the contact as given, the pseudonymous ref of an email address,
+// or that of a chat user given as "line:<user ID>" or "whatsapp:<user ID>".
+// It returns nil when no contact was given.
+func (s *SubjectService) contactMatcher(contact string) func(string) bool {
+	if contact == "" {
+		return nil
+	}
+	refs := map[string]bool{contact: true}
+	if strings.Contains(contact, "@") {
+		refs[emailContactRef(s.secret, strings.ToLower(contact))] = true
+	}
+	for _, channel := range []string{model.ChannelLine, model.ChannelWhatsApp} {
+		if userID, ok := strings.CutPrefix(contact, channel+":"); ok && userID != "" {
+			refs[chatContactRef(s.secret, channel, userID)] = true
+		}
+	}
+	return func(ref string) bool {
+		return refs[ref]
+	}
+}
+
+// get loads a subject request by its string ID
+func (s *SubjectService) get(ctx context.Context, id string) (*model.SubjectRequest, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrSubjectRequestNotFound
+	}
+	subject, err := s.subjectRepo.GetByID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, ErrSubjectRequestNotFound
+	}
+	return subject, nil
+}
+
+// closeSubjectRequest adds the updates that close a request to updates:
+// its final status and who closed it, with the subject's identifiers cleared
+func closeSubjectRequest(status string, userID *uuid.UUID, updates map[string]interface{}) map[string]interface{} {
+	updates["status"] = status
+	updates["closed_by"] = userID
+	updates["closed_at"] = time.Now().UTC()
+	updates["follow_up_hash"] = ""
+	updates["contact_ref"] = ""
+	updates["participant_hash"] = ""
+	return updates
+}
+
+// subjectIdentifiers names the kinds of identifier a request carries
+func subjectIdentifiers(subject *model.SubjectRequest) []string {
+	ids := []string{}
+	if subject.FollowUpHash != "" {
+		ids = append(ids, "followUpToken")
+	}
+	if subject.ContactRef != "" {
+		ids = append(ids, "contactRef")
+	}
+	if subject.ParticipantHash != "" {
+		ids = append(ids, "participantHash")
+	}
+	return ids
+}
+
+// subjectDataCounts counts the records held about a subject, by class
+func subjectDataCounts(data *repository.SubjectData) model.JSONMap {
+	return model.JSONMap{
+		"reports":       len(data.Reports),
+		"evidence":      len(data.Evidence),
+		"appeals":       len(data.Appeals),
+		"subscriptions": len(data.Subscriptions),
+		"submissionIps": len(data.SubmissionIPs),
+		"quizResults":   len(data.QuizResults),
+		"participants":  len(data.Participants),
+	}
+}
+
+// toSubjectRequestVO converts a subject request model to VO
+func toSubjectRequestVO(subject *model.SubjectRequest, now time.Time) *vo.SubjectRequestVO {
+	result := &vo.SubjectRequestVO{
+		ID:               subject.ID.String(),
+		Kind:             subject.Kind,
+		Status:           subject.Status,
+		HasFollowUpToken: subject.FollowUpHash != "",
+		ContactRef:       string(subject.ContactRef),
+		ParticipantHash:  subject.ParticipantHash,
+		Note:             subject.Note,
+		VerificationNote: subject.VerificationNote,
+		VerifiedAt:       subject.VerifiedAt,
+		ReceivedAt:       subject.ReceivedAt,
+		DueAt:            subject.DueAt,
+		Overdue:          subject.Open() && now.After(subject.DueAt),
+		ExtendedAt:       subject.ExtendedAt,
+		ExtensionReason:  subject.ExtensionReason,
+		RejectionReason:  subject.RejectionReason,
+		Result:           subject.Result,
+		ClosedAt:         subject.ClosedAt,
+		CreatedAt:        subject.CreatedAt,
+	}
+
+	result.VerifiedBy = toUserSummaryVO(subject.Verifier)
+	result.ClosedBy = toUserSummaryVO(subject.Closer)
+	result.CreatedBy = toUserSummaryVO(subject.Creator)
+	return result
+}
+
+// toUserSummaryVO converts a loaded user association to a summary, or nil
+func toUserSummaryVO(u *model.User) *vo.UserSummaryVO {
+	if u == nil {
+		return nil
+	}
+	return &vo.UserSummaryVO{
+		ID:          u.ID.String(),
+		DisplayName: u.DisplayName,
+		Role:        u.Role,
+	}
+}
+
+// toSubjectExportVO builds the access bundle from a subject's data
+func toSubjectExportVO(requestID uuid.UUID, data *repository.SubjectData) *vo.SubjectExportVO {
+	bundle := &vo.SubjectExportVO{
+		RequestID:             requestID.String(),
+		GeneratedAt:           time.Now().UTC(),
+		Reports:               make([]vo.SubjectReportVO, len(data.Reports)),
+		Evidence:              make([]vo.SubjectEvidenceVO, len(data.Evidence)),
+		Appeals:               make([]vo.SubjectAppealVO, len(data.Appeals)),
+		Subscriptions:         make([]vo.SubjectSubscriptionVO, len(data.Subscriptions)),
+		SubmissionIPs:         make([]vo.SubjectIPVO, len(data.SubmissionIPs)),
+		QuizResults:           make([]vo.SubjectQuizResultVO, len(data.QuizResults)),
+		TrainingParticipation: make([]vo.SubjectParticipantVO, len(data.Participants)),
+	}
+
+	for i, r := range data.Reports {
+		bundle.Reports[i] = vo.SubjectReportVO{
+			ID:                r.ID.String(),
+			Category:          r.Category,
+			SeveritySuggested: r.SeveritySuggested,
+			AreaHint:          r.AreaHint,
+			TimeWindow:        r.TimeWindow,
+			Description:       r.Description,
+			EvidenceRefs:      r.EvidenceRefs,
+			ReporterContact:   string(r.ReporterContactRef),
+			ZoneID:            r.Location.ZoneID,
+			Channel:           r.Channel,
+			Status:            publicReportStatus(r.Status),
+			DeviceHash:        r.DeviceHash,
+			NetworkHash:       r.NetworkHash,
+			CreatedAt:         r.CreatedAt,
+			UpdatedAt:         r.UpdatedAt,
+			PurgedAt:          r.PurgedAt,
+		}
+	}
+	for i, e := range data.Evidence {
+		bundle.Evidence[i] = vo.SubjectEvidenceVO{
+			ID:          e.ID.String(),
+			ReportID:    e.ReportID.String(),
+			Kind:        e.Kind,
+			ContentType: e.ContentType,
+			Filename:    e.Filename,
+			Size:        e.Size,
+			SHA256:      e.SHA256,
+			Data:        e.Data,
+			CreatedAt:   e.CreatedAt,
+		}
+	}
+	for i, a := range data.Appeals {
+		bundle.Appeals[i] = vo.SubjectAppealVO{
+			ID:             a.ID.String(),
+			Message:        a.Message,
+			Status:         a.Status,
+			DecisionReason: a.DecisionReason,
+			CreatedAt:      a.CreatedAt,
+			DecidedAt:      a.DecidedAt,
+		}
+		if a.ReportID != nil {
+			bundle.Appeals[i].ReportID = a.ReportID.String()
+		}
+	}
+	for i, sub := range data.Subscriptions {
+		bundle.Subscriptions[i] = vo.SubjectSubscriptionVO{
+			ReportID:  sub.ReportID.String(),
+			Channel:   sub.Channel,
+			Recipient: string(sub.Recipient),
+			Lang:      sub.Lang,
+			ExpiresAt: sub.ExpiresAt,
+			CreatedAt: sub.CreatedAt,
+		}
+	}
+	for i, l := range data.SubmissionIPs {
+		bundle.SubmissionIPs[i] = vo.SubjectIPVO{IP: l.ActorIP, At: l.Timestamp}
+		if l.ObjectID != nil {
+			bundle.SubmissionIPs[i].ReportID = l.ObjectID.String()
+		}
+	}
+	for i, q := range data.QuizResults {
+		bundle.QuizResults[i] = vo.SubjectQuizResultVO{
+			ID:        q.ID.String(),
+			QuizType:  q.QuizType,
+			Score:     q.Score,
+			MaxScore:  q.MaxScore,
+			Answers:   q.Answers,
+			CreatedAt: q.CreatedAt,
+		}
+		if q.EventID != nil {
+			bundle.QuizResults[i].EventID = q.EventID.String()
+		}
+	}
+	for i, p := range data.Participants {
+		bundle.TrainingParticipation[i] = vo.SubjectParticipantVO{
+			EventID:   p.EventID.String(),
+			PreScore:  p.PreScore,
+			PostScore: p.PostScore,
+			CreatedAt: p.CreatedAt,
+		}
+	}
+	return bundle
+}
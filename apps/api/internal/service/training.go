@@ -115,11 +115,17 @@ func (s *TrainingService) ListEvents(ctx context.Context, query dto.ListTraining
 		}
 	}
 
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repository.ListTrainingParams{
 		Page:     query.Page,
 		PageSize: query.PageSize,
 		From:     from,
 		To:       to,
+		After:    after,
 	}
 
 	events, total, err := s.trainingRepo.ListEvents(ctx, params)
@@ -127,20 +133,26 @@ func (s *TrainingService) ListEvents(ctx context.Context, query dto.ListTraining
 		return nil, err
 	}
 
+	hasMore := hasNextPage(after, len(events), query.Page, query.PageSize, total)
+	if len(events) > query.PageSize {
+		events = events[:query.PageSize]
+	}
+
 	eventVOs := make([]vo.TrainingEventVO, len(events))
+	var last repository.Cursor
 	for i, e := range events {
 		eventVOs[i] = *s.toTrainingEventVO(&e)
+		last = repository.Cursor{Time: e.EventDate, ID: e.ID}
 	}
 
-	return &vo.TrainingEventListVO{
-		Data: eventVOs,
-		Pagination: vo.PaginationVO{
-			Page:       query.Page,
-			PageSize:   query.PageSize,
-			Total:      total,
-			TotalPages: int((total + int64(query.PageSize) - 1) / int64(query.PageSize)),
-		},
-	}, nil
+	result := &vo.TrainingEventListVO{
+		Data:   eventVOs,
+		Cursor: newCursorPagination(query.PageSize, hasMore, last),
+	}
+	if after == nil {
+		result.Pagination = newPagination(query.Page, query.PageSize, total)
+	}
+	return result, nil
 }
 
 // RecordQuizResult records a quiz result for a training event
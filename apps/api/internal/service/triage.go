@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,95 +18,488 @@ import (
 )
 
 var (
-	ErrTriageNotFound = errors.New("triage decision not found")
+	ErrTriageNotFound   = errors.New("triage decision not found")
+	ErrSeverityRequired = errors.New("severity is required for triage decisions")
+	ErrBatchIncomplete  = errors.New("batch has reports that cannot be changed")
+	ErrBatchConflict    = errors.New("report changed while the batch was applied")
+)
+
+// Bulk-only decisions alongside the triage decisions
+const (
+	bulkDecisionSpam      = "spam"
+	bulkDecisionDuplicate = "duplicate"
+)
+
+// Bulk triage outcomes
+const (
+	bulkOutcomeApplied = "applied"
+	bulkOutcomeSkipped = "skipped"
 )
 
 // TriageService handles triage business logic
 type TriageService struct {
-	triageRepo *repository.TriageRepository
-	reportRepo *repository.ReportRepository
-	auditRepo  *repository.AuditRepository
+	triageRepo    *repository.TriageRepository
+	reportRepo    *repository.ReportRepository
+	incidentRepo  *repository.IncidentRepository
+	rubricRepo    *repository.RubricRepository
+	consensusRepo *repository.ConsensusRepository
+	userRepo      *repository.UserRepository
+	auditRepo     *repository.AuditRepository
+	leases        repository.LeaseStore
+
+	reputationSvc   *ReputationService
+	notificationSvc *NotificationService
+
+	// Decisions at or above this severity need a second review; empty
+	// disables consensus
+	consensusSeverity string
 }
 
 // NewTriageService creates a new triage service
 func NewTriageService(
 	triageRepo *repository.TriageRepository,
 	reportRepo *repository.ReportRepository,
+	incidentRepo *repository.IncidentRepository,
+	rubricRepo *repository.RubricRepository,
+	consensusRepo *repository.ConsensusRepository,
+	userRepo *repository.UserRepository,
 	auditRepo *repository.AuditRepository,
+	leases repository.LeaseStore,
+	reputationSvc *ReputationService,
+	notificationSvc *NotificationService,
+	consensusSeverity string,
 ) *TriageService {
 	return &TriageService{
-		triageRepo: triageRepo,
-		reportRepo: reportRepo,
-		auditRepo:  auditRepo,
+		triageRepo:        triageRepo,
+		reportRepo:        reportRepo,
+		incidentRepo:      incidentRepo,
+		rubricRepo:        rubricRepo,
+		consensusRepo:     consensusRepo,
+		userRepo:          userRepo,
+		auditRepo:         auditRepo,
+		leases:            leases,
+		reputationSvc:     reputationSvc,
+		notificationSvc:   notificationSvc,
+		consensusSeverity: consensusSeverity,
 	}
 }
 
-// TriageReport creates a triage decision for a report
-func (s *TriageService) TriageReport(ctx context.Context, reportID string, req dto.TriageRequest, userID *uuid.UUID, actorIP string) (*vo.TriageDecisionVO, error) {
+// TriageReport creates a triage decision for a report. A decision at or
+// above the consensus severity, or on a report already in a consensus round,
+// is recorded as a blind review instead: the round is returned and the
+// decision stays nil until a second reviewer agrees or a lead breaks the tie.
+func (s *TriageService) TriageReport(ctx context.Context, reportID string, req dto.TriageRequest, userID *uuid.UUID, actorIP string) (*vo.TriageDecisionVO, *vo.ConsensusRoundVO, error) {
 	reportUUID, err := uuid.Parse(reportID)
 	if err != nil {
-		return nil, ErrReportNotFound
+		return nil, nil, ErrReportNotFound
 	}
 
 	// Check if report exists
 	report, err := s.reportRepo.GetByID(ctx, reportUUID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if report == nil {
-		return nil, ErrReportNotFound
+		return nil, nil, ErrReportNotFound
 	}
 
-	// Create audit hash
-	auditData := map[string]interface{}{
-		"reportId":      reportID,
-		"decision":      req.Decision,
-		"severityFinal": req.SeverityFinal,
-		"evidenceLevel": req.EvidenceLevel,
-		"rationale":     req.Rationale,
-		"timestamp":     time.Now().UTC().Format(time.RFC3339),
+	// Only the claim holder may decide on a claimed report
+	lease, err := s.leases.Get(ctx, reportUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lease != nil && (userID == nil || lease.HolderID != *userID) {
+		return nil, nil, ErrReportClaimed
+	}
+
+	// A repeated decision that keeps the current status is allowed (e.g.
+	// re-triage)
+	newStatus := decisionStatus(req.Decision)
+	if newStatus != report.Status && !isValidReportTransition(report.Status, newStatus) {
+		return nil, nil, ErrInvalidTransition
+	}
+
+	// The decision must meet the active rubric for the report's category
+	rubricVersion, err := s.checkRubric(ctx, report, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	rationaleFields := rationaleFieldsMap(req.RationaleFields)
+
+	round, err := s.consensusRepo.GetPendingRound(ctx, reportUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if round != nil || s.needsConsensus(req.SeverityFinal) {
+		review := &model.TriageReview{
+			Decision:        req.Decision,
+			SeverityFinal:   req.SeverityFinal,
+			EvidenceLevel:   req.EvidenceLevel,
+			Rationale:       req.Rationale,
+			RationaleFields: rationaleFields,
+			Confirmations:   req.Confirmations,
+			RubricVersion:   rubricVersion,
+		}
+		return s.review(ctx, report, round, review, lease, userID, actorIP)
 	}
-	auditHash := generateAuditHash(auditData)
 
 	decision := &model.TriageDecision{
-		ReportID:      reportUUID,
-		DecidedBy:     userID,
-		Decision:      req.Decision,
-		SeverityFinal: req.SeverityFinal,
-		EvidenceLevel: req.EvidenceLevel,
-		Rationale:     req.Rationale,
-		AuditHash:     auditHash,
-		DecidedAt:     time.Now().UTC(),
+		ReportID:        reportUUID,
+		DecidedBy:       userID,
+		Decision:        req.Decision,
+		SeverityFinal:   req.SeverityFinal,
+		EvidenceLevel:   req.EvidenceLevel,
+		Rationale:       req.Rationale,
+		RubricVersion:   rubricVersion,
+		RationaleFields: rationaleFields,
+		Confirmations:   req.Confirmations,
+	}
+	if err := s.finalize(ctx, report, decision, lease, actorIP); err != nil {
+		return nil, nil, err
 	}
 
-	if err := s.triageRepo.Create(ctx, decision); err != nil {
+	return s.toTriageDecisionVO(decision), nil, nil
+}
+
+// checkRubric checks a decision against the active rubric and returns the
+// version it met, or nil when no rubric is active
+func (s *TriageService) checkRubric(ctx context.Context, report *model.Report, req dto.TriageRequest) (*int, error) {
+	rubric, err := s.rubricRepo.GetActive(ctx)
+	if err != nil || rubric == nil {
 		return nil, err
 	}
+	violations := checkRubric(rubric, report.Category, req.Decision, req.SeverityFinal, req.EvidenceLevel, req.RationaleFields, req.Confirmations)
+	if violations != nil {
+		return nil, &RubricError{Version: rubric.Version, Violations: violations}
+	}
+	return &rubric.Version, nil
+}
+
+// finalize records a final triage decision on a report: it moves the report
+// to the decision's status, ends any claim, updates the reporter's
+// reputation and writes the audit entry
+func (s *TriageService) finalize(ctx context.Context, report *model.Report, decision *model.TriageDecision, lease *model.ReportLease, actorIP string) error {
+	newStatus, err := prepareDecision(report, decision)
+	if err != nil {
+		return err
+	}
+
+	// Record the decision and update report status based on it, unless the
+	// report changed since it was loaded
+	ok, err := s.triageRepo.Decide(ctx, decision, report.Status, newStatus)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidTransition
+	}
+
+	s.decided(ctx, report, decision, lease, newStatus, actorIP)
+	return nil
+}
+
+// prepareDecision checks that a decision may move the report and stamps it
+// with its time and audit hash. It returns the report's new status.
+func prepareDecision(report *model.Report, decision *model.TriageDecision) (string, error) {
+	newStatus := decisionStatus(decision.Decision)
+	if newStatus != report.Status && !isValidReportTransition(report.Status, newStatus) {
+		return "", ErrInvalidTransition
+	}
+	decision.DecidedAt = time.Now().UTC()
+
+	// Create audit hash
+	auditData := map[string]interface{}{
+		"reportId":        report.ID.String(),
+		"decision":        decision.Decision,
+		"severityFinal":   decision.SeverityFinal,
+		"evidenceLevel":   decision.EvidenceLevel,
+		"rationale":       decision.Rationale,
+		"rationaleFields": decision.RationaleFields,
+		"confirmations":   decision.Confirmations,
+		"rubricVersion":   decision.RubricVersion,
+		"timestamp":       decision.DecidedAt.Format(time.RFC3339),
+	}
+	if decision.RoundID != nil {
+		auditData["roundId"] = decision.RoundID.String()
+	}
+	decision.AuditHash = generateAuditHash(auditData)
+	return newStatus, nil
+}
+
+// decided follows up a recorded decision: it ends the claim, updates the
+// reporter's reputation and writes the audit entry
+func (s *TriageService) decided(ctx context.Context, report *model.Report, decision *model.TriageDecision, lease *model.ReportLease, newStatus, actorIP string) {
+	// The decision ends the claim
+	if lease != nil {
+		s.leases.Release(ctx, report.ID, lease.HolderID)
+	}
+
+	// Reputation is best effort and must not block the decision
+	if err := s.reputationSvc.RecordOutcome(ctx, report, decisionOutcome(decision.Decision)); err != nil {
+		log.Printf("reputation update failed for report %s: %v", report.ID, err)
+	}
 
-	// Update report status based on decision
-	newStatus := model.StatusTriaged
-	if req.Decision == model.DecisionEscalate {
-		newStatus = model.StatusEscalated
-	} else if req.Decision == model.DecisionReject {
-		newStatus = model.StatusClosed
+	diff := model.JSONMap{
+		"decision":      decision.Decision,
+		"severityFinal": decision.SeverityFinal,
+		"status":        map[string]string{"from": report.Status, "to": newStatus},
+		"rubricVersion": decision.RubricVersion,
+		"auditHash":     decision.AuditHash,
+	}
+	if decision.RoundID != nil {
+		diff["roundId"] = decision.RoundID.String()
 	}
-	s.reportRepo.UpdateStatus(ctx, reportUUID, newStatus)
 
 	// Create audit log
 	s.auditRepo.Create(ctx, &model.AuditLog{
-		ActorID:    userID,
+		ActorID:    decision.DecidedBy,
 		ActorIP:    actorIP,
 		Action:     model.ActionTriage,
 		ObjectType: model.ObjectTypeReport,
-		ObjectID:   &reportUUID,
-		Diff: model.JSONMap{
-			"decision":      req.Decision,
-			"severityFinal": req.SeverityFinal,
-			"auditHash":     auditHash,
-		},
+		ObjectID:   &report.ID,
+		Diff:       diff,
 	})
+}
+
+// BulkTriage applies one decision to many reports. Reports that are missing,
+// claimed by someone else or whose status does not allow the decision are
+// skipped (or, with req.Atomic, fail the whole batch with ErrBatchIncomplete);
+// the rest change in a single transaction with one audit entry each, all
+// carrying the batch ID.
+func (s *TriageService) BulkTriage(ctx context.Context, req dto.BulkTriageRequest, userID *uuid.UUID, actorIP string) (*vo.BulkTriageResultVO, error) {
+	spam := req.Decision == bulkDecisionSpam
+	duplicate := req.Decision == bulkDecisionDuplicate
+	if !spam && !duplicate && req.SeverityFinal == "" {
+		return nil, ErrSeverityRequired
+	}
+	// High-severity decisions are reviewed one report at a time
+	if !spam && !duplicate && s.needsConsensus(req.SeverityFinal) {
+		return nil, ErrConsensusRequired
+	}
+
+	var incidentID *uuid.UUID
+	if duplicate {
+		uid, err := uuid.Parse(req.IncidentID)
+		if err != nil {
+			return nil, ErrIncidentNotFound
+		}
+		incident, err := s.incidentRepo.GetByID(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+		if incident == nil {
+			return nil, ErrIncidentNotFound
+		}
+		if incident.Status == model.IncidentStatusMerged {
+			return nil, ErrIncidentMerged
+		}
+		incidentID = &incident.ID
+	}
+
+	ids := make([]uuid.UUID, len(req.ReportIDs))
+	for i, raw := range req.ReportIDs {
+		uid, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, ErrReportNotFound
+		}
+		ids[i] = uid
+	}
 
-	return s.toTriageDecisionVO(decision), nil
+	reports, err := s.reportRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*model.Report, len(reports))
+	for i := range reports {
+		byID[reports[i].ID] = &reports[i]
+	}
+
+	// Reports in a consensus round are decided by the round
+	pendingIDs, err := s.consensusRepo.PendingReportIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	inConsensus := make(map[uuid.UUID]bool, len(pendingIDs))
+	for _, id := range pendingIDs {
+		inConsensus[id] = true
+	}
+
+	// Triage decisions are checked against the rubric report by report
+	var rubric *model.TriageRubric
+	if !spam && !duplicate {
+		rubric, err = s.rubricRepo.GetActive(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rationaleFields := rationaleFieldsMap(req.RationaleFields)
+
+	batchID := uuid.New()
+	now := time.Now().UTC()
+	result := &vo.BulkTriageResultVO{
+		BatchID:  batchID.String(),
+		Decision: req.Decision,
+		Results:  make([]vo.BulkTriageItemVO, len(ids)),
+	}
+	if rubric != nil {
+		result.RubricVersion = &rubric.Version
+	}
+
+	var items []repository.BatchItem
+	var leased []*model.ReportLease
+	for i, reportID := range ids {
+		item := vo.BulkTriageItemVO{ReportID: reportID.String(), Outcome: bulkOutcomeSkipped}
+		report := byID[reportID]
+		if report == nil {
+			item.Error = "NOT_FOUND"
+			result.Results[i] = item
+			continue
+		}
+		item.StatusFrom = report.Status
+
+		if inConsensus[reportID] {
+			item.Error = "IN_CONSENSUS"
+			result.Results[i] = item
+			continue
+		}
+
+		// Only the claim holder may decide on a claimed report
+		lease, err := s.leases.Get(ctx, reportID)
+		if err != nil {
+			return nil, err
+		}
+		if lease != nil && (userID == nil || lease.HolderID != *userID) {
+			item.Error = "REPORT_CLAIMED"
+			result.Results[i] = item
+			continue
+		}
+
+		// As with single decisions, keeping the current status is allowed
+		to := bulkStatus(req.Decision)
+		if to != report.Status && !isValidReportTransition(report.Status, to) {
+			item.Error = "INVALID_TRANSITION"
+			result.Results[i] = item
+			continue
+		}
+		if rubric != nil {
+			violations := checkRubric(rubric, report.Category, req.Decision, req.SeverityFinal, req.EvidenceLevel, req.RationaleFields, req.Confirmations)
+			if violations != nil {
+				item.Error = "RUBRIC_VIOLATION"
+				item.Violations = violations
+				result.Results[i] = item
+				continue
+			}
+		}
+		item.StatusTo = to
+
+		batchItem := repository.BatchItem{
+			ReportID:   reportID,
+			From:       report.Status,
+			To:         to,
+			IncidentID: incidentID,
+		}
+		diff := model.JSONMap{
+			"batchId": batchID.String(),
+			"status":  map[string]string{"from": report.Status, "to": to},
+			"reason":  req.Reason,
+		}
+		action := model.ActionTriage
+		switch {
+		case spam:
+			action = model.ActionSpam
+		case duplicate:
+			action = model.ActionClose
+			diff["incidentId"] = incidentID.String()
+		default:
+			auditHash := generateAuditHash(map[string]interface{}{
+				"reportId":        reportID.String(),
+				"decision":        req.Decision,
+				"severityFinal":   req.SeverityFinal,
+				"evidenceLevel":   req.EvidenceLevel,
+				"rationale":       req.Reason,
+				"rationaleFields": rationaleFields,
+				"confirmations":   req.Confirmations,
+				"rubricVersion":   result.RubricVersion,
+				"timestamp":       now.Format(time.RFC3339),
+			})
+			batchItem.Decision = &model.TriageDecision{
+				ID:              uuid.New(),
+				ReportID:        reportID,
+				DecidedBy:       userID,
+				Decision:        req.Decision,
+				SeverityFinal:   req.SeverityFinal,
+				EvidenceLevel:   req.EvidenceLevel,
+				Rationale:       req.Reason,
+				AuditHash:       auditHash,
+				RubricVersion:   result.RubricVersion,
+				RationaleFields: rationaleFields,
+				Confirmations:   req.Confirmations,
+				DecidedAt:       now,
+			}
+			item.DecisionID = batchItem.Decision.ID.String()
+			diff["decision"] = req.Decision
+			diff["severityFinal"] = req.SeverityFinal
+			diff["rubricVersion"] = result.RubricVersion
+			diff["auditHash"] = auditHash
+		}
+		batchItem.Audit = &model.AuditLog{
+			ActorID:    userID,
+			ActorIP:    actorIP,
+			Action:     action,
+			ObjectType: model.ObjectTypeReport,
+			ObjectID:   &report.ID,
+			Diff:       diff,
+		}
+
+		item.Outcome = bulkOutcomeApplied
+		result.Results[i] = item
+		items = append(items, batchItem)
+		if lease != nil {
+			leased = append(leased, lease)
+		}
+	}
+
+	result.Applied = len(items)
+	result.Skipped = len(ids) - len(items)
+	if req.Atomic && result.Skipped > 0 {
+		for i := range result.Results {
+			if result.Results[i].Outcome == bulkOutcomeApplied {
+				result.Results[i].Outcome = bulkOutcomeSkipped
+				result.Results[i].StatusTo = ""
+				result.Results[i].DecisionID = ""
+			}
+		}
+		result.Applied = 0
+		result.Skipped = len(ids)
+		return result, ErrBatchIncomplete
+	}
+
+	if len(items) > 0 {
+		if err := s.triageRepo.ApplyBatch(ctx, items); err != nil {
+			if errors.Is(err, repository.ErrBatchConflict) {
+				return nil, ErrBatchConflict
+			}
+			return nil, err
+		}
+	}
+
+	// The decision ends the claims
+	for _, lease := range leased {
+		s.leases.Release(ctx, lease.ReportID, lease.HolderID)
+	}
+
+	// Reputation is best effort and must not block the batch
+	outcome := decisionOutcome(req.Decision)
+	if spam {
+		outcome = model.OutcomeSpam
+	}
+	for _, item := range items {
+		if err := s.reputationSvc.RecordOutcome(ctx, byID[item.ReportID], outcome); err != nil {
+			log.Printf("reputation update failed for report %s: %v", item.ReportID, err)
+		}
+	}
+
+	return result, nil
 }
 
 // List retrieves triage decisions with pagination
@@ -119,11 +513,17 @@ func (s *TriageService) List(ctx context.Context, query dto.ListTriageDecisionsQ
 		}
 	}
 
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repository.ListTriageParams{
 		Page:     query.Page,
 		PageSize: query.PageSize,
 		ReportID: reportID,
 		Decision: query.Decision,
+		After:    after,
 	}
 
 	decisions, total, err := s.triageRepo.List(ctx, params)
@@ -131,20 +531,26 @@ func (s *TriageService) List(ctx context.Context, query dto.ListTriageDecisionsQ
 		return nil, err
 	}
 
+	hasMore := hasNextPage(after, len(decisions), query.Page, query.PageSize, total)
+	if len(decisions) > query.PageSize {
+		decisions = decisions[:query.PageSize]
+	}
+
 	decisionVOs := make([]vo.TriageDecisionVO, len(decisions))
+	var last repository.Cursor
 	for i, d := range decisions {
 		decisionVOs[i] = *s.toTriageDecisionVO(&d)
+		last = repository.Cursor{Time: d.DecidedAt, ID: d.ID}
 	}
 
-	return &vo.TriageDecisionListVO{
-		Data: decisionVOs,
-		Pagination: vo.PaginationVO{
-			Page:       query.Page,
-			PageSize:   query.PageSize,
-			Total:      total,
-			TotalPages: int((total + int64(query.PageSize) - 1) / int64(query.PageSize)),
-		},
-	}, nil
+	result := &vo.TriageDecisionListVO{
+		Data:   decisionVOs,
+		Cursor: newCursorPagination(query.PageSize, hasMore, last),
+	}
+	if after == nil {
+		result.Pagination = newPagination(query.Page, query.PageSize, total)
+	}
+	return result, nil
 }
 
 // toTriageDecisionVO converts a triage decision model to VO
@@ -156,8 +562,16 @@ func (s *TriageService) toTriageDecisionVO(decision *model.TriageDecision) *vo.T
 		SeverityFinal: decision.SeverityFinal,
 		EvidenceLevel: decision.EvidenceLevel,
 		Rationale:     decision.Rationale,
+		RubricVersion: decision.RubricVersion,
+		Confirmations: decision.Confirmations,
 		DecidedAt:     decision.DecidedAt,
 	}
+	if len(decision.RationaleFields) > 0 {
+		result.RationaleFields = decision.RationaleFields
+	}
+	if decision.RoundID != nil {
+		result.RoundID = decision.RoundID.String()
+	}
 
 	if decision.Decider != nil {
 		result.DecidedBy = &vo.UserSummaryVO{
@@ -170,6 +584,32 @@ func (s *TriageService) toTriageDecisionVO(decision *model.TriageDecision) *vo.T
 	return result
 }
 
+// decisionStatus maps a triage decision to the resulting report status
+func decisionStatus(decision string) string {
+	switch decision {
+	case model.DecisionEscalate:
+		return model.StatusEscalated
+	case model.DecisionReject:
+		return model.StatusClosed
+	case model.DecisionNeedsMoreInfo:
+		return model.StatusUnderReview
+	default:
+		return model.StatusTriaged
+	}
+}
+
+// bulkStatus maps a bulk decision to the resulting report status
+func bulkStatus(decision string) string {
+	switch decision {
+	case bulkDecisionSpam:
+		return model.StatusSpam
+	case bulkDecisionDuplicate:
+		return model.StatusClosed
+	default:
+		return decisionStatus(decision)
+	}
+}
+
 // generateAuditHash creates a SHA256 hash of the audit data
 func generateAuditHash(data map[string]interface{}) string {
 	jsonBytes, _ := json.Marshal(data)
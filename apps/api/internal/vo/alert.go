@@ -9,6 +9,8 @@ type AlertVO struct {
 	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440002"`
 	// Associated report ID (optional)
 	ReportID string `json:"reportId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Associated incident ID (optional)
+	IncidentID string `json:"incidentId,omitempty" example:"550e8400-e29b-41d4-a716-446655440010"`
 	// Alert status
 	Status string `json:"status" example:"draft"`
 	// Event description
@@ -21,6 +23,8 @@ type AlertVO struct {
 	Certainty string `json:"certainty" example:"Likely"`
 	// Affected area
 	Area string `json:"area" example:"University campus and surrounding transit hubs"`
+	// Targeted pilot zone
+	ZoneID string `json:"zoneId,omitempty" example:"tw-tpe-daan"`
 	// Action instructions
 	Instruction string `json:"instruction" example:"Do not click suspicious links. Verify sender identity."`
 	// Public-facing message
@@ -44,6 +48,8 @@ type AlertVO struct {
 type AlertListVO struct {
 	// List of alerts
 	Data []AlertVO `json:"data"`
-	// Pagination metadata
-	Pagination PaginationVO `json:"pagination"`
+	// Page metadata (omitted when following a cursor)
+	Pagination *PaginationVO `json:"pagination,omitempty"`
+	// Cursor for keyset pagination
+	Cursor *CursorPaginationVO `json:"cursor,omitempty"`
 }
@@ -0,0 +1,59 @@
+package vo
+
+import "time"
+
+// AppealVO represents an appeal as seen by reviewers
+// @Description Appeal response object
+type AppealVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440030"`
+	// What is appealed: report (a spam decision) or blocked (an intake block)
+	Kind string `json:"kind" example:"report"`
+	// Appealed report
+	ReportID string `json:"reportId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Appealed report details
+	Report *ReportVO `json:"report,omitempty"`
+	// When the appealed intake block happened
+	BlockedAt *time.Time `json:"blockedAt,omitempty" example:"2026-01-08T14:30:00Z"`
+	// Reporter's statement
+	Message string `json:"message" example:"This was a real incident, I was there."`
+	// Review status: pending, upheld or overturned
+	Status string `json:"status" example:"pending"`
+	// Reviewer's reason, shared with the reporter
+	DecisionReason string `json:"decisionReason,omitempty"`
+	// Decision timestamp
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	// Reviewer
+	DecidedBy *UserSummaryVO `json:"decidedBy,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T15:00:00Z"`
+}
+
+// AppealListVO represents a paginated list of appeals
+// @Description Paginated appeal list response
+type AppealListVO struct {
+	// List of appeals
+	Data []AppealVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// AppealStatusVO is what a reporter sees of their appeal
+// @Description Public appeal status
+type AppealStatusVO struct {
+	// Unique identifier, used to check the status later
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440030"`
+	// What is appealed: report or blocked
+	Kind string `json:"kind" example:"report"`
+	// Review status: pending, upheld or overturned
+	Status string `json:"status" example:"pending"`
+	// Reviewer's reason
+	DecisionReason string `json:"decisionReason,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T15:00:00Z"`
+	// Decision timestamp
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	// For an overturned intake block: send once as X-Appeal-Pass to get a
+	// submission past the rate limit. Shown for 7 days after the decision.
+	Pass string `json:"pass,omitempty"`
+}
@@ -0,0 +1,53 @@
+package vo
+
+import "time"
+
+// BrigadeClusterVO represents a suspected coordinated-reporting cluster
+// @Description Brigade cluster response object
+type BrigadeClusterVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440020"`
+	// What the reports target: area, category or phrasing
+	TargetType string `json:"targetType" example:"area"`
+	// Target identifier (area key, category or phrasing fingerprint)
+	TargetKey string `json:"targetKey" example:"zone:tw-tpe-daan"`
+	// Area whose intake is tightened
+	AreaKey string `json:"areaKey,omitempty" example:"zone:tw-tpe-daan"`
+	// Category of the triggering report
+	Category string `json:"category" example:"suspicious_person"`
+	// Correlation signals: network, devices, phrasing
+	Signals []string `json:"signals"`
+	// Number of reports in the cluster
+	ReportCount int `json:"reportCount" example:"8"`
+	// Number of distinct devices behind the reports
+	SourceCount int `json:"sourceCount" example:"2"`
+	// Review status: open, confirmed or dismissed
+	Status string `json:"status" example:"open"`
+	// Intake stays tightened until this time
+	TightenedUntil *time.Time `json:"tightenedUntil,omitempty" example:"2026-01-08T16:30:00Z"`
+	// First detection timestamp
+	DetectedAt time.Time `json:"detectedAt" example:"2026-01-08T14:30:00Z"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt" example:"2026-01-08T14:45:00Z"`
+	// Review timestamp
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	// Reviewer
+	ResolvedBy *UserSummaryVO `json:"resolvedBy,omitempty"`
+}
+
+// BrigadeClusterListVO represents a paginated list of brigade clusters
+// @Description Paginated brigade cluster list response
+type BrigadeClusterListVO struct {
+	// List of clusters
+	Data []BrigadeClusterVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// BrigadeClusterDetailVO represents a brigade cluster with its reports
+// @Description Brigade cluster with its reports
+type BrigadeClusterDetailVO struct {
+	BrigadeClusterVO
+	// Reports in the cluster
+	Reports []ReportVO `json:"reports"`
+}
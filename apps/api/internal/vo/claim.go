@@ -0,0 +1,53 @@
+package vo
+
+import "time"
+
+// ClaimVO represents a misinformation claim
+// @Description Misinformation claim response object
+type ClaimVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440040"`
+	// The claim as it circulates
+	Text string `json:"text" example:"The MRT will shut down all lines tonight because of a bomb threat"`
+	// BCP 47 language tag
+	Language string `json:"language,omitempty" example:"zh-TW"`
+	// URLs where the claim was seen
+	Appearances []string `json:"appearances"`
+	// Verdict: unverified, false, misleading or true
+	Verdict string `json:"verdict" example:"false"`
+	// Public explanation of the verdict
+	Explanation string `json:"explanation,omitempty" example:"Metro Taipei confirmed all lines run on the normal timetable"`
+	// Source URLs backing the verdict
+	Evidence []string `json:"evidence"`
+	// Publication time of the first rating; empty while unverified
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	// Time of the latest verdict
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+	// Reviewer of the latest verdict
+	ReviewedBy *UserSummaryVO `json:"reviewedBy,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T14:30:00Z"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt" example:"2026-01-08T15:10:00Z"`
+	// Creator information
+	CreatedBy *UserSummaryVO `json:"createdBy,omitempty"`
+}
+
+// ClaimListVO represents a paginated list of claims
+// @Description Paginated claim list response
+type ClaimListVO struct {
+	// List of claims
+	Data []ClaimVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// ClaimDetailVO represents a claim with its linked reports and incidents
+// @Description Claim with linked reports and incidents
+type ClaimDetailVO struct {
+	ClaimVO
+	// Linked reports
+	Reports []ReportVO `json:"reports"`
+	// Linked incidents
+	Incidents []IncidentVO `json:"incidents"`
+}
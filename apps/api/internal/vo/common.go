@@ -15,6 +15,17 @@ type PaginationVO struct {
 	TotalPages int `json:"totalPages" example:"8"`
 }
 
+// CursorPaginationVO represents keyset pagination metadata
+// @Description Cursor pagination metadata
+type CursorPaginationVO struct {
+	// Opaque cursor for the next page; pass it back as the cursor parameter
+	NextCursor string `json:"nextCursor,omitempty" example:"eyJ0IjoiMjAyNi0wMS0wOFQxNDozMDowMFoiLCJpZCI6IjU1MGU4NDAwIn0"`
+	// Whether another page follows
+	HasMore bool `json:"hasMore" example:"true"`
+	// Items per page
+	PageSize int `json:"pageSize" example:"20"`
+}
+
 // UserSummaryVO represents a brief user summary
 // @Description Brief user information
 type UserSummaryVO struct {
@@ -117,6 +128,8 @@ type AuditLogVO struct {
 type AuditLogListVO struct {
 	// List of audit logs
 	Data []AuditLogVO `json:"data"`
-	// Pagination metadata
-	Pagination PaginationVO `json:"pagination"`
+	// Page metadata (omitted when following a cursor)
+	Pagination *PaginationVO `json:"pagination,omitempty"`
+	// Cursor for keyset pagination
+	Cursor *CursorPaginationVO `json:"cursor,omitempty"`
 }
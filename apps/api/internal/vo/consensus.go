@@ -0,0 +1,63 @@
+package vo
+
+import "time"
+
+// ConsensusRoundVO represents the independent reviews of a high-severity
+// triage decision
+// @Description Consensus round
+type ConsensusRoundVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440070"`
+	// Report under review
+	ReportID string `json:"reportId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// open, agreed, disputed or resolved
+	Status string `json:"status" example:"open"`
+	// Reviews recorded so far
+	ReviewCount int `json:"reviewCount" example:"1"`
+	// Reviews needed before the decision is final
+	ReviewsRequired int `json:"reviewsRequired" example:"2"`
+	// The reviews; withheld while the round is open so reviews stay blind
+	Reviews []TriageReviewVO `json:"reviews,omitempty"`
+	// Final triage decision, once the round is closed
+	DecisionID string `json:"decisionId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	// Lead who broke the tie
+	ResolvedBy *UserSummaryVO `json:"resolvedBy,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T15:00:00Z"`
+	// When the reviewers agreed or a lead broke the tie
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+}
+
+// TriageReviewVO represents one reviewer's decision in a consensus round
+// @Description Consensus round review
+type TriageReviewVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440071"`
+	// Reviewer
+	Reviewer *UserSummaryVO `json:"reviewer,omitempty"`
+	// Decision
+	Decision string `json:"decision" example:"accept"`
+	// Severity assessment
+	SeverityFinal string `json:"severityFinal" example:"S3"`
+	// Evidence level assessment
+	EvidenceLevel string `json:"evidenceLevel,omitempty" example:"E2"`
+	// Rationale
+	Rationale string `json:"rationale,omitempty"`
+	// Structured rationale required by the rubric
+	RationaleFields map[string]interface{} `json:"rationaleFields,omitempty"`
+	// Rubric checks the reviewer confirmed
+	Confirmations []string `json:"confirmations,omitempty"`
+	// Rubric version the review was checked against
+	RubricVersion *int `json:"rubricVersion,omitempty" example:"2"`
+	// Review timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T15:00:00Z"`
+}
+
+// ConsensusRoundListVO represents a paginated list of consensus rounds
+// @Description Paginated consensus round list response
+type ConsensusRoundListVO struct {
+	// List of consensus rounds
+	Data []ConsensusRoundVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
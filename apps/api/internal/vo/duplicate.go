@@ -0,0 +1,28 @@
+package vo
+
+import "time"
+
+// DuplicateCandidateVO represents a suspected duplicate of a report
+// @Description Suggested duplicate with score breakdown
+type DuplicateCandidateVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440020"`
+	// Report the suggestion was raised for
+	ReportID string `json:"reportId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Earlier report that looks like the same event
+	Candidate *ReportVO `json:"candidate,omitempty"`
+	// Combined score (0-1)
+	Score float64 `json:"score" example:"0.82"`
+	// Description similarity (0-1)
+	TextScore float64 `json:"textScore" example:"0.9"`
+	// Area hint similarity (0-1)
+	AreaScore float64 `json:"areaScore" example:"1"`
+	// Time window proximity (0-1)
+	TimeScore float64 `json:"timeScore" example:"0.5"`
+	// Review status (suggested, linked, dismissed)
+	Status string `json:"status" example:"suggested"`
+	// Review timestamp
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T14:30:00Z"`
+}
@@ -0,0 +1,74 @@
+package vo
+
+import "time"
+
+// ImportResultVO represents the outcome of a bulk report import
+// @Description Bulk report import result
+type ImportResultVO struct {
+	// Import batch the reports were tagged with; empty for a dry run
+	BatchID string `json:"batchId,omitempty" example:"550e8400-e29b-41d4-a716-446655440040"`
+	// Whether rows were only validated
+	DryRun bool `json:"dryRun"`
+	// Whether any failed row rolls back the whole batch
+	Atomic bool `json:"atomic"`
+	// Batch status: completed, partial or rolled_back. For a dry run, the
+	// status the import would end with.
+	Status string `json:"status" example:"completed"`
+	// Rows read
+	Total int `json:"total" example:"120"`
+	// Rows that passed validation
+	Valid int `json:"valid" example:"120"`
+	// Reports created
+	Imported int `json:"imported" example:"120"`
+	// Rows that failed validation or could not be stored
+	Failed int `json:"failed" example:"0"`
+	// Outcome of each row
+	Rows []ImportRowVO `json:"rows"`
+}
+
+// ImportRowVO is the outcome of one imported row
+// @Description Bulk report import row result
+type ImportRowVO struct {
+	// Line of the row in the file
+	Line int `json:"line" example:"2"`
+	// imported, valid (dry run), invalid, failed or rolled_back
+	Status string `json:"status" example:"imported"`
+	// Report created from the row
+	ReportID string `json:"reportId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Why the row was not imported
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ImportBatchVO represents a past bulk import
+// @Description Import batch response object
+type ImportBatchVO struct {
+	// Unique identifier; filter reports with importBatchId
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440040"`
+	// Name given to the batch, such as the drill scenario
+	Name string `json:"name" example:"2026 Q1 tabletop drill"`
+	// File format: csv or jsonl
+	Format string `json:"format" example:"csv"`
+	// Whether any failed row rolled back the whole batch
+	Atomic bool `json:"atomic"`
+	// completed, partial or rolled_back
+	Status string `json:"status" example:"completed"`
+	// Rows read
+	Total int `json:"total" example:"120"`
+	// Reports created
+	Imported int `json:"imported" example:"120"`
+	// Rows not imported
+	Failed int `json:"failed" example:"0"`
+	// User who ran the import; empty when run from the command line
+	CreatedBy *UserSummaryVO `json:"createdBy,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T14:30:00Z"`
+}
+
+// ImportBatchListVO represents a paginated list of import batches
+// @Description Paginated import batch list response
+type ImportBatchListVO struct {
+	// List of import batches
+	Data []ImportBatchVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
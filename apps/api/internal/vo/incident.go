@@ -0,0 +1,53 @@
+package vo
+
+import "time"
+
+// IncidentVO represents the response for an incident
+// @Description Incident response object
+type IncidentVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440010"`
+	// Short title
+	Title string `json:"title" example:"Unattended bag at Taipei Main Station"`
+	// Incident category
+	Category string `json:"category" example:"suspicious_item"`
+	// Incident severity
+	Severity string `json:"severity,omitempty" example:"S2"`
+	// Lifecycle status
+	Status string `json:"status" example:"open"`
+	// Internal summary
+	Summary string `json:"summary,omitempty"`
+	// Approximate area
+	AreaHint string `json:"areaHint,omitempty" example:"Taipei Main Station, east exit"`
+	// Number of linked reports
+	ReportCount int64 `json:"reportCount" example:"10"`
+	// Target incident when merged
+	MergedInto string `json:"mergedInto,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T14:30:00Z"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt" example:"2026-01-08T14:30:00Z"`
+	// Resolution timestamp
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	// Creator information
+	CreatedBy *UserSummaryVO `json:"createdBy,omitempty"`
+}
+
+// IncidentListVO represents a paginated list of incidents
+// @Description Paginated incident list response
+type IncidentListVO struct {
+	// List of incidents
+	Data []IncidentVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// IncidentDetailVO represents an incident with its reports and alerts
+// @Description Detailed incident with linked reports and alerts
+type IncidentDetailVO struct {
+	IncidentVO
+	// Linked reports
+	Reports []ReportVO `json:"reports"`
+	// Linked alerts
+	Alerts []AlertVO `json:"alerts"`
+}
@@ -0,0 +1,80 @@
+package vo
+
+import "time"
+
+// IndicatorVO represents a scam indicator
+// @Description Scam indicator response object
+type IndicatorVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440030"`
+	// Indicator type: url, domain, email, phone, bank_account, line_id or crypto_wallet
+	Type string `json:"type" example:"phone"`
+	// Normalised value (E.164 phones, refanged lower-case URLs)
+	Value string `json:"value" example:"+886912345678"`
+	// Verification status: unverified, confirmed or false_positive
+	Status string `json:"status" example:"unverified"`
+	// Number of reports the indicator was found in
+	ReportCount int `json:"reportCount" example:"3"`
+	// Creation time of the earliest report mentioning it
+	FirstSeenAt time.Time `json:"firstSeenAt" example:"2026-01-08T14:30:00Z"`
+	// Creation time of the latest report mentioning it
+	LastSeenAt time.Time `json:"lastSeenAt" example:"2026-01-09T09:10:00Z"`
+	// Verification note
+	VerificationNote string `json:"verificationNote,omitempty" example:"Number listed by 165 anti-fraud hotline"`
+	// Verification timestamp
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+	// Verifier
+	VerifiedBy *UserSummaryVO `json:"verifiedBy,omitempty"`
+}
+
+// IndicatorListVO represents a paginated list of scam indicators
+// @Description Paginated scam indicator list response
+type IndicatorListVO struct {
+	// List of indicators
+	Data []IndicatorVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// IndicatorDetailVO represents a scam indicator with its reports
+// @Description Scam indicator with the reports it was found in
+type IndicatorDetailVO struct {
+	IndicatorVO
+	// Newest reports mentioning the indicator
+	Reports []ReportVO `json:"reports"`
+}
+
+// IndicatorRescanVO represents the result of re-extracting indicators
+// @Description Indicator rescan result
+type IndicatorRescanVO struct {
+	// Reports scanned
+	Reports int `json:"reports" example:"412"`
+	// Indicators found, counting each report separately
+	Indicators int `json:"indicators" example:"538"`
+}
+
+// IndicatorMatchVO represents a verified indicator in a hash-prefix lookup
+// @Description Verified indicator matching a lookup prefix
+type IndicatorMatchVO struct {
+	// Full SHA-256 hex of type:value; compare with the hash you computed
+	Hash string `json:"hash" example:"3f7a1c0e5b2d9f84a6c3e1b7d0f2a4c6e8b1d3f5a7c9e0b2d4f6a8c0e2b4d6f8"`
+	// Indicator type
+	Type string `json:"type" example:"phone"`
+	// Verification status: confirmed (scam) or false_positive (checked, not a scam)
+	Status string `json:"status" example:"confirmed"`
+	// Number of reports the indicator was found in
+	ReportCount int `json:"reportCount" example:"12"`
+	// Creation time of the earliest report mentioning it
+	FirstSeenAt time.Time `json:"firstSeenAt" example:"2026-01-08T14:30:00Z"`
+	// Creation time of the latest report mentioning it
+	LastSeenAt time.Time `json:"lastSeenAt" example:"2026-01-09T09:10:00Z"`
+}
+
+// IndicatorLookupVO represents the result of a hash-prefix lookup
+// @Description Hash-prefix lookup result
+type IndicatorLookupVO struct {
+	// Queried hash prefix
+	Prefix string `json:"prefix" example:"3f7a1"`
+	// Verified indicators whose hash starts with the prefix
+	Matches []IndicatorMatchVO `json:"matches"`
+}
@@ -1,5 +1,7 @@
 package vo
 
+import "time"
+
 // KPIMetricsVO represents the KPI metrics response
 // @Description KPI metrics summary
 type KPIMetricsVO struct {
@@ -49,6 +51,16 @@ type SystemKPIVO struct {
 	AlertPublishLatency float64 `json:"alertPublishLatency" example:"12.0"`
 	// Publish latency target
 	PublishLatencyTarget float64 `json:"publishLatencyTarget" example:"15.0"`
+	// Reports with at least one triage decision
+	TriagedReports int `json:"triagedReports" example:"120"`
+	// Distinct incidents behind the triaged reports (ungrouped reports count once each)
+	TriagedIncidents int `json:"triagedIncidents" example:"85"`
+	// Incidents currently open
+	OpenIncidents int `json:"openIncidents" example:"3"`
+	// Appeals awaiting a decision
+	PendingAppeals int `json:"pendingAppeals" example:"2"`
+	// Decided appeals that overturned the original decision (percentage)
+	AppealOverturnRate float64 `json:"appealOverturnRate" example:"12.5"`
 }
 
 // AdoptionKPIVO represents adoption-related KPIs
@@ -71,6 +83,9 @@ type GovernanceKPIVO struct {
 	CertifiedTriagers int `json:"certifiedTriagers" example:"18"`
 	// Certified triagers target
 	TriagersTarget int `json:"triagersTarget" example:"15"`
+	// Cohen's kappa on the decisions of independent high-severity reviews
+	// over the last 90 days; absent when undefined
+	ConsensusKappa *float64 `json:"consensusKappa,omitempty" example:"0.72"`
 }
 
 // DashboardStatsVO represents public dashboard statistics
@@ -86,6 +101,8 @@ type DashboardStatsVO struct {
 	RecentAlerts []AlertSummaryVO `json:"recentAlerts"`
 	// Report category breakdown
 	CategoryBreakdown []CategoryCountVO `json:"categoryBreakdown"`
+	// Report pilot zone breakdown
+	ZoneBreakdown []ZoneCountVO `json:"zoneBreakdown"`
 }
 
 // AlertSummaryVO represents a brief alert summary
@@ -95,6 +112,7 @@ type AlertSummaryVO struct {
 	Event     string `json:"event"`
 	Severity  string `json:"severity"`
 	Area      string `json:"area"`
+	ZoneID    string `json:"zoneId,omitempty"`
 	CreatedAt string `json:"createdAt"`
 }
 
@@ -104,3 +122,65 @@ type CategoryCountVO struct {
 	Category string `json:"category" example:"scam_phishing"`
 	Count    int    `json:"count" example:"45"`
 }
+
+// ZoneCountVO represents a pilot zone count pair
+// @Description Pilot zone count for breakdown
+type ZoneCountVO struct {
+	ZoneID string `json:"zoneId" example:"tw-tpe-daan"`
+	Count  int    `json:"count" example:"12"`
+}
+
+// TriageAgreementVO represents how often independent reviewers of
+// high-severity reports agree
+// @Description Triage inter-rater agreement
+type TriageAgreementVO struct {
+	// Start of the look-back window
+	Since time.Time `json:"since" example:"2026-01-01T00:00:00Z"`
+	// Consensus rounds with two reviews in the window
+	Rounds int `json:"rounds" example:"40"`
+	// Cohen's kappa on the decision over all rounds; absent when undefined
+	DecisionKappa *float64 `json:"decisionKappa,omitempty" example:"0.72"`
+	// Cohen's kappa on the final severity over all rounds
+	SeverityKappa *float64 `json:"severityKappa,omitempty" example:"0.64"`
+	// Rounds whose reviewers disagreed (percentage)
+	DisagreementRate float64 `json:"disagreementRate" example:"17.5"`
+	// Agreement per pair of triagers
+	Pairs []TriagerPairAgreementVO `json:"pairs"`
+	// Agreement per calendar month, oldest first
+	Trend []AgreementTrendVO `json:"trend"`
+}
+
+// TriagerPairAgreementVO represents the agreement of two triagers who
+// reviewed the same reports
+// @Description Agreement of a pair of triagers
+type TriagerPairAgreementVO struct {
+	// First triager
+	TriagerA *UserSummaryVO `json:"triagerA,omitempty"`
+	// Second triager
+	TriagerB *UserSummaryVO `json:"triagerB,omitempty"`
+	// Rounds both triagers reviewed
+	Rounds int `json:"rounds" example:"12"`
+	// Rounds with the same decision (percentage)
+	DecisionAgreement float64 `json:"decisionAgreement" example:"91.7"`
+	// Rounds with the same final severity (percentage)
+	SeverityAgreement float64 `json:"severityAgreement" example:"83.3"`
+	// Cohen's kappa on the decision; absent when undefined
+	DecisionKappa *float64 `json:"decisionKappa,omitempty" example:"0.8"`
+	// Cohen's kappa on the final severity; absent when undefined
+	SeverityKappa *float64 `json:"severityKappa,omitempty" example:"0.66"`
+}
+
+// AgreementTrendVO represents triage agreement in one calendar month
+// @Description Monthly triage agreement
+type AgreementTrendVO struct {
+	// Month (YYYY-MM, UTC)
+	Month string `json:"month" example:"2026-01"`
+	// Consensus rounds opened in the month
+	Rounds int `json:"rounds" example:"9"`
+	// Cohen's kappa on the decision; absent when undefined
+	DecisionKappa *float64 `json:"decisionKappa,omitempty" example:"0.7"`
+	// Cohen's kappa on the final severity; absent when undefined
+	SeverityKappa *float64 `json:"severityKappa,omitempty" example:"0.6"`
+	// Rounds whose reviewers disagreed (percentage)
+	DisagreementRate float64 `json:"disagreementRate" example:"22.2"`
+}
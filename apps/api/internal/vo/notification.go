@@ -0,0 +1,33 @@
+package vo
+
+import "time"
+
+// NotificationVO represents an in-app notification
+// @Description In-app notification
+type NotificationVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440040"`
+	// Notification kind
+	Kind string `json:"kind" example:"sla_breach"`
+	// Short title
+	Title string `json:"title" example:"SLA breached: S3 report awaiting triage"`
+	// Message body
+	Body string `json:"body,omitempty"`
+	// Related object type
+	ObjectType string `json:"objectType,omitempty" example:"report"`
+	// Related object ID
+	ObjectID string `json:"objectId,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Read timestamp
+	ReadAt *time.Time `json:"readAt,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T15:01:00Z"`
+}
+
+// NotificationListVO represents a paginated list of notifications
+// @Description Paginated notification list response
+type NotificationListVO struct {
+	// List of notifications
+	Data []NotificationVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
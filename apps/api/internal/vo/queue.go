@@ -0,0 +1,20 @@
+package vo
+
+import "time"
+
+// LeaseVO represents a triager's claim on a report
+// @Description Time-limited claim on a report
+type LeaseVO struct {
+	// Claimed report ID
+	ReportID string `json:"reportId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// User holding the claim
+	HolderID string `json:"holderId" example:"550e8400-e29b-41d4-a716-446655440001"`
+	// Admin who assigned the report (when assigned rather than claimed)
+	AssignedBy string `json:"assignedBy,omitempty" example:"550e8400-e29b-41d4-a716-446655440003"`
+	// Claim timestamp
+	AcquiredAt time.Time `json:"acquiredAt" example:"2026-01-08T14:35:00Z"`
+	// Expiry timestamp
+	ExpiresAt time.Time `json:"expiresAt" example:"2026-01-08T14:50:00Z"`
+	// Claimed report
+	Report *ReportVO `json:"report,omitempty"`
+}
@@ -13,6 +13,10 @@ type ReportVO struct {
 	SeveritySuggested string `json:"severitySuggested,omitempty" example:"S2"`
 	// Approximate area/location hint
 	AreaHint string `json:"areaHint" example:"Near campus entrance"`
+	// Pilot zone resolved from the location
+	ZoneID string `json:"zoneId,omitempty" example:"tw-tpe-daan"`
+	// Coarsened location cell
+	Geohash string `json:"geohash,omitempty" example:"wsqqqm"`
 	// Time window of the incident
 	TimeWindow string `json:"timeWindow,omitempty" example:"2026-01-08 14:00-15:00"`
 	// Detailed description
@@ -21,19 +25,55 @@ type ReportVO struct {
 	Evidence []string `json:"evidence,omitempty"`
 	// Current status
 	Status string `json:"status" example:"submitted"`
+	// Intake channel: web, email, line, whatsapp or import
+	Channel string `json:"channel,omitempty" example:"web"`
+	// Intake gate risk score (0-1); not shown to the submitter
+	RiskScore float64 `json:"riskScore,omitempty" example:"0.25"`
+	// Intake gate findings behind the risk score
+	RiskSignals []string `json:"riskSignals,omitempty"`
+	// Trust indicator for the pseudonymous reporter; not shown to the submitter
+	ReporterTrust *ReporterTrustVO `json:"reporterTrust,omitempty"`
+	// Suspected coordinated-reporting cluster; not shown to the submitter
+	BrigadeClusterID string `json:"brigadeClusterId,omitempty" example:"550e8400-e29b-41d4-a716-446655440020"`
+	// Token for appealing a decision on this report; returned once, at submission
+	FollowUpToken string `json:"followUpToken,omitempty" example:"q3Yx0c6c7w1m8vKJ2gN5sT4uR9pLzA1b"`
+	// Incident this report is grouped under
+	IncidentID string `json:"incidentId,omitempty" example:"550e8400-e29b-41d4-a716-446655440010"`
+	// Import batch a bulk-imported report came in
+	ImportBatchID string `json:"importBatchId,omitempty" example:"550e8400-e29b-41d4-a716-446655440040"`
+	// When retention cleared the report's content; only category, status,
+	// zone and timestamps remain
+	PurgedAt *time.Time `json:"purgedAt,omitempty" example:"2026-07-08T03:00:00Z"`
 	// Creation timestamp
 	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T14:30:00Z"`
 	// Last update timestamp
 	UpdatedAt time.Time `json:"updatedAt" example:"2026-01-08T14:30:00Z"`
 }
 
+// ReporterTrustVO summarises a reporter's track record without identifying them
+// @Description Reporter trust indicator
+type ReporterTrustVO struct {
+	// Trust level: new, trusted, neutral or low
+	Level string `json:"level" example:"trusted"`
+	// Smoothed share of accepted reports (0-1)
+	Score float64 `json:"score" example:"0.8"`
+	// Earlier reports accepted or escalated
+	Accepted int `json:"accepted" example:"3"`
+	// Earlier reports rejected
+	Rejected int `json:"rejected" example:"0"`
+	// Earlier reports marked as spam
+	Spam int `json:"spam" example:"0"`
+}
+
 // ReportListVO represents a paginated list of reports
 // @Description Paginated report list response
 type ReportListVO struct {
 	// List of reports
 	Data []ReportVO `json:"data"`
-	// Pagination metadata
-	Pagination PaginationVO `json:"pagination"`
+	// Page metadata (omitted when following a cursor)
+	Pagination *PaginationVO `json:"pagination,omitempty"`
+	// Cursor for keyset pagination
+	Cursor *CursorPaginationVO `json:"cursor,omitempty"`
 }
 
 // ReportDetailVO represents detailed report with triage history
@@ -42,4 +82,50 @@ type ReportDetailVO struct {
 	ReportVO
 	// Triage decisions for this report
 	TriageDecisions []TriageDecisionVO `json:"triageDecisions,omitempty"`
+	// Suggested duplicates awaiting review
+	Duplicates []DuplicateCandidateVO `json:"duplicates,omitempty"`
+}
+
+// FacetCountVO represents the number of matching reports with a value
+// @Description Facet value count
+type FacetCountVO struct {
+	Value string `json:"value" example:"scam_phishing"`
+	Count int64  `json:"count" example:"12"`
+}
+
+// ReportFacetsVO represents facet counts for a report search
+// @Description Report search facets
+type ReportFacetsVO struct {
+	Status            []FacetCountVO `json:"status"`
+	Category          []FacetCountVO `json:"category"`
+	SeveritySuggested []FacetCountVO `json:"severitySuggested"`
+	// Severity of the latest triage decision
+	SeverityFinal []FacetCountVO `json:"severityFinal"`
+	// Evidence level of the latest triage decision
+	EvidenceLevel []FacetCountVO `json:"evidenceLevel"`
+	Zone          []FacetCountVO `json:"zone"`
+}
+
+// ReportSearchVO represents a page of search results with facets
+// @Description Report search response
+type ReportSearchVO struct {
+	// Matching reports
+	Data []ReportVO `json:"data"`
+	// Counts across all matching reports
+	Facets ReportFacetsVO `json:"facets"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// IntakeChallengeVO represents a proof-of-work challenge for report intake
+// @Description Proof-of-work challenge
+type IntakeChallengeVO struct {
+	// Challenge token; send back as X-PoW-Challenge
+	Token string `json:"token" example:"q3Jt...Vw.Zk9x...aQ"`
+	// Required leading zero bits of SHA-256(token + ":" + solution)
+	Difficulty int `json:"difficulty" example:"16"`
+	// Hash algorithm
+	Algorithm string `json:"algorithm" example:"sha256"`
+	// Challenge expiry
+	ExpiresAt time.Time `json:"expiresAt" example:"2026-01-08T14:40:00Z"`
 }
@@ -0,0 +1,54 @@
+package vo
+
+import "time"
+
+// RetentionSummaryVO describes one retention purge, or what a purge would do
+// now when it is a dry run
+// @Description Retention purge summary
+type RetentionSummaryVO struct {
+	// When the purge ran or the preview was computed
+	RunAt time.Time `json:"runAt" example:"2026-01-08T03:00:00Z"`
+	// Whether nothing was changed
+	DryRun bool `json:"dryRun"`
+	// Outcome per retention class; classes kept indefinitely are omitted
+	Classes []RetentionClassVO `json:"classes"`
+	// Why the purge stopped early; the counts cover what was done before it
+	Error string `json:"error,omitempty"`
+}
+
+// RetentionClassVO is the purge outcome for one retention class
+// @Description Retention class purge outcome
+type RetentionClassVO struct {
+	// reports, contacts, evidence, audit_ips or audit_logs
+	Class string `json:"class" example:"reports"`
+	// anonymize, clear or delete
+	Action string `json:"action" example:"anonymize"`
+	// Retention window
+	Window string `json:"window" example:"4320h0m0s"`
+	// Records older than this are purged
+	Cutoff time.Time `json:"cutoff" example:"2025-07-12T03:00:00Z"`
+	// Records purged, or due to be purged in a dry run
+	Records int64 `json:"records" example:"42"`
+	// Evidence files deleted with purged reports
+	EvidenceFiles int64 `json:"evidenceFiles,omitempty" example:"3"`
+}
+
+// RetentionRunVO is a purge summary as recorded in the audit log
+// @Description Recorded retention purge
+type RetentionRunVO struct {
+	// Audit log entry ID
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440050"`
+	// Purge summary
+	Summary RetentionSummaryVO `json:"summary"`
+	// Whether the summary's signature matches under the current signing key
+	SignatureValid bool `json:"signatureValid"`
+}
+
+// RetentionRunListVO represents a paginated list of recorded purges
+// @Description Paginated retention run list response
+type RetentionRunListVO struct {
+	// List of purges
+	Data []RetentionRunVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
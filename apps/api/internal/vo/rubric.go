@@ -0,0 +1,35 @@
+package vo
+
+import "time"
+
+// TriageRubricVO represents a triage rubric version
+// @Description Triage rubric version
+type TriageRubricVO struct {
+	// Version number
+	Version int `json:"version" example:"2"`
+	// What changed and why
+	Note string `json:"note,omitempty" example:"Scam reports need the payment channel recorded"`
+	// Whether decisions are checked against this version
+	Active bool `json:"active" example:"true"`
+	// Rule for categories without a rule of their own
+	Default RubricRuleVO `json:"default"`
+	// Rules by report category
+	Categories map[string]RubricRuleVO `json:"categories,omitempty"`
+	// Admin who saved the version
+	CreatedBy *UserSummaryVO `json:"createdBy,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T10:00:00Z"`
+}
+
+// RubricRuleVO is what a decision on a report of one category must include
+// @Description Triage rubric rule
+type RubricRuleVO struct {
+	// Decisions the rule applies to (all when empty)
+	Decisions []string `json:"decisions,omitempty" example:"accept,escalate"`
+	// Lowest evidence level allowed for each final severity
+	MinEvidence map[string]string `json:"minEvidence,omitempty"`
+	// Rationale fields that must be filled in
+	Fields []string `json:"fields,omitempty" example:"source"`
+	// Checks the triager must confirm
+	Confirmations []string `json:"confirmations,omitempty" example:"no_personal_data"`
+}
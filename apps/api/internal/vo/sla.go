@@ -0,0 +1,60 @@
+package vo
+
+import "time"
+
+// SLAPolicyVO represents a per-severity triage SLA
+// @Description Triage SLA policy
+type SLAPolicyVO struct {
+	// Severity level
+	Severity string `json:"severity" example:"S3"`
+	// Minutes allowed from submission to triage
+	TriageMinutes int `json:"triageMinutes" example:"30"`
+	// Minutes before the deadline to send a warning
+	WarnMinutes int `json:"warnMinutes" example:"10"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt" example:"2026-01-08T14:30:00Z"`
+}
+
+// SLABreachVO represents a recorded SLA warning or breach
+// @Description SLA warning or breach
+type SLABreachVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440030"`
+	// Report ID
+	ReportID string `json:"reportId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Level (warning, breach)
+	Level string `json:"level" example:"breach"`
+	// Severity used to pick the policy
+	Severity string `json:"severity" example:"S3"`
+	// Triage deadline
+	DueAt time.Time `json:"dueAt" example:"2026-01-08T15:00:00Z"`
+	// Detection timestamp
+	DetectedAt time.Time `json:"detectedAt" example:"2026-01-08T15:01:00Z"`
+	// User IDs notified
+	Notified []string `json:"notified,omitempty"`
+	// When the notification was sent; unset while it is being retried
+	NotifiedAt *time.Time `json:"notifiedAt,omitempty"`
+	// When the report left the queue
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// SLABreachListVO represents a paginated list of SLA breaches
+// @Description Paginated SLA breach list response
+type SLABreachListVO struct {
+	// List of breaches
+	Data []SLABreachVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// SLAStatVO represents breach counts for a severity and level
+// @Description SLA performance by severity
+type SLAStatVO struct {
+	Severity string `json:"severity" example:"S3"`
+	Level    string `json:"level" example:"breach"`
+	Count    int    `json:"count" example:"4"`
+	// Still awaiting triage
+	Open int `json:"open" example:"1"`
+	// Average minutes past the deadline before triage (breaches only)
+	AvgOverrunMinutes float64 `json:"avgOverrunMinutes" example:"12.5"`
+}
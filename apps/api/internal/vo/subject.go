@@ -0,0 +1,169 @@
+package vo
+
+import "time"
+
+// SubjectRequestVO represents a data subject request
+// @Description Data subject request response object
+type SubjectRequestVO struct {
+	// Unique identifier
+	ID string `json:"id" example:"550e8400-e29b-41d4-a716-446655440060"`
+	// access (a copy of the subject's data) or erasure
+	Kind string `json:"kind" example:"access"`
+	// received, verified, fulfilled or rejected
+	Status string `json:"status" example:"received"`
+	// Whether the subject gave a follow-up token (stored hashed)
+	HasFollowUpToken bool `json:"hasFollowUpToken"`
+	// Contact the subject gave; cleared once the request is closed
+	ContactRef string `json:"contactRef,omitempty" example:"reporter@example.org"`
+	// Training participant hash the subject gave; cleared once the request is closed
+	ParticipantHash string `json:"participantHash,omitempty"`
+	// How the request arrived
+	Note string `json:"note,omitempty" example:"Letter to the project office"`
+	// How the subject's identity was checked
+	VerificationNote string `json:"verificationNote,omitempty" example:"Follow-up token matched; confirmed by phone"`
+	// Verification timestamp
+	VerifiedAt *time.Time `json:"verifiedAt,omitempty"`
+	// Admin who verified the request
+	VerifiedBy *UserSummaryVO `json:"verifiedBy,omitempty"`
+	// When the subject made the request
+	ReceivedAt time.Time `json:"receivedAt" example:"2026-01-08T10:00:00Z"`
+	// Statutory deadline
+	DueAt time.Time `json:"dueAt" example:"2026-02-08T10:00:00Z"`
+	// Whether the request is open past its deadline
+	Overdue bool `json:"overdue"`
+	// When the deadline was extended
+	ExtendedAt *time.Time `json:"extendedAt,omitempty"`
+	// Why the deadline was extended
+	ExtensionReason string `json:"extensionReason,omitempty"`
+	// Why the request was rejected
+	RejectionReason string `json:"rejectionReason,omitempty"`
+	// Records found (access) or erased (erasure), by class
+	Result map[string]interface{} `json:"result,omitempty"`
+	// When the request was fulfilled or rejected
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+	// Admin who fulfilled or rejected the request
+	ClosedBy *UserSummaryVO `json:"closedBy,omitempty"`
+	// Admin who logged the request
+	CreatedBy *UserSummaryVO `json:"createdBy,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" example:"2026-01-08T10:05:00Z"`
+}
+
+// SubjectRequestListVO represents a paginated list of subject requests
+// @Description Paginated subject request list response
+type SubjectRequestListVO struct {
+	// List of subject requests
+	Data []SubjectRequestVO `json:"data"`
+	// Pagination metadata
+	Pagination PaginationVO `json:"pagination"`
+}
+
+// SubjectExportVO is everything held about a data subject, as handed to them
+// @Description Data subject access bundle
+type SubjectExportVO struct {
+	// Subject request the bundle answers
+	RequestID string `json:"requestId" example:"550e8400-e29b-41d4-a716-446655440060"`
+	// When the bundle was generated
+	GeneratedAt time.Time `json:"generatedAt" example:"2026-01-09T09:00:00Z"`
+	// Reports the subject filed
+	Reports []SubjectReportVO `json:"reports"`
+	// Evidence files kept with those reports
+	Evidence []SubjectEvidenceVO `json:"evidence"`
+	// Appeals against decisions on those reports
+	Appeals []SubjectAppealVO `json:"appeals"`
+	// Chat status update subscriptions for those reports
+	Subscriptions []SubjectSubscriptionVO `json:"subscriptions"`
+	// IP addresses recorded when those reports were submitted
+	SubmissionIPs []SubjectIPVO `json:"submissionIps"`
+	// Training quiz results under the subject's participant hash
+	QuizResults []SubjectQuizResultVO `json:"quizResults"`
+	// Training attendance under the subject's participant hash
+	TrainingParticipation []SubjectParticipantVO `json:"trainingParticipation"`
+}
+
+// SubjectReportVO is a report as the subject filed it, with its outcome
+// @Description Report in a data subject access bundle
+type SubjectReportVO struct {
+	ID                string     `json:"id"`
+	Category          string     `json:"category"`
+	SeveritySuggested string     `json:"severitySuggested,omitempty"`
+	AreaHint          string     `json:"areaHint,omitempty"`
+	TimeWindow        string     `json:"timeWindow,omitempty"`
+	Description       string     `json:"description,omitempty"`
+	EvidenceRefs      []string   `json:"evidenceRefs"`
+	ReporterContact   string     `json:"reporterContact,omitempty"`
+	ZoneID            string     `json:"zoneId,omitempty"`
+	Channel           string     `json:"channel"`
+	Status            string     `json:"status"`
+	DeviceHash        string     `json:"deviceHash,omitempty"`
+	NetworkHash       string     `json:"networkHash,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	PurgedAt          *time.Time `json:"purgedAt,omitempty"`
+}
+
+// SubjectEvidenceVO is an evidence file with its content
+// @Description Evidence file in a data subject access bundle
+type SubjectEvidenceVO struct {
+	ID          string    `json:"id"`
+	ReportID    string    `json:"reportId"`
+	Kind        string    `json:"kind"`
+	ContentType string    `json:"contentType"`
+	Filename    string    `json:"filename,omitempty"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	Data        []byte    `json:"data"` // base64
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// SubjectAppealVO is an appeal the subject filed
+// @Description Appeal in a data subject access bundle
+type SubjectAppealVO struct {
+	ID             string     `json:"id"`
+	ReportID       string     `json:"reportId"`
+	Message        string     `json:"message,omitempty"`
+	Status         string     `json:"status"`
+	DecisionReason string     `json:"decisionReason,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	DecidedAt      *time.Time `json:"decidedAt,omitempty"`
+}
+
+// SubjectSubscriptionVO is a chat status update subscription
+// @Description Chat subscription in a data subject access bundle
+type SubjectSubscriptionVO struct {
+	ReportID  string    `json:"reportId"`
+	Channel   string    `json:"channel"`
+	Recipient string    `json:"recipient"`
+	Lang      string    `json:"lang"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SubjectIPVO is an IP address recorded with a report submission
+// @Description Submission IP in a data subject access bundle
+type SubjectIPVO struct {
+	ReportID string    `json:"reportId"`
+	IP       string    `json:"ip"`
+	At       time.Time `json:"at"`
+}
+
+// SubjectQuizResultVO is a training quiz result
+// @Description Quiz result in a data subject access bundle
+type SubjectQuizResultVO struct {
+	ID        string                 `json:"id"`
+	EventID   string                 `json:"eventId,omitempty"`
+	QuizType  string                 `json:"quizType"`
+	Score     float64                `json:"score"`
+	MaxScore  float64                `json:"maxScore"`
+	Answers   map[string]interface{} `json:"answers,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+// SubjectParticipantVO is a training attendance record
+// @Description Training attendance in a data subject access bundle
+type SubjectParticipantVO struct {
+	EventID   string    `json:"eventId"`
+	PreScore  *float64  `json:"preScore,omitempty"`
+	PostScore *float64  `json:"postScore,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -36,8 +36,10 @@ type TrainingEventVO struct {
 type TrainingEventListVO struct {
 	// List of training events
 	Data []TrainingEventVO `json:"data"`
-	// Pagination metadata
-	Pagination PaginationVO `json:"pagination"`
+	// Page metadata (omitted when following a cursor)
+	Pagination *PaginationVO `json:"pagination,omitempty"`
+	// Cursor for keyset pagination
+	Cursor *CursorPaginationVO `json:"cursor,omitempty"`
 }
 
 // QuizResultVO represents the response for a quiz result
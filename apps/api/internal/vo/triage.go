@@ -17,6 +17,14 @@ type TriageDecisionVO struct {
 	EvidenceLevel string `json:"evidenceLevel,omitempty" example:"E2"`
 	// Rationale for the decision
 	Rationale string `json:"rationale,omitempty" example:"Clear evidence of phishing attempt"`
+	// Structured rationale required by the rubric
+	RationaleFields map[string]interface{} `json:"rationaleFields,omitempty"`
+	// Rubric checks the triager confirmed
+	Confirmations []string `json:"confirmations,omitempty"`
+	// Rubric version the decision was checked against
+	RubricVersion *int `json:"rubricVersion,omitempty" example:"2"`
+	// Consensus round the decision closed, for high-severity decisions
+	RoundID string `json:"roundId,omitempty" example:"550e8400-e29b-41d4-a716-446655440070"`
 	// Decision timestamp
 	DecidedAt time.Time `json:"decidedAt" example:"2026-01-08T15:00:00Z"`
 	// Decider information (if available)
@@ -28,6 +36,44 @@ type TriageDecisionVO struct {
 type TriageDecisionListVO struct {
 	// List of triage decisions
 	Data []TriageDecisionVO `json:"data"`
-	// Pagination metadata
-	Pagination PaginationVO `json:"pagination"`
+	// Page metadata (omitted when following a cursor)
+	Pagination *PaginationVO `json:"pagination,omitempty"`
+	// Cursor for keyset pagination
+	Cursor *CursorPaginationVO `json:"cursor,omitempty"`
+}
+
+// BulkTriageItemVO represents the outcome for one report in a bulk triage
+// @Description Per-report bulk triage result
+type BulkTriageItemVO struct {
+	// Report ID
+	ReportID string `json:"reportId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Outcome (applied/skipped)
+	Outcome string `json:"outcome" example:"applied"`
+	// Report status before the batch (empty when the report was not found)
+	StatusFrom string `json:"statusFrom,omitempty" example:"submitted"`
+	// Report status after the batch
+	StatusTo string `json:"statusTo,omitempty" example:"spam"`
+	// Triage decision created for the report (triage decisions only)
+	DecisionID string `json:"decisionId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	// Reason the report was skipped (NOT_FOUND, REPORT_CLAIMED, INVALID_TRANSITION, RUBRIC_VIOLATION, IN_CONSENSUS)
+	Error string `json:"error,omitempty" example:"INVALID_TRANSITION"`
+	// What the decision is missing under the rubric (RUBRIC_VIOLATION only)
+	Violations map[string]string `json:"violations,omitempty"`
+}
+
+// BulkTriageResultVO represents the result of a bulk triage
+// @Description Bulk triage result
+type BulkTriageResultVO struct {
+	// Batch ID, recorded in every audit entry of the batch
+	BatchID string `json:"batchId" example:"550e8400-e29b-41d4-a716-446655440050"`
+	// Decision applied
+	Decision string `json:"decision" example:"spam"`
+	// Rubric version triage decisions were checked against
+	RubricVersion *int `json:"rubricVersion,omitempty" example:"2"`
+	// Number of reports changed
+	Applied int `json:"applied" example:"38"`
+	// Number of reports left unchanged
+	Skipped int `json:"skipped" example:"2"`
+	// Per-report results, in request order
+	Results []BulkTriageItemVO `json:"results"`
 }
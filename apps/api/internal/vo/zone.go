@@ -0,0 +1,21 @@
+package vo
+
+// ZoneVO represents a pilot zone from the gazetteer
+// @Description Pilot zone (Taipei district or Glasgow ward)
+type ZoneVO struct {
+	// Zone identifier
+	ID string `json:"id" example:"tw-tpe-daan"`
+	// English name
+	Name string `json:"name" example:"Da'an"`
+	// Local-language name
+	LocalName string `json:"localName,omitempty" example:"大安區"`
+	// City identifier
+	CityID string `json:"cityId" example:"tw-tpe"`
+	// City name
+	City string `json:"city" example:"Taipei"`
+	// ISO country code
+	Country string `json:"country" example:"TW"`
+	// Approximate zone centre
+	Latitude  float64 `json:"latitude" example:"25.0264"`
+	Longitude float64 `json:"longitude" example:"121.5436"`
+}